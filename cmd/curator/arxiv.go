@@ -0,0 +1,184 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/bakkerme/curator-ai/internal/config"
+	"github.com/bakkerme/curator-ai/internal/runner"
+	"github.com/bakkerme/curator-ai/internal/runner/factory"
+	"github.com/bakkerme/curator-ai/internal/sources/arxiv"
+)
+
+const arxivUsage = `usage: curator arxiv <command> [flags]
+
+commands:
+  backfill  run arXiv flows over a historical date range, one window at a time`
+
+// runArxiv dispatches the `curator arxiv` subcommands.
+func runArxiv(ctx context.Context, env config.EnvConfig, args []string, out io.Writer) error {
+	if len(args) == 0 {
+		return fmt.Errorf("%s", arxivUsage)
+	}
+	switch args[0] {
+	case "backfill":
+		return runArxivBackfill(ctx, env, args[1:], out)
+	default:
+		return fmt.Errorf("unknown arxiv command %q\n%s", args[0], arxivUsage)
+	}
+}
+
+func runArxivBackfill(ctx context.Context, env config.EnvConfig, args []string, out io.Writer) error {
+	fs := flag.NewFlagSet("arxiv backfill", flag.ContinueOnError)
+	fs.SetOutput(out)
+	configPath := fs.String("config", env.CuratorConfigPath, "path to curator document file or directory")
+	flowID := fs.String("flow-id", env.FlowID, "flow identifier (single document only)")
+	fromFlag := fs.String("from", "", "start of the range (YYYY-MM-DD or RFC3339, required)")
+	toFlag := fs.String("to", "", "end of the range (YYYY-MM-DD or RFC3339, default: now)")
+	windowFlag := fs.String("window", "7d", "size of each window")
+	deliver := fs.Bool("deliver", false, "run the flows' outputs (e.g. send email) for every window and mark papers as seen")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	from, err := parseBackfillTime(*fromFlag)
+	if err != nil {
+		return fmt.Errorf("from: %w", err)
+	}
+	to := time.Now().UTC()
+	if strings.TrimSpace(*toFlag) != "" {
+		if to, err = parseBackfillTime(*toFlag); err != nil {
+			return fmt.Errorf("to: %w", err)
+		}
+	}
+	step, err := config.ParseDurationExtended(*windowFlag)
+	if err != nil {
+		return fmt.Errorf("window: %w", err)
+	}
+	windows, err := backfillWindows(from, to, step)
+	if err != nil {
+		return err
+	}
+
+	loaded, err := config.LoadCuratorDocuments(*configPath)
+	if err != nil {
+		return fmt.Errorf("load curator documents: %w", err)
+	}
+	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{}))
+	f, err := factory.NewFromEnvConfig(logger, env)
+	if err != nil {
+		return fmt.Errorf("build runtime factory: %w", err)
+	}
+	r := runner.New(logger)
+
+	seenFlowIDs := map[string]int{}
+	for _, doc := range loaded {
+		if !hasArxivSource(doc.Document) {
+			continue
+		}
+		id := *flowID
+		if len(loaded) > 1 {
+			id = uniqueFlowID(defaultFlowID(doc.Path, doc.Document), seenFlowIDs)
+		}
+		prepareBackfill(doc.Document, *deliver)
+		flow, err := doc.Document.ParseToFlowWithFactory(f)
+		if err != nil {
+			return fmt.Errorf("parse flow (%s): %w", doc.Path, err)
+		}
+		flow.ID = id
+		if !*deliver {
+			flow.Outputs = nil
+		}
+		for _, window := range windows {
+			_, _ = fmt.Fprintf(out, "%s: backfilling %s to %s\n", id, window.from.Format(time.RFC3339), window.to.Format(time.RFC3339))
+			if _, err := r.RunOnce(arxiv.WithWindow(ctx, window.from, window.to), flow); err != nil {
+				return fmt.Errorf("%s (%s) window %s: %w", id, doc.Path, window.from.Format(time.RFC3339), err)
+			}
+		}
+	}
+	return nil
+}
+
+type backfillWindow struct {
+	from time.Time
+	to   time.Time
+}
+
+// backfillWindows splits [from, to) into consecutive windows of step; the last
+// window is shortened to end at to.
+func backfillWindows(from, to time.Time, step time.Duration) ([]backfillWindow, error) {
+	if step <= 0 {
+		return nil, fmt.Errorf("window must be > 0")
+	}
+	if !from.Before(to) {
+		return nil, fmt.Errorf("from must be before to")
+	}
+	var windows []backfillWindow
+	for start := from; start.Before(to); start = start.Add(step) {
+		end := start.Add(step)
+		if end.After(to) {
+			end = to
+		}
+		windows = append(windows, backfillWindow{from: start, to: end})
+	}
+	return windows, nil
+}
+
+// prepareBackfill keeps only doc's arXiv sources and clears their relative
+// windows; each run pins the window through the context instead, so neither
+// since_last_run state nor other sources are touched. Without deliver nothing
+// is remembered either: no dedupe store and no dedupe fingerprints, so papers
+// a dry run reads are still delivered by later runs.
+func prepareBackfill(doc *config.CuratorDocument, deliver bool) {
+	var sources []config.SourceConfig
+	for _, source := range doc.Workflow.Sources {
+		if source.Arxiv == nil {
+			continue
+		}
+		source.Arxiv.SinceLastRun = false
+		source.Arxiv.Lookback = ""
+		source.Arxiv.DateFrom = ""
+		source.Arxiv.DateTo = ""
+		sources = append(sources, source)
+	}
+	doc.Workflow.Sources = sources
+	if deliver {
+		return
+	}
+	doc.Workflow.DedupeStore = nil
+	for _, quality := range doc.Workflow.Quality {
+		if quality.Dedupe != nil {
+			quality.Dedupe.Remember = ""
+		}
+	}
+}
+
+func hasArxivSource(doc *config.CuratorDocument) bool {
+	for _, source := range doc.Workflow.Sources {
+		if source.Arxiv != nil {
+			return true
+		}
+	}
+	return false
+}
+
+func parseBackfillTime(raw string) (time.Time, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return time.Time{}, fmt.Errorf("a date is required")
+	}
+	if parsed, err := time.Parse(time.RFC3339, raw); err == nil {
+		return parsed.UTC(), nil
+	}
+	parsed, err := time.Parse("2006-01-02", raw)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("expected YYYY-MM-DD or RFC3339, got %q", raw)
+	}
+	return parsed, nil
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bakkerme/curator-ai/internal/config"
+)
+
+func TestBackfillWindows_SplitsRangeAndTrimsLastWindow(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 1, 17, 0, 0, 0, 0, time.UTC)
+	windows, err := backfillWindows(from, to, 7*24*time.Hour)
+	if err != nil {
+		t.Fatalf("backfillWindows: %v", err)
+	}
+	if len(windows) != 3 {
+		t.Fatalf("expected 3 windows, got %d", len(windows))
+	}
+	if !windows[1].from.Equal(from.Add(7*24*time.Hour)) || !windows[2].to.Equal(to) {
+		t.Fatalf("unexpected windows: %+v", windows)
+	}
+	if _, err := backfillWindows(to, from, time.Hour); err == nil {
+		t.Fatalf("expected error for inverted range")
+	}
+}
+
+func TestPrepareBackfill_KeepsArxivSourcesAndForgetsNothingWithoutDeliver(t *testing.T) {
+	newDoc := func() *config.CuratorDocument {
+		return &config.CuratorDocument{Workflow: config.Workflow{
+			Sources: []config.SourceConfig{
+				{Arxiv: &config.ArxivSource{Categories: []string{"cs.CL"}, SinceLastRun: true, Lookback: "2d", DateFrom: "2020-01-01"}},
+				{RSS: &config.RSSSource{Feeds: []string{"https://example.com/rss"}}},
+			},
+			Quality:     []config.QualityConfig{{Dedupe: &config.DedupeQuality{Name: "dedupe", Remember: "7d"}}},
+			DedupeStore: &config.DedupeStoreConfig{Driver: "sqlite"},
+		}}
+	}
+
+	doc := newDoc()
+	prepareBackfill(doc, false)
+	if len(doc.Workflow.Sources) != 1 || doc.Workflow.Sources[0].Arxiv == nil {
+		t.Fatalf("expected only the arXiv source to remain, got %+v", doc.Workflow.Sources)
+	}
+	arxiv := doc.Workflow.Sources[0].Arxiv
+	if arxiv.SinceLastRun || arxiv.Lookback != "" || arxiv.DateFrom != "" {
+		t.Fatalf("expected the configured window to be cleared, got %+v", arxiv)
+	}
+	if doc.Workflow.DedupeStore != nil || doc.Workflow.Quality[0].Dedupe.Remember != "" {
+		t.Fatalf("expected a dry run to remember nothing, got store %+v and remember %q", doc.Workflow.DedupeStore, doc.Workflow.Quality[0].Dedupe.Remember)
+	}
+
+	doc = newDoc()
+	prepareBackfill(doc, true)
+	if doc.Workflow.DedupeStore == nil || doc.Workflow.Quality[0].Dedupe.Remember != "7d" {
+		t.Fatalf("expected a delivering backfill to keep its dedupe state")
+	}
+	if !hasArxivSource(doc) {
+		t.Fatalf("expected document to report an arxiv source")
+	}
+}
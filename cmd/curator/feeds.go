@@ -0,0 +1,201 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/bakkerme/curator-ai/internal/config"
+	"github.com/bakkerme/curator-ai/internal/runner/factory"
+	"github.com/bakkerme/curator-ai/internal/sources/rss"
+	"github.com/bakkerme/curator-ai/internal/sources/rss/health"
+)
+
+const feedsUsage = `usage: curator feeds <command> [flags]
+
+commands:
+  health       list dead and stale RSS feeds recorded by feed_health
+  export-opml  write the RSS feeds used by a document as OPML`
+
+// runFeeds dispatches the `curator feeds` subcommands.
+func runFeeds(ctx context.Context, env config.EnvConfig, args []string, out io.Writer) error {
+	if len(args) == 0 {
+		return fmt.Errorf("%s", feedsUsage)
+	}
+	switch args[0] {
+	case "health":
+		return runFeedsHealth(ctx, env, args[1:], out)
+	case "export-opml":
+		return runFeedsExportOPML(ctx, env, args[1:], out)
+	default:
+		return fmt.Errorf("unknown feeds command %q\n%s", args[0], feedsUsage)
+	}
+}
+
+func runFeedsHealth(ctx context.Context, env config.EnvConfig, args []string, out io.Writer) error {
+	fs := flag.NewFlagSet("feeds health", flag.ContinueOnError)
+	fs.SetOutput(out)
+	configPath := fs.String("config", env.CuratorConfigPath, "path to curator document file or directory")
+	staleAfter := fs.String("stale-after", "14d", "report feeds whose newest item is older than this")
+	deadAfter := fs.Int("dead-after", 3, "report feeds that failed this many fetches in a row")
+	all := fs.Bool("all", false, "list healthy feeds too")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	stale, err := config.ParseDurationExtended(*staleAfter)
+	if err != nil {
+		return fmt.Errorf("stale-after: %w", err)
+	}
+
+	cfgs, err := feedHealthConfigs(*configPath)
+	if err != nil {
+		return err
+	}
+	var feeds []health.FeedHealth
+	for _, cfg := range cfgs {
+		store, err := factory.OpenFeedHealthStore(cfg)
+		if err != nil {
+			return err
+		}
+		listed, err := store.List(ctx)
+		_ = store.Close()
+		if err != nil {
+			return fmt.Errorf("list feed health: %w", err)
+		}
+		feeds = append(feeds, listed...)
+	}
+
+	return writeFeedHealthReport(out, feeds, time.Now().UTC(), stale, *deadAfter, *all)
+}
+
+func runFeedsExportOPML(ctx context.Context, env config.EnvConfig, args []string, out io.Writer) error {
+	fs := flag.NewFlagSet("feeds export-opml", flag.ContinueOnError)
+	fs.SetOutput(out)
+	configPath := fs.String("config", env.CuratorConfigPath, "path to curator document file or directory")
+	outputPath := fs.String("o", "", "write OPML to this file instead of stdout")
+	title := fs.String("title", "Curator feeds", "OPML head title")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	loaded, err := config.LoadCuratorDocuments(*configPath)
+	if err != nil {
+		return fmt.Errorf("load curator documents: %w", err)
+	}
+	feeds, err := documentFeeds(ctx, loaded)
+	if err != nil {
+		return err
+	}
+
+	if *outputPath == "" {
+		return rss.WriteOPML(out, *title, feeds)
+	}
+	f, err := os.Create(*outputPath)
+	if err != nil {
+		return fmt.Errorf("create %s: %w", *outputPath, err)
+	}
+	if err := rss.WriteOPML(f, *title, feeds); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// documentFeeds collects the RSS feeds of every rss source in the loaded
+// documents, resolving OPML references, with duplicates removed.
+func documentFeeds(ctx context.Context, loaded []config.LoadedCuratorDocument) ([]rss.Feed, error) {
+	seen := map[string]bool{}
+	var feeds []rss.Feed
+	for _, doc := range loaded {
+		for _, source := range doc.Document.Workflow.Sources {
+			if source.RSS == nil {
+				continue
+			}
+			resolved, err := rss.ResolveFeeds(ctx, source.RSS.Feeds, source.RSS.OPML, source.RSS.UserAgent)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", doc.Path, err)
+			}
+			for _, feed := range resolved {
+				if seen[feed.URL] {
+					continue
+				}
+				seen[feed.URL] = true
+				feeds = append(feeds, feed)
+			}
+		}
+	}
+	return feeds, nil
+}
+
+// feedHealthConfigs returns the distinct feed_health stores referenced by the
+// curator documents at path, falling back to the default store.
+func feedHealthConfigs(path string) ([]*config.FeedHealthConfig, error) {
+	loaded, err := config.LoadCuratorDocuments(path)
+	if err != nil {
+		return nil, fmt.Errorf("load curator documents: %w", err)
+	}
+	seen := map[string]bool{}
+	var cfgs []*config.FeedHealthConfig
+	for _, doc := range loaded {
+		cfg := doc.Document.Workflow.FeedHealth
+		if cfg == nil {
+			continue
+		}
+		key := strings.TrimSpace(cfg.DSN) + "|" + strings.TrimSpace(cfg.Table)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		cfgs = append(cfgs, cfg)
+	}
+	if len(cfgs) == 0 {
+		cfgs = append(cfgs, &config.FeedHealthConfig{})
+	}
+	return cfgs, nil
+}
+
+func writeFeedHealthReport(out io.Writer, feeds []health.FeedHealth, now time.Time, staleAfter time.Duration, deadAfter int, all bool) error {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "STATUS\tFEED\tLAST SUCCESS\tLAST ITEM\tFAILURES\tAVG ITEMS\tLAST ERROR")
+	listed := 0
+	for _, feed := range feeds {
+		status := health.Classify(feed, now, staleAfter, deadAfter)
+		if status == health.StatusOK && !all {
+			continue
+		}
+		listed++
+		lastError := ""
+		if feed.ConsecutiveFailures > 0 {
+			lastError = feed.LastError
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d\t%.1f\t%s\n",
+			status,
+			feed.FeedURL,
+			formatReportTime(feed.LastSuccessAt),
+			formatReportTime(feed.LastItemAt),
+			feed.ConsecutiveFailures,
+			feed.AverageItems(),
+			lastError,
+		)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	if listed == 0 {
+		_, err := fmt.Fprintf(out, "all %d tracked feeds are healthy\n", len(feeds))
+		return err
+	}
+	return nil
+}
+
+func formatReportTime(t time.Time) string {
+	if t.IsZero() {
+		return "never"
+	}
+	return t.UTC().Format("2006-01-02 15:04")
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bakkerme/curator-ai/internal/config"
+	"github.com/bakkerme/curator-ai/internal/sources/rss"
+	"github.com/bakkerme/curator-ai/internal/sources/rss/health"
+)
+
+func TestWriteFeedHealthReport_ListsDeadAndStaleFeeds(t *testing.T) {
+	now := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	feeds := []health.FeedHealth{
+		{FeedURL: "https://ok.example.com/rss", LastSuccessAt: now, LastItemAt: now.Add(-time.Hour), SuccessCount: 2, TotalItems: 10},
+		{FeedURL: "https://dead.example.com/rss", ConsecutiveFailures: 4, LastError: "no such host"},
+		{FeedURL: "https://stale.example.com/rss", LastSuccessAt: now, LastItemAt: now.Add(-60 * 24 * time.Hour), SuccessCount: 1, TotalItems: 3},
+	}
+
+	var out bytes.Buffer
+	if err := writeFeedHealthReport(&out, feeds, now, 14*24*time.Hour, 3, false); err != nil {
+		t.Fatalf("write report: %v", err)
+	}
+	report := out.String()
+	if strings.Contains(report, "ok.example.com") {
+		t.Fatalf("expected healthy feed to be omitted:\n%s", report)
+	}
+	for _, want := range []string{"dead", "https://dead.example.com/rss", "no such host", "stale", "https://stale.example.com/rss", "3.0"} {
+		if !strings.Contains(report, want) {
+			t.Fatalf("expected %q in report:\n%s", want, report)
+		}
+	}
+}
+
+func TestWriteFeedHealthReport_AllHealthy(t *testing.T) {
+	now := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	feeds := []health.FeedHealth{{FeedURL: "https://ok.example.com/rss", LastSuccessAt: now, LastItemAt: now}}
+
+	var out bytes.Buffer
+	if err := writeFeedHealthReport(&out, feeds, now, 14*24*time.Hour, 3, false); err != nil {
+		t.Fatalf("write report: %v", err)
+	}
+	if !strings.Contains(out.String(), "all 1 tracked feeds are healthy") {
+		t.Fatalf("unexpected report:\n%s", out.String())
+	}
+}
+
+func TestRunFeedsExportOPML_WritesDocumentFeeds(t *testing.T) {
+	dir := t.TempDir()
+	docPath := filepath.Join(dir, "curator.yaml")
+	doc := `
+workflow:
+  name: "Feeds"
+  trigger:
+    - cron:
+        schedule: "0 0 * * *"
+  sources:
+    - rss:
+        feeds:
+          - "https://a.example.com/rss"
+          - "https://b.example.com/rss"
+    - rss:
+        feeds:
+          - "https://a.example.com/rss"
+  output:
+    - email:
+        template: "Hello"
+        to: "test@example.com"
+        from: "noreply@example.com"
+        subject: "Daily Report"
+`
+	if err := os.WriteFile(docPath, []byte(doc), 0o644); err != nil {
+		t.Fatalf("write doc: %v", err)
+	}
+
+	var out bytes.Buffer
+	if err := runFeeds(context.Background(), config.EnvConfig{}, []string{"export-opml", "-config", docPath}, &out); err != nil {
+		t.Fatalf("export failed: %v", err)
+	}
+	feeds, err := rss.ParseOPML(&out)
+	if err != nil {
+		t.Fatalf("parse exported opml: %v", err)
+	}
+	if len(feeds) != 2 || feeds[0].URL != "https://a.example.com/rss" || feeds[1].URL != "https://b.example.com/rss" {
+		t.Fatalf("unexpected exported feeds: %+v", feeds)
+	}
+}
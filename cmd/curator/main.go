@@ -35,6 +35,19 @@ func main() {
 		log.Panicf("failed to load environment: %v", err)
 	}
 
+	if len(os.Args) > 1 && os.Args[1] == "feeds" {
+		if err := runFeeds(context.Background(), env, os.Args[2:], os.Stdout); err != nil {
+			log.Fatalf("feeds: %v", err)
+		}
+		return
+	}
+	if len(os.Args) > 1 && os.Args[1] == "arxiv" {
+		if err := runArxiv(context.Background(), env, os.Args[2:], os.Stdout); err != nil {
+			log.Fatalf("arxiv: %v", err)
+		}
+		return
+	}
+
 	configPath := flag.String("config", env.CuratorConfigPath, "path to curator document file or directory")
 	flowID := flag.String("flow-id", env.FlowID, "flow identifier")
 	runOnce := flag.Bool("run-once", env.RunOnce, "run once and exit")
@@ -20,9 +20,18 @@ type EnvConfig struct {
 	Crawl4AI                 Crawl4AIEnvConfig
 	Docling                  DoclingEnvConfig
 	Arxiv                    ArxivEnvConfig
+	Biorxiv                  BiorxivEnvConfig
+	HuggingFace              HuggingFaceEnvConfig
+	Mastodon                 MastodonEnvConfig
+	Bluesky                  BlueskyEnvConfig
+	Forum                    ForumEnvConfig
+	Media                    MediaEnvConfig
+	URLs                     URLsEnvConfig
+	SemanticScholar          SemanticScholarEnvConfig
 	Reddit                   RedditEnvConfig
 	RSS                      RSSEnvConfig
 	Scrape                   ScrapeEnvConfig
+	Images                   ImagesEnvConfig
 	SMTP                     SMTPEnvConfig
 }
 
@@ -64,9 +73,56 @@ type DoclingEnvConfig struct {
 }
 
 type ArxivEnvConfig struct {
+	BaseURL      string
+	HTTPTimeout  time.Duration
+	UserAgent    string
+	RequestDelay time.Duration // ARXIV_REQUEST_DELAY, default 3s
+}
+
+type BiorxivEnvConfig struct {
+	BaseURL     string        // BIORXIV_BASE_URL, default https://api.biorxiv.org
+	HTTPTimeout time.Duration // BIORXIV_HTTP_TIMEOUT, default 15s
+	UserAgent   string        // BIORXIV_USER_AGENT
+}
+
+type HuggingFaceEnvConfig struct {
+	BaseURL     string        // HUGGINGFACE_BASE_URL, default https://huggingface.co
+	Token       string        // HUGGINGFACE_TOKEN, optional
+	HTTPTimeout time.Duration // HUGGINGFACE_HTTP_TIMEOUT, default 15s
+}
+
+type MastodonEnvConfig struct {
+	AccessToken string        // MASTODON_ACCESS_TOKEN, optional default for mastodon sources
+	HTTPTimeout time.Duration // MASTODON_HTTP_TIMEOUT, default 15s
+	UserAgent   string        // MASTODON_USER_AGENT
+}
+
+type BlueskyEnvConfig struct {
+	BaseURL     string        // BLUESKY_BASE_URL, default https://public.api.bsky.app
+	HTTPTimeout time.Duration // BLUESKY_HTTP_TIMEOUT, default 15s
+	UserAgent   string        // BLUESKY_USER_AGENT
+}
+
+type ForumEnvConfig struct {
+	HTTPTimeout time.Duration // FORUM_HTTP_TIMEOUT, default 15s
+	UserAgent   string        // FORUM_USER_AGENT
+}
+
+type MediaEnvConfig struct {
+	YTDLPPath   string        // YTDLP_PATH, default "yt-dlp" on PATH
+	HTTPTimeout time.Duration // MEDIA_HTTP_TIMEOUT, default 30s
+	UserAgent   string        // MEDIA_USER_AGENT
+}
+
+type URLsEnvConfig struct {
+	HTTPTimeout time.Duration // URLS_HTTP_TIMEOUT, default 30s
+	UserAgent   string        // URLS_USER_AGENT
+}
+
+type SemanticScholarEnvConfig struct {
 	BaseURL     string
+	APIKey      string
 	HTTPTimeout time.Duration
-	UserAgent   string
 }
 
 type RedditEnvConfig struct {
@@ -90,6 +146,11 @@ type ScrapeEnvConfig struct {
 	UserAgent   string
 }
 
+type ImagesEnvConfig struct {
+	HTTPTimeout time.Duration
+	UserAgent   string
+}
+
 type SMTPEnvConfig struct {
 	Host               string
 	Port               int
@@ -150,9 +211,48 @@ func LoadEnv() (EnvConfig, error) {
 			HTTPTimeout: envDuration("DOCLING_HTTP_TIMEOUT", 60*time.Second),
 		},
 		Arxiv: ArxivEnvConfig{
-			BaseURL:     strings.TrimSpace(envString("ARXIV_BASE_URL", "")),
-			HTTPTimeout: envDuration("ARXIV_HTTP_TIMEOUT", 10*time.Second),
-			UserAgent:   envString("ARXIV_USER_AGENT", "curator-ai/0.1"),
+			BaseURL:      strings.TrimSpace(envString("ARXIV_BASE_URL", "")),
+			HTTPTimeout:  envDuration("ARXIV_HTTP_TIMEOUT", 10*time.Second),
+			UserAgent:    envString("ARXIV_USER_AGENT", "curator-ai/0.1"),
+			RequestDelay: envDuration("ARXIV_REQUEST_DELAY", 3*time.Second),
+		},
+		Biorxiv: BiorxivEnvConfig{
+			BaseURL:     strings.TrimSpace(envString("BIORXIV_BASE_URL", "")),
+			HTTPTimeout: envDuration("BIORXIV_HTTP_TIMEOUT", 15*time.Second),
+			UserAgent:   envString("BIORXIV_USER_AGENT", "curator-ai/0.1"),
+		},
+		HuggingFace: HuggingFaceEnvConfig{
+			BaseURL:     strings.TrimSpace(envString("HUGGINGFACE_BASE_URL", "")),
+			Token:       envString("HUGGINGFACE_TOKEN", ""),
+			HTTPTimeout: envDuration("HUGGINGFACE_HTTP_TIMEOUT", 15*time.Second),
+		},
+		Mastodon: MastodonEnvConfig{
+			AccessToken: envString("MASTODON_ACCESS_TOKEN", ""),
+			HTTPTimeout: envDuration("MASTODON_HTTP_TIMEOUT", 15*time.Second),
+			UserAgent:   envString("MASTODON_USER_AGENT", "curator-ai/0.1"),
+		},
+		Bluesky: BlueskyEnvConfig{
+			BaseURL:     strings.TrimSpace(envString("BLUESKY_BASE_URL", "")),
+			HTTPTimeout: envDuration("BLUESKY_HTTP_TIMEOUT", 15*time.Second),
+			UserAgent:   envString("BLUESKY_USER_AGENT", "curator-ai/0.1"),
+		},
+		Forum: ForumEnvConfig{
+			HTTPTimeout: envDuration("FORUM_HTTP_TIMEOUT", 15*time.Second),
+			UserAgent:   envString("FORUM_USER_AGENT", "curator-ai/0.1"),
+		},
+		Media: MediaEnvConfig{
+			YTDLPPath:   strings.TrimSpace(envString("YTDLP_PATH", "yt-dlp")),
+			HTTPTimeout: envDuration("MEDIA_HTTP_TIMEOUT", 30*time.Second),
+			UserAgent:   envString("MEDIA_USER_AGENT", "curator-ai/0.1"),
+		},
+		URLs: URLsEnvConfig{
+			HTTPTimeout: envDuration("URLS_HTTP_TIMEOUT", 30*time.Second),
+			UserAgent:   envString("URLS_USER_AGENT", "curator-ai/0.1"),
+		},
+		SemanticScholar: SemanticScholarEnvConfig{
+			BaseURL:     strings.TrimSpace(envString("SEMANTIC_SCHOLAR_BASE_URL", "")),
+			APIKey:      envString("SEMANTIC_SCHOLAR_API_KEY", ""),
+			HTTPTimeout: envDuration("SEMANTIC_SCHOLAR_HTTP_TIMEOUT", 15*time.Second),
 		},
 		Reddit: RedditEnvConfig{
 			HTTPTimeout:  envDuration("REDDIT_HTTP_TIMEOUT", 10*time.Second),
@@ -172,6 +272,10 @@ func LoadEnv() (EnvConfig, error) {
 			HTTPTimeout: envDuration("SCRAPE_HTTP_TIMEOUT", 10*time.Second),
 			UserAgent:   envString("SCRAPE_USER_AGENT", "curator-ai/0.1"),
 		},
+		Images: ImagesEnvConfig{
+			HTTPTimeout: envDuration("IMAGES_HTTP_TIMEOUT", 15*time.Second),
+			UserAgent:   envString("IMAGES_USER_AGENT", "curator-ai/0.1"),
+		},
 		SMTP: SMTPEnvConfig{
 			Host:               envString("SMTP_HOST", ""),
 			Port:               envInt("SMTP_PORT", 587),
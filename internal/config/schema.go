@@ -2,7 +2,11 @@ package config
 
 import (
 	"fmt"
+	"math"
 	"net/mail"
+	"net/url"
+	"regexp"
+	"slices"
 	"strings"
 	"time"
 
@@ -30,6 +34,9 @@ type Workflow struct {
 	Version        string             `yaml:"version,omitempty"`
 	MaxConcurrency int                `yaml:"max_concurrency,omitempty"`
 	DedupeStore    *DedupeStoreConfig `yaml:"dedupe_store,omitempty"`
+	ReaderCache    *ReaderCacheConfig `yaml:"reader_cache,omitempty"`
+	FeedHealth     *FeedHealthConfig  `yaml:"feed_health,omitempty"`
+	StateStore     *StateStoreConfig  `yaml:"state_store,omitempty"`
 	Trigger        []TriggerConfig    `yaml:"trigger"`
 	Sources        []SourceConfig     `yaml:"sources"`
 	Quality        []QualityConfig    `yaml:"quality,omitempty"`
@@ -51,11 +58,19 @@ type CronTrigger struct {
 
 // SourceConfig wraps different source types
 type SourceConfig struct {
-	Reddit   *RedditSource   `yaml:"reddit,omitempty"`
-	RSS      *RSSSource      `yaml:"rss,omitempty"`
-	Arxiv    *ArxivSource    `yaml:"arxiv,omitempty"`
-	Scrape   *ScrapeSource   `yaml:"scrape,omitempty"`
-	TestFile *TestFileSource `yaml:"testfile,omitempty"`
+	Reddit      *RedditSource      `yaml:"reddit,omitempty"`
+	RSS         *RSSSource         `yaml:"rss,omitempty"`
+	Arxiv       *ArxivSource       `yaml:"arxiv,omitempty"`
+	Biorxiv     *BiorxivSource     `yaml:"biorxiv,omitempty"`
+	HuggingFace *HuggingFaceSource `yaml:"huggingface,omitempty"`
+	Mastodon    *MastodonSource    `yaml:"mastodon,omitempty"`
+	Bluesky     *BlueskySource     `yaml:"bluesky,omitempty"`
+	Forum       *ForumSource       `yaml:"forum,omitempty"`
+	Media       *MediaSource       `yaml:"media,omitempty"`
+	Watch       *WatchSource       `yaml:"watch,omitempty"`
+	URLs        *URLsSource        `yaml:"urls,omitempty"`
+	Scrape      *ScrapeSource      `yaml:"scrape,omitempty"`
+	TestFile    *TestFileSource    `yaml:"testfile,omitempty"`
 }
 
 // ScrapeSource defines generic web scraping source configuration.
@@ -67,6 +82,8 @@ type ScrapeSource struct {
 	Extraction  ScrapeExtractionConfig `yaml:"extraction"`
 	Markdown    ScrapeMarkdownConfig   `yaml:"markdown,omitempty"`
 	Request     ScrapeRequestConfig    `yaml:"request,omitempty"`
+	Enrich      *EnrichConfig          `yaml:"enrich,omitempty"`
+	ImageFetch  *ImageFetchConfig      `yaml:"image_fetch,omitempty"`
 	SummaryPlan *SummaryPlanConfig     `yaml:"summary_plan,omitempty"`
 	Snapshot    *core.SnapshotConfig   `yaml:"snapshot,omitempty"`
 }
@@ -104,25 +121,61 @@ type ScrapeRequestConfig struct {
 
 // RedditSource defines Reddit data source configuration
 type RedditSource struct {
-	Subreddits      []string             `yaml:"subreddits"`
+	Subreddits      []string             `yaml:"subreddits,omitempty"`
+	Queries         []RedditQuery        `yaml:"queries,omitempty"`
 	Limit           int                  `yaml:"limit,omitempty"`
 	Sort            string               `yaml:"sort,omitempty"`
 	TimeFilter      string               `yaml:"time_filter,omitempty"`
 	IncludeComments bool                 `yaml:"include_comments,omitempty"`
+	CommentDepth    int                  `yaml:"comment_depth,omitempty"`
+	CommentLimits   []int                `yaml:"comment_limits,omitempty"`
+	MinCommentScore *int                 `yaml:"min_comment_score,omitempty"`
 	IncludeWeb      bool                 `yaml:"include_web,omitempty"`
 	IncludeImages   bool                 `yaml:"include_images,omitempty"`
 	MinScore        int                  `yaml:"min_score,omitempty"`
+	Enrich          *EnrichConfig        `yaml:"enrich,omitempty"`
+	ImageFetch      *ImageFetchConfig    `yaml:"image_fetch,omitempty"`
 	SummaryPlan     *SummaryPlanConfig   `yaml:"summary_plan,omitempty"`
 	Snapshot        *core.SnapshotConfig `yaml:"snapshot,omitempty"`
 }
 
+// FullArticleConfig fetches an item's link through the web reader when the
+// feed only ships a teaser. When is "short" (default, content shorter than
+// MinLength visible characters) or "always"; Mode is "replace" (default) or
+// "append" to keep the teaser above the article.
+type FullArticleConfig struct {
+	When      string `yaml:"when,omitempty"`
+	MinLength int    `yaml:"min_length,omitempty"`
+	Mode      string `yaml:"mode,omitempty"`
+}
+
+// RedditQuery is a single listing fetched for a reddit source. Exactly one of
+// Subreddit, User or Multireddit selects the listing; Search turns it into a
+// search, restricted to Subreddit when one is set. Sort, Limit, TimeFilter and
+// MinScore default to the source-level values.
+type RedditQuery struct {
+	Subreddit   string `yaml:"subreddit,omitempty"`
+	User        string `yaml:"user,omitempty"`
+	Multireddit string `yaml:"multireddit,omitempty"`
+	Search      string `yaml:"search,omitempty"`
+	Sort        string `yaml:"sort,omitempty"`
+	Limit       int    `yaml:"limit,omitempty"`
+	TimeFilter  string `yaml:"time_filter,omitempty"`
+	MinScore    *int   `yaml:"min_score,omitempty"`
+}
+
 // RSSSource defines RSS/Atom feed configuration
 type RSSSource struct {
-	Feeds                   []string             `yaml:"feeds"`
+	Feeds                   []string             `yaml:"feeds,omitempty"`
+	OPML                    string               `yaml:"opml,omitempty"`
 	Limit                   int                  `yaml:"limit,omitempty"`
 	IncludeContent          *bool                `yaml:"include_content,omitempty"`
 	ConvertSourceToMarkdown bool                 `yaml:"convert_source_to_markdown,omitempty"`
 	UserAgent               string               `yaml:"user_agent,omitempty"`
+	MaxConcurrency          int                  `yaml:"max_concurrency,omitempty"`
+	FetchFullArticle        *FullArticleConfig   `yaml:"fetch_full_article,omitempty"`
+	Enrich                  *EnrichConfig        `yaml:"enrich,omitempty"`
+	ImageFetch              *ImageFetchConfig    `yaml:"image_fetch,omitempty"`
 	SummaryPlan             *SummaryPlanConfig   `yaml:"summary_plan,omitempty"`
 	Snapshot                *core.SnapshotConfig `yaml:"snapshot,omitempty"`
 }
@@ -136,15 +189,270 @@ type ArxivSource struct {
 	SortOrder  string   `yaml:"sort_order,omitempty"`
 	DateFrom   string   `yaml:"date_from,omitempty"`
 	DateTo     string   `yaml:"date_to,omitempty"`
+	// Lookback fetches papers submitted within this window before each run
+	// (e.g. "2d"). With SinceLastRun it is only used for the first run.
+	Lookback string `yaml:"lookback,omitempty"`
+	// SinceLastRun starts each window where the last successful run ended.
+	// Requires workflow.state_store.
+	SinceLastRun bool `yaml:"since_last_run,omitempty"`
+	// PageSize is the number of results requested per API call when
+	// MaxResults needs more than one page (default: 100).
+	PageSize int `yaml:"page_size,omitempty"`
+	// ResurfaceRevisions windows on the last-updated date and treats each new
+	// version (v2, v3, ...) of a seen paper as a new item.
+	ResurfaceRevisions bool `yaml:"resurface_revisions,omitempty"`
+	// Citations adds citation counts, venue and TLDR from a Semantic
+	// Scholar-compatible API (SEMANTIC_SCHOLAR_BASE_URL) to block metadata.
+	Citations *CitationsConfig `yaml:"citations,omitempty"`
+	// Watchlist flags papers by watched authors or affiliations.
+	Watchlist *WatchlistConfig `yaml:"watchlist,omitempty"`
+	// FullText selects where full text comes from: "html_first" (default) reads
+	// the arXiv HTML rendering and falls back to the PDF via Docling, "pdf_only"
+	// always uses the PDF and "html_only" never converts PDFs.
+	FullText string `yaml:"full_text,omitempty"`
 	// AbstractOnly forces PostBlock content/chunks to be built from the abstract only.
 	// When enabled, the processor skips full-text fetches via Jina.
 	AbstractOnly            *bool                `yaml:"abstract_only,omitempty"`
 	IncludeAbstractInChunks *bool                `yaml:"include_abstract_in_chunks,omitempty"`
 	Chunking                *ArxivChunkingConfig `yaml:"chunking,omitempty"`
+	Enrich                  *EnrichConfig        `yaml:"enrich,omitempty"`
+	ImageFetch              *ImageFetchConfig    `yaml:"image_fetch,omitempty"`
+	SummaryPlan             *SummaryPlanConfig   `yaml:"summary_plan,omitempty"`
+	Snapshot                *core.SnapshotConfig `yaml:"snapshot,omitempty"`
+}
+
+// BiorxivSource defines bioRxiv/medRxiv details API configuration. Preprints are
+// fetched, chunked and summarized like arXiv papers.
+type BiorxivSource struct {
+	// Server is "biorxiv" (default) or "medrxiv".
+	Server string `yaml:"server,omitempty"`
+	// Categories keeps only preprints in these subject categories
+	// (e.g. "bioinformatics", "genomics"); empty keeps all.
+	Categories []string `yaml:"categories,omitempty"`
+	MaxResults int      `yaml:"max_results,omitempty"`
+	DateFrom   string   `yaml:"date_from,omitempty"`
+	DateTo     string   `yaml:"date_to,omitempty"`
+	// Lookback fetches preprints posted within this window before each run
+	// (default: "1d" when no dates are set).
+	Lookback string `yaml:"lookback,omitempty"`
+	// AbstractOnly skips full-text PDF conversion.
+	AbstractOnly            *bool                `yaml:"abstract_only,omitempty"`
+	IncludeAbstractInChunks *bool                `yaml:"include_abstract_in_chunks,omitempty"`
+	Chunking                *ArxivChunkingConfig `yaml:"chunking,omitempty"`
+	Enrich                  *EnrichConfig        `yaml:"enrich,omitempty"`
+	ImageFetch              *ImageFetchConfig    `yaml:"image_fetch,omitempty"`
 	SummaryPlan             *SummaryPlanConfig   `yaml:"summary_plan,omitempty"`
 	Snapshot                *core.SnapshotConfig `yaml:"snapshot,omitempty"`
 }
 
+// HuggingFaceSource defines Hugging Face Hub configuration. At least one of
+// DailyPapers or Models is required.
+type HuggingFaceSource struct {
+	DailyPapers *HFDailyPapersConfig `yaml:"daily_papers,omitempty"`
+	Models      *HFModelsConfig      `yaml:"models,omitempty"`
+	Enrich      *EnrichConfig        `yaml:"enrich,omitempty"`
+	ImageFetch  *ImageFetchConfig    `yaml:"image_fetch,omitempty"`
+	SummaryPlan *SummaryPlanConfig   `yaml:"summary_plan,omitempty"`
+	Snapshot    *core.SnapshotConfig `yaml:"snapshot,omitempty"`
+}
+
+// HFDailyPapersConfig selects papers from the Daily Papers feed.
+type HFDailyPapersConfig struct {
+	// Date is a YYYY-MM-DD day; empty reads the latest day.
+	Date       string `yaml:"date,omitempty"`
+	MinUpvotes int    `yaml:"min_upvotes,omitempty"`
+	Limit      int    `yaml:"limit,omitempty"`
+}
+
+// HFModelsConfig selects models from the Hub listing.
+type HFModelsConfig struct {
+	// Sort is "trending" (default) or "created" for new model drops.
+	Sort         string `yaml:"sort,omitempty"`
+	PipelineTag  string `yaml:"pipeline_tag,omitempty"`
+	Library      string `yaml:"library,omitempty"`
+	Search       string `yaml:"search,omitempty"`
+	Author       string `yaml:"author,omitempty"`
+	MinDownloads int    `yaml:"min_downloads,omitempty"`
+	MinLikes     int    `yaml:"min_likes,omitempty"`
+	// Limit caps how many models are listed before filtering (default: 20).
+	Limit int `yaml:"limit,omitempty"`
+}
+
+// MastodonSource defines Mastodon REST API configuration. At least one of
+// Hashtags, Lists or Accounts is required.
+type MastodonSource struct {
+	// Instance is the instance base URL, e.g. "https://sigmoid.social".
+	Instance string `yaml:"instance"`
+	// AccessToken overrides MASTODON_ACCESS_TOKEN; list timelines need one.
+	AccessToken string   `yaml:"access_token,omitempty"`
+	Hashtags    []string `yaml:"hashtags,omitempty"`
+	// Lists are list IDs owned by the token's account.
+	Lists []string `yaml:"lists,omitempty"`
+	// Accounts are handles (user or user@host) whose statuses are read.
+	Accounts []string `yaml:"accounts,omitempty"`
+	// Limit is the number of statuses read per timeline (default: 20, max: 40).
+	Limit int `yaml:"limit,omitempty"`
+	// Threads joins an author's self-replies into one post and attaches
+	// other accounts' replies as comments.
+	Threads       bool                 `yaml:"threads,omitempty"`
+	MinBoosts     int                  `yaml:"min_boosts,omitempty"`
+	MinFavourites int                  `yaml:"min_favourites,omitempty"`
+	Enrich        *EnrichConfig        `yaml:"enrich,omitempty"`
+	ImageFetch    *ImageFetchConfig    `yaml:"image_fetch,omitempty"`
+	SummaryPlan   *SummaryPlanConfig   `yaml:"summary_plan,omitempty"`
+	Snapshot      *core.SnapshotConfig `yaml:"snapshot,omitempty"`
+}
+
+// BlueskySource defines Bluesky AppView configuration. At least one of
+// Authors, Feeds or Searches is required.
+type BlueskySource struct {
+	// Authors are handles or DIDs whose posts and self-threads are read.
+	Authors []string `yaml:"authors,omitempty"`
+	// Feeds are feed generator at:// URIs.
+	Feeds    []string `yaml:"feeds,omitempty"`
+	Searches []string `yaml:"searches,omitempty"`
+	// SearchSort orders search results: "latest" (default) or "top".
+	SearchSort string `yaml:"search_sort,omitempty"`
+	// Limit is the number of posts read per feed (default: 25, max: 100).
+	Limit int `yaml:"limit,omitempty"`
+	// Threads fetches each post's thread and attaches other accounts'
+	// replies as comments.
+	Threads     bool                 `yaml:"threads,omitempty"`
+	Enrich      *EnrichConfig        `yaml:"enrich,omitempty"`
+	ImageFetch  *ImageFetchConfig    `yaml:"image_fetch,omitempty"`
+	SummaryPlan *SummaryPlanConfig   `yaml:"summary_plan,omitempty"`
+	Snapshot    *core.SnapshotConfig `yaml:"snapshot,omitempty"`
+}
+
+// ForumSource defines a Discourse or Lemmy forum configuration.
+type ForumSource struct {
+	// Flavour is "discourse" or "lemmy".
+	Flavour string `yaml:"flavour"`
+	// BaseURL is the forum or instance URL, e.g. "https://discuss.pytorch.org".
+	BaseURL string `yaml:"base_url"`
+	// Categories are Discourse category slugs; empty reads the whole forum.
+	Categories []string `yaml:"categories,omitempty"`
+	// Communities are Lemmy community names (name or name@instance).
+	Communities []string `yaml:"communities,omitempty"`
+	// Sort is "latest" (default) or "top".
+	Sort string `yaml:"sort,omitempty"`
+	// Period bounds "top": "day", "week" (default), "month", "year" or "all".
+	Period string `yaml:"period,omitempty"`
+	// Limit is the number of topics read per category or community (default: 10, max: 50).
+	Limit int `yaml:"limit,omitempty"`
+	// MaxReplies is the number of most-liked replies kept per topic (default: 20, max: 50).
+	MaxReplies  int                  `yaml:"max_replies,omitempty"`
+	Enrich      *EnrichConfig        `yaml:"enrich,omitempty"`
+	ImageFetch  *ImageFetchConfig    `yaml:"image_fetch,omitempty"`
+	SummaryPlan *SummaryPlanConfig   `yaml:"summary_plan,omitempty"`
+	Snapshot    *core.SnapshotConfig `yaml:"snapshot,omitempty"`
+}
+
+// MediaSource defines podcast and YouTube transcript configuration. At least
+// one of Podcasts, Channels or Playlists is required.
+type MediaSource struct {
+	// Podcasts are podcast RSS feed URLs with audio enclosures.
+	Podcasts []string `yaml:"podcasts,omitempty"`
+	// Channels are YouTube channel IDs (UC...).
+	Channels []string `yaml:"channels,omitempty"`
+	// Playlists are YouTube playlist IDs.
+	Playlists []string `yaml:"playlists,omitempty"`
+	// Limit is the number of newest episodes read per feed (default: 5, max: 50).
+	Limit int `yaml:"limit,omitempty"`
+	// SubtitleLanguages are preferred subtitle languages in order (default: ["en"]).
+	SubtitleLanguages []string `yaml:"subtitle_languages,omitempty"`
+	// Transcription enables transcribing audio when no transcript or
+	// subtitles are published.
+	Transcription *MediaTranscriptionConfig `yaml:"transcription,omitempty"`
+	Enrich        *EnrichConfig             `yaml:"enrich,omitempty"`
+	ImageFetch    *ImageFetchConfig         `yaml:"image_fetch,omitempty"`
+	SummaryPlan   *SummaryPlanConfig        `yaml:"summary_plan,omitempty"`
+	Snapshot      *core.SnapshotConfig      `yaml:"snapshot,omitempty"`
+}
+
+// MediaTranscriptionConfig configures the OpenAI-compatible
+// /audio/transcriptions fallback.
+type MediaTranscriptionConfig struct {
+	// Model defaults to "whisper-1".
+	Model string `yaml:"model,omitempty"`
+	// Language is an optional ISO-639-1 hint, e.g. "en".
+	Language string `yaml:"language,omitempty"`
+	// MaxAudioMB skips episodes with larger audio files (default: 25).
+	MaxAudioMB int `yaml:"max_audio_mb,omitempty"`
+}
+
+// WatchSource monitors pages without feeds and emits a diff when one changes
+// materially. The last reported version of each page is kept in
+// workflow.state_store, which is required.
+type WatchSource struct {
+	Pages []WatchPage `yaml:"pages"`
+	// Via is "scrape" (default; raw HTML through the scrape fetcher) or
+	// "reader" (markdown through the web reader, for JavaScript-heavy pages).
+	Via string `yaml:"via,omitempty"`
+	// IgnoreSelectors are removed from every page before comparing, e.g.
+	// timestamps and ads. Only valid with via "scrape".
+	IgnoreSelectors []string `yaml:"ignore_selectors,omitempty"`
+	// IgnorePatterns are regular expressions; matching lines are dropped
+	// before comparing.
+	IgnorePatterns []string `yaml:"ignore_patterns,omitempty"`
+	// Threshold is the minimum fraction of lines changed, 0-1 (default: 0).
+	Threshold float64 `yaml:"threshold,omitempty"`
+	// MinChangedLines is the minimum number of changed lines (default: 1).
+	MinChangedLines int                  `yaml:"min_changed_lines,omitempty"`
+	Request         ScrapeRequestConfig  `yaml:"request,omitempty"`
+	Enrich          *EnrichConfig        `yaml:"enrich,omitempty"`
+	ImageFetch      *ImageFetchConfig    `yaml:"image_fetch,omitempty"`
+	SummaryPlan     *SummaryPlanConfig   `yaml:"summary_plan,omitempty"`
+	Snapshot        *core.SnapshotConfig `yaml:"snapshot,omitempty"`
+}
+
+// WatchPage is a single monitored page.
+type WatchPage struct {
+	URL string `yaml:"url"`
+	// Name is used in block titles (default: the page <title> or URL).
+	Name string `yaml:"name,omitempty"`
+	// Selector limits the comparison to matching elements (default: body).
+	// Only valid with via "scrape".
+	Selector string `yaml:"selector,omitempty"`
+	// IgnoreSelectors are removed in addition to the source-wide ones.
+	IgnoreSelectors []string `yaml:"ignore_selectors,omitempty"`
+}
+
+// URLsSource reads a fixed list of pages and documents, given inline or in a
+// file, and emits one block per URL.
+type URLsSource struct {
+	URLs []string `yaml:"urls,omitempty"`
+	// File lists one URL per line; blank lines and "#" comments are ignored.
+	File string `yaml:"file,omitempty"`
+	// HTMLReader is "builtin" (default; local HTML-to-markdown) or "crawl4ai".
+	HTMLReader  string               `yaml:"html_reader,omitempty"`
+	Enrich      *EnrichConfig        `yaml:"enrich,omitempty"`
+	ImageFetch  *ImageFetchConfig    `yaml:"image_fetch,omitempty"`
+	SummaryPlan *SummaryPlanConfig   `yaml:"summary_plan,omitempty"`
+	Snapshot    *core.SnapshotConfig `yaml:"snapshot,omitempty"`
+}
+
+// CitationsConfig enables citation enrichment for paper sources.
+type CitationsConfig struct {
+	// BatchSize caps how many papers are looked up per API request (default: 100, max: 500).
+	BatchSize int `yaml:"batch_size,omitempty"`
+}
+
+// WatchlistConfig lists authors and affiliations whose posts are flagged with
+// Metadata["watchlist"].
+type WatchlistConfig struct {
+	// Authors are matched loosely: initials, "Family, Given" order, accents
+	// and one-letter typos in longer names are tolerated.
+	Authors []string `yaml:"authors,omitempty"`
+	// Affiliations match when their words appear in an author's affiliation.
+	Affiliations []string `yaml:"affiliations,omitempty"`
+	// BypassQuality lets matching posts skip every quality processor.
+	BypassQuality bool `yaml:"bypass_quality,omitempty"`
+	// MustRead marks matching posts for the email "Must read" section
+	// (.MustRead); it implies BypassQuality.
+	MustRead bool `yaml:"must_read,omitempty"`
+}
+
 // ArxivChunkingConfig controls how arXiv paper content is split into chunks.
 type ArxivChunkingConfig struct {
 	Mode             string `yaml:"mode,omitempty"`
@@ -152,6 +460,37 @@ type ArxivChunkingConfig struct {
 	MinSectionChars  int    `yaml:"min_section_chars,omitempty"`
 }
 
+// EnrichConfig enables link enrichment for any source: links found in a post's
+// content are fetched through the web reader and attached as WebBlocks, and
+// image links are attached as ImageBlocks.
+type EnrichConfig struct {
+	// MaxLinks caps how many web links are fetched per post (default: 5).
+	MaxLinks int `yaml:"max_links,omitempty"`
+	// MaxImages caps how many image links are attached per post (default: 5).
+	MaxImages      int      `yaml:"max_images,omitempty"`
+	MaxConcurrency int      `yaml:"max_concurrency,omitempty"`
+	IncludeDomains []string `yaml:"include_domains,omitempty"`
+	ExcludeDomains []string `yaml:"exclude_domains,omitempty"`
+	// IncludeImages attaches image links as ImageBlocks (default: true).
+	IncludeImages *bool `yaml:"include_images,omitempty"`
+}
+
+// ImageFetchConfig downloads a source's ImageBlocks so multimodal requests send
+// image bytes (data URLs) instead of URLs the provider may fail to fetch.
+type ImageFetchConfig struct {
+	// MaxBytes rejects downloads larger than this many bytes (default: 10 MiB).
+	MaxBytes int64 `yaml:"max_bytes,omitempty"`
+	// MaxDimension downsizes images whose longer edge exceeds this many pixels (default: 1568).
+	MaxDimension int `yaml:"max_dimension,omitempty"`
+	// MinDimension drops tracking pixels and tiny images narrower or shorter than this (default: 32).
+	MinDimension     int      `yaml:"min_dimension,omitempty"`
+	AllowedMIMETypes []string `yaml:"allowed_mime_types,omitempty"`
+	// CacheDir stores normalized image bytes keyed by a hash of the image URL.
+	CacheDir             string `yaml:"cache_dir,omitempty"`
+	IncludeCommentImages bool   `yaml:"include_comment_images,omitempty"`
+	MaxConcurrency       int    `yaml:"max_concurrency,omitempty"`
+}
+
 // SummaryPlanConfig declares how summary processors should handle a post.
 type SummaryPlanConfig struct {
 	Mode          core.SummaryMode `yaml:"mode"`
@@ -163,25 +502,82 @@ type SummaryPlanConfig struct {
 type TestFileSource struct {
 	Path        string               `yaml:"path"`
 	ChunkSize   int                  `yaml:"chunk_size,omitempty"`
+	Enrich      *EnrichConfig        `yaml:"enrich,omitempty"`
+	ImageFetch  *ImageFetchConfig    `yaml:"image_fetch,omitempty"`
 	SummaryPlan *SummaryPlanConfig   `yaml:"summary_plan,omitempty"`
 	Snapshot    *core.SnapshotConfig `yaml:"snapshot,omitempty"`
 }
 
 // QualityConfig wraps different quality processor types
 type QualityConfig struct {
-	QualityRule *QualityRule `yaml:"quality_rule,omitempty"`
-	LLM         *LLMQuality  `yaml:"llm,omitempty"`
+	QualityRule *QualityRule   `yaml:"quality_rule,omitempty"`
+	LLM         *LLMQuality    `yaml:"llm,omitempty"`
+	Dedupe      *DedupeQuality `yaml:"dedupe,omitempty"`
 }
 
 // QualityRule defines rule-based quality filtering
 type QualityRule struct {
-	Name       string               `yaml:"name"`
-	Rule       string               `yaml:"rule"`
-	ActionType string               `yaml:"action_type"`
-	Result     string               `yaml:"result"`
-	Snapshot   *core.SnapshotConfig `yaml:"snapshot,omitempty"`
+	Name       string `yaml:"name"`
+	Rule       string `yaml:"rule"`
+	ActionType string `yaml:"action_type"`
+	// Result is "pass" or "drop" and only applies to action_type pass_drop.
+	Result string `yaml:"result,omitempty"`
+	// Tag, Score and Section are the arguments of the tag, score and section
+	// actions. These actions keep every block and only annotate matches.
+	Tag      string               `yaml:"tag,omitempty"`
+	Score    float64              `yaml:"score,omitempty"`
+	Section  string               `yaml:"section,omitempty"`
+	Snapshot *core.SnapshotConfig `yaml:"snapshot,omitempty"`
+}
+
+// DedupeQuality clusters posts about the same story across sources and merges
+// each cluster into one primary post that links the others as alternates.
+type DedupeQuality struct {
+	Name string `yaml:"name"`
+	// Match lists the signals that make two posts duplicates: "url" (the
+	// post URL), "links" (a linked page is the other post's URL), "title" and
+	// "content".
+	// Default: all of them.
+	Match []string `yaml:"match,omitempty"`
+	// TitleSimilarity is the minimum share of title words two posts have in
+	// common (Jaccard, 0-1; default 0.8 when unset).
+	TitleSimilarity *float64 `yaml:"title_similarity,omitempty"`
+	// ContentDistance is the maximum number of differing bits between two
+	// 64-bit content SimHashes (default 3 when unset; 0 only matches
+	// identical hashes).
+	ContentDistance *int `yaml:"content_distance,omitempty"`
+	// MinContentWords skips content matching for shorter posts (default 50).
+	MinContentWords int `yaml:"min_content_words,omitempty"`
+	// Primary picks the post a cluster is merged into: "first" (default,
+	// source order), "most_comments" or "longest".
+	Primary string `yaml:"primary,omitempty"`
+	// Remember keeps fingerprints for this long (e.g. "7d") and drops later
+	// posts that match one. Requires workflow.state_store.
+	Remember string               `yaml:"remember,omitempty"`
+	Snapshot *core.SnapshotConfig `yaml:"snapshot,omitempty"`
 }
 
+// Dedupe match signals and primary strategies.
+const (
+	DedupeMatchURL     = "url"
+	DedupeMatchLinks   = "links"
+	DedupeMatchTitle   = "title"
+	DedupeMatchContent = "content"
+
+	DedupePrimaryFirst        = "first"
+	DedupePrimaryMostComments = "most_comments"
+	DedupePrimaryLongest      = "longest"
+)
+
+// Quality rule action types.
+const (
+	RuleActionPassDrop = "pass_drop"
+	RuleActionTag      = "tag"
+	RuleActionScore    = "score"
+	RuleActionPriority = "priority"
+	RuleActionSection  = "section"
+)
+
 // LLMQuality defines AI-powered quality evaluation
 type LLMQuality struct {
 	Name           string   `yaml:"name"`
@@ -316,6 +712,77 @@ func (c *DedupeStoreConfig) UnmarshalYAML(value *yaml.Node) error {
 	return nil
 }
 
+// FeedHealthConfig enables persisting RSS feed fetch outcomes for the
+// `curator feeds health` report.
+type FeedHealthConfig struct {
+	Driver string `yaml:"driver,omitempty"`
+	DSN    string `yaml:"dsn,omitempty"`
+	Table  string `yaml:"table,omitempty"`
+}
+
+// StateStoreConfig persists small per-flow bookkeeping between runs, such as
+// the end of the last arXiv window fetched by since_last_run.
+type StateStoreConfig struct {
+	Driver string `yaml:"driver,omitempty"`
+	DSN    string `yaml:"dsn,omitempty"`
+	Table  string `yaml:"table,omitempty"`
+}
+
+// ReaderCacheConfig persists reader results (crawl4ai pages, docling PDF
+// conversions) keyed by URL. Each reader opts in with its own policy.
+type ReaderCacheConfig struct {
+	Driver   string             `yaml:"driver,omitempty"`
+	DSN      string             `yaml:"dsn,omitempty"`
+	Table    string             `yaml:"table,omitempty"`
+	Crawl4AI *ReaderCachePolicy `yaml:"crawl4ai,omitempty"`
+	Docling  *ReaderCachePolicy `yaml:"docling,omitempty"`
+}
+
+// ReaderCachePolicy controls caching for a single reader.
+type ReaderCachePolicy struct {
+	TTL time.Duration `yaml:"ttl,omitempty"`
+	// ServeStale returns an expired cached copy when the reader errors (default: true).
+	ServeStale *bool `yaml:"serve_stale,omitempty"`
+}
+
+func (p *ReaderCachePolicy) UnmarshalYAML(value *yaml.Node) error {
+	type temp struct {
+		TTL        interface{} `yaml:"ttl,omitempty"`
+		ServeStale *bool       `yaml:"serve_stale,omitempty"`
+	}
+	var t temp
+	if err := value.Decode(&t); err != nil {
+		return err
+	}
+
+	p.ServeStale = t.ServeStale
+	p.TTL = 0
+	if t.TTL == nil {
+		return nil
+	}
+	s, ok := t.TTL.(string)
+	if !ok {
+		return fmt.Errorf("reader_cache ttl must be a duration string")
+	}
+	if strings.TrimSpace(s) == "" {
+		return nil
+	}
+	d, err := ParseDurationExtended(s)
+	if err != nil {
+		return fmt.Errorf("reader_cache ttl: %w", err)
+	}
+	p.TTL = d
+	return nil
+}
+
+// ServeStaleOrDefault reports whether stale entries may be served on reader errors.
+func (p *ReaderCachePolicy) ServeStaleOrDefault() bool {
+	if p == nil || p.ServeStale == nil {
+		return true
+	}
+	return *p.ServeStale
+}
+
 // EmailOutput defines email delivery configuration
 type EmailOutput struct {
 	Template               string               `yaml:"template"`
@@ -335,19 +802,28 @@ type EmailOutput struct {
 type ProcessorType string
 
 const (
-	ProcessorTriggerCron   ProcessorType = "trigger_cron"
-	ProcessorSourceReddit  ProcessorType = "source_reddit"
-	ProcessorSourceRSS     ProcessorType = "source_rss"
-	ProcessorSourceArxiv   ProcessorType = "source_arxiv"
-	ProcessorSourceScrape  ProcessorType = "source_scrape"
-	ProcessorSourceTest    ProcessorType = "source_testfile"
-	ProcessorQualityRule   ProcessorType = "quality_rule"
-	ProcessorQualityLLM    ProcessorType = "quality_llm"
-	ProcessorSummaryLLM    ProcessorType = "summary_llm"
-	ProcessorRunSummaryLLM ProcessorType = "run_summary_llm"
-	ProcessorSummaryMD     ProcessorType = "summary_markdown"
-	ProcessorRunSummaryMD  ProcessorType = "run_summary_markdown"
-	ProcessorOutputEmail   ProcessorType = "output_email"
+	ProcessorTriggerCron    ProcessorType = "trigger_cron"
+	ProcessorSourceReddit   ProcessorType = "source_reddit"
+	ProcessorSourceRSS      ProcessorType = "source_rss"
+	ProcessorSourceArxiv    ProcessorType = "source_arxiv"
+	ProcessorSourceBiorxiv  ProcessorType = "source_biorxiv"
+	ProcessorSourceHF       ProcessorType = "source_huggingface"
+	ProcessorSourceMastodon ProcessorType = "source_mastodon"
+	ProcessorSourceBluesky  ProcessorType = "source_bluesky"
+	ProcessorSourceForum    ProcessorType = "source_forum"
+	ProcessorSourceMedia    ProcessorType = "source_media"
+	ProcessorSourceWatch    ProcessorType = "source_watch"
+	ProcessorSourceURLs     ProcessorType = "source_urls"
+	ProcessorSourceScrape   ProcessorType = "source_scrape"
+	ProcessorSourceTest     ProcessorType = "source_testfile"
+	ProcessorQualityRule    ProcessorType = "quality_rule"
+	ProcessorQualityLLM     ProcessorType = "quality_llm"
+	ProcessorQualityDedupe  ProcessorType = "quality_dedupe"
+	ProcessorSummaryLLM     ProcessorType = "summary_llm"
+	ProcessorRunSummaryLLM  ProcessorType = "run_summary_llm"
+	ProcessorSummaryMD      ProcessorType = "summary_markdown"
+	ProcessorRunSummaryMD   ProcessorType = "run_summary_markdown"
+	ProcessorOutputEmail    ProcessorType = "output_email"
 )
 
 // ParsedFlow represents the internal structure after parsing
@@ -373,10 +849,19 @@ type ProcessorFactory interface {
 	NewRedditSource(config *RedditSource) (core.SourceProcessor, error)
 	NewRSSSource(config *RSSSource) (core.SourceProcessor, error)
 	NewArxivSource(config *ArxivSource) (core.SourceProcessor, error)
+	NewBiorxivSource(config *BiorxivSource) (core.SourceProcessor, error)
+	NewHuggingFaceSource(config *HuggingFaceSource) (core.SourceProcessor, error)
+	NewMastodonSource(config *MastodonSource) (core.SourceProcessor, error)
+	NewBlueskySource(config *BlueskySource) (core.SourceProcessor, error)
+	NewForumSource(config *ForumSource) (core.SourceProcessor, error)
+	NewMediaSource(config *MediaSource) (core.SourceProcessor, error)
+	NewWatchSource(config *WatchSource) (core.SourceProcessor, error)
+	NewURLsSource(config *URLsSource) (core.SourceProcessor, error)
 	NewScrapeSource(config *ScrapeSource) (core.SourceProcessor, error)
 	NewTestFileSource(config *TestFileSource) (core.SourceProcessor, error)
 	NewQualityRule(config *QualityRule) (core.QualityProcessor, error)
 	NewLLMQuality(config *LLMQuality) (core.QualityProcessor, error)
+	NewDedupeQuality(config *DedupeQuality) (core.QualityProcessor, error)
 	NewLLMSummary(config *LLMSummary) (core.SummaryProcessor, error)
 	NewLLMRunSummary(config *LLMSummary) (core.RunSummaryProcessor, error)
 	NewMarkdownSummary(config *MarkdownSummary) (core.SummaryProcessor, error)
@@ -384,6 +869,22 @@ type ProcessorFactory interface {
 	NewEmailOutput(config *EmailOutput) (core.OutputProcessor, error)
 }
 
+// ReaderCacheConfigurer supports configuring a persistent cache around readers.
+type ReaderCacheConfigurer interface {
+	ConfigureReaderCache(config *ReaderCacheConfig) error
+}
+
+// FeedHealthConfigurer supports configuring a shared feed health store for RSS sources.
+type FeedHealthConfigurer interface {
+	ConfigureFeedHealth(config *FeedHealthConfig) error
+}
+
+// StateStoreConfigurer supports configuring a shared state store for sources
+// that track progress between runs.
+type StateStoreConfigurer interface {
+	ConfigureStateStore(config *StateStoreConfig) error
+}
+
 // DedupeStoreConfigurer supports configuring a shared dedupe store for processors.
 type DedupeStoreConfigurer interface {
 	ConfigureDedupeStore(config *DedupeStoreConfig) error
@@ -418,6 +919,17 @@ func (d *CuratorDocument) Validate() error {
 		return err
 	}
 
+	if err := validateReaderCacheConfig(d.Workflow.ReaderCache); err != nil {
+		return err
+	}
+
+	if err := validateFeedHealthConfig(d.Workflow.FeedHealth); err != nil {
+		return err
+	}
+	if err := validateStateStoreConfig(d.Workflow.StateStore); err != nil {
+		return err
+	}
+
 	for _, output := range d.Workflow.Output {
 		emailConfig, err := decodeEmailOutput(output.Email)
 		if err != nil {
@@ -459,14 +971,17 @@ func (d *CuratorDocument) Validate() error {
 
 	// Validate sources
 	for i, source := range d.Workflow.Sources {
-		if source.Reddit == nil && source.RSS == nil && source.Arxiv == nil && source.Scrape == nil && source.TestFile == nil {
+		if source.Reddit == nil && source.RSS == nil && source.Arxiv == nil && source.Biorxiv == nil && source.HuggingFace == nil && source.Mastodon == nil && source.Bluesky == nil && source.Forum == nil && source.Media == nil && source.Watch == nil && source.URLs == nil && source.Scrape == nil && source.TestFile == nil {
 			return fmt.Errorf("source %d: unsupported source type", i)
 		}
-		if source.Reddit != nil && len(source.Reddit.Subreddits) == 0 {
-			return fmt.Errorf("source %d: at least one subreddit is required", i)
+		if source.Reddit != nil && len(source.Reddit.Subreddits) == 0 && len(source.Reddit.Queries) == 0 {
+			return fmt.Errorf("source %d: at least one subreddit or query is required", i)
+		}
+		if source.Reddit != nil && len(source.Reddit.Subreddits) > 0 && len(source.Reddit.Queries) > 0 {
+			return fmt.Errorf("source %d: reddit subreddits and queries cannot be combined; add the subreddits as a query", i)
 		}
-		if source.RSS != nil && len(source.RSS.Feeds) == 0 {
-			return fmt.Errorf("source %d: at least one rss feed is required", i)
+		if source.RSS != nil && len(source.RSS.Feeds) == 0 && strings.TrimSpace(source.RSS.OPML) == "" {
+			return fmt.Errorf("source %d: at least one rss feed is required (feeds or opml)", i)
 		}
 		if source.Arxiv != nil && strings.TrimSpace(source.Arxiv.Query) == "" && len(source.Arxiv.Categories) == 0 {
 			return fmt.Errorf("source %d: arxiv requires query or categories", i)
@@ -497,28 +1012,216 @@ func (d *CuratorDocument) Validate() error {
 			}
 		}
 		if source.Reddit != nil {
+			if source.Reddit.CommentDepth < 0 {
+				return fmt.Errorf("source %d: reddit comment_depth must be >= 0", i)
+			}
+			for _, limit := range source.Reddit.CommentLimits {
+				if limit <= 0 {
+					return fmt.Errorf("source %d: reddit comment_limits must be > 0", i)
+				}
+			}
+			for j, query := range source.Reddit.Queries {
+				if err := validateRedditQuery(fmt.Sprintf("source %d reddit query %d", i, j), query); err != nil {
+					return err
+				}
+			}
 			if err := validateSummaryPlanConfig(fmt.Sprintf("source %d reddit", i), source.Reddit.SummaryPlan); err != nil {
 				return err
 			}
 			if err := validateSnapshotConfig(fmt.Sprintf("source %d reddit", i), source.Reddit.Snapshot); err != nil {
 				return err
 			}
+			if err := validateEnrichConfig(fmt.Sprintf("source %d reddit", i), source.Reddit.Enrich); err != nil {
+				return err
+			}
+			if err := validateImageFetchConfig(fmt.Sprintf("source %d reddit", i), source.Reddit.ImageFetch); err != nil {
+				return err
+			}
 		}
 		if source.RSS != nil {
+			if source.RSS.MaxConcurrency < 0 {
+				return fmt.Errorf("source %d: rss max_concurrency must be >= 0", i)
+			}
+			if full := source.RSS.FetchFullArticle; full != nil {
+				switch strings.ToLower(full.When) {
+				case "", "short", "always":
+				default:
+					return fmt.Errorf("source %d: rss fetch_full_article when must be \"short\" or \"always\"", i)
+				}
+				switch strings.ToLower(full.Mode) {
+				case "", "replace", "append":
+				default:
+					return fmt.Errorf("source %d: rss fetch_full_article mode must be \"replace\" or \"append\"", i)
+				}
+				if full.MinLength < 0 {
+					return fmt.Errorf("source %d: rss fetch_full_article min_length must be >= 0", i)
+				}
+			}
 			if err := validateSummaryPlanConfig(fmt.Sprintf("source %d rss", i), source.RSS.SummaryPlan); err != nil {
 				return err
 			}
 			if err := validateSnapshotConfig(fmt.Sprintf("source %d rss", i), source.RSS.Snapshot); err != nil {
 				return err
 			}
+			if err := validateEnrichConfig(fmt.Sprintf("source %d rss", i), source.RSS.Enrich); err != nil {
+				return err
+			}
+			if err := validateImageFetchConfig(fmt.Sprintf("source %d rss", i), source.RSS.ImageFetch); err != nil {
+				return err
+			}
 		}
 		if source.Arxiv != nil {
+			if err := validateArxivSource(fmt.Sprintf("source %d arxiv", i), source.Arxiv, d.Workflow.StateStore); err != nil {
+				return err
+			}
 			if err := validateSummaryPlanConfig(fmt.Sprintf("source %d arxiv", i), source.Arxiv.SummaryPlan); err != nil {
 				return err
 			}
 			if err := validateSnapshotConfig(fmt.Sprintf("source %d arxiv", i), source.Arxiv.Snapshot); err != nil {
 				return err
 			}
+			if err := validateEnrichConfig(fmt.Sprintf("source %d arxiv", i), source.Arxiv.Enrich); err != nil {
+				return err
+			}
+			if err := validateImageFetchConfig(fmt.Sprintf("source %d arxiv", i), source.Arxiv.ImageFetch); err != nil {
+				return err
+			}
+		}
+		if source.Biorxiv != nil {
+			if err := validateBiorxivSource(fmt.Sprintf("source %d biorxiv", i), source.Biorxiv); err != nil {
+				return err
+			}
+			if err := validateSummaryPlanConfig(fmt.Sprintf("source %d biorxiv", i), source.Biorxiv.SummaryPlan); err != nil {
+				return err
+			}
+			if err := validateSnapshotConfig(fmt.Sprintf("source %d biorxiv", i), source.Biorxiv.Snapshot); err != nil {
+				return err
+			}
+			if err := validateEnrichConfig(fmt.Sprintf("source %d biorxiv", i), source.Biorxiv.Enrich); err != nil {
+				return err
+			}
+			if err := validateImageFetchConfig(fmt.Sprintf("source %d biorxiv", i), source.Biorxiv.ImageFetch); err != nil {
+				return err
+			}
+		}
+		if source.HuggingFace != nil {
+			if err := validateHuggingFaceSource(fmt.Sprintf("source %d huggingface", i), source.HuggingFace); err != nil {
+				return err
+			}
+			if err := validateSummaryPlanConfig(fmt.Sprintf("source %d huggingface", i), source.HuggingFace.SummaryPlan); err != nil {
+				return err
+			}
+			if err := validateSnapshotConfig(fmt.Sprintf("source %d huggingface", i), source.HuggingFace.Snapshot); err != nil {
+				return err
+			}
+			if err := validateEnrichConfig(fmt.Sprintf("source %d huggingface", i), source.HuggingFace.Enrich); err != nil {
+				return err
+			}
+			if err := validateImageFetchConfig(fmt.Sprintf("source %d huggingface", i), source.HuggingFace.ImageFetch); err != nil {
+				return err
+			}
+		}
+		if source.Mastodon != nil {
+			if err := validateMastodonSource(fmt.Sprintf("source %d mastodon", i), source.Mastodon); err != nil {
+				return err
+			}
+			if err := validateSummaryPlanConfig(fmt.Sprintf("source %d mastodon", i), source.Mastodon.SummaryPlan); err != nil {
+				return err
+			}
+			if err := validateSnapshotConfig(fmt.Sprintf("source %d mastodon", i), source.Mastodon.Snapshot); err != nil {
+				return err
+			}
+			if err := validateEnrichConfig(fmt.Sprintf("source %d mastodon", i), source.Mastodon.Enrich); err != nil {
+				return err
+			}
+			if err := validateImageFetchConfig(fmt.Sprintf("source %d mastodon", i), source.Mastodon.ImageFetch); err != nil {
+				return err
+			}
+		}
+		if source.Bluesky != nil {
+			if err := validateBlueskySource(fmt.Sprintf("source %d bluesky", i), source.Bluesky); err != nil {
+				return err
+			}
+			if err := validateSummaryPlanConfig(fmt.Sprintf("source %d bluesky", i), source.Bluesky.SummaryPlan); err != nil {
+				return err
+			}
+			if err := validateSnapshotConfig(fmt.Sprintf("source %d bluesky", i), source.Bluesky.Snapshot); err != nil {
+				return err
+			}
+			if err := validateEnrichConfig(fmt.Sprintf("source %d bluesky", i), source.Bluesky.Enrich); err != nil {
+				return err
+			}
+			if err := validateImageFetchConfig(fmt.Sprintf("source %d bluesky", i), source.Bluesky.ImageFetch); err != nil {
+				return err
+			}
+		}
+		if source.Forum != nil {
+			if err := validateForumSource(fmt.Sprintf("source %d forum", i), source.Forum); err != nil {
+				return err
+			}
+			if err := validateSummaryPlanConfig(fmt.Sprintf("source %d forum", i), source.Forum.SummaryPlan); err != nil {
+				return err
+			}
+			if err := validateSnapshotConfig(fmt.Sprintf("source %d forum", i), source.Forum.Snapshot); err != nil {
+				return err
+			}
+			if err := validateEnrichConfig(fmt.Sprintf("source %d forum", i), source.Forum.Enrich); err != nil {
+				return err
+			}
+			if err := validateImageFetchConfig(fmt.Sprintf("source %d forum", i), source.Forum.ImageFetch); err != nil {
+				return err
+			}
+		}
+		if source.Media != nil {
+			if err := validateMediaSource(fmt.Sprintf("source %d media", i), source.Media); err != nil {
+				return err
+			}
+			if err := validateSummaryPlanConfig(fmt.Sprintf("source %d media", i), source.Media.SummaryPlan); err != nil {
+				return err
+			}
+			if err := validateSnapshotConfig(fmt.Sprintf("source %d media", i), source.Media.Snapshot); err != nil {
+				return err
+			}
+			if err := validateEnrichConfig(fmt.Sprintf("source %d media", i), source.Media.Enrich); err != nil {
+				return err
+			}
+			if err := validateImageFetchConfig(fmt.Sprintf("source %d media", i), source.Media.ImageFetch); err != nil {
+				return err
+			}
+		}
+		if source.Watch != nil {
+			if err := validateWatchSource(fmt.Sprintf("source %d watch", i), source.Watch, d.Workflow.StateStore); err != nil {
+				return err
+			}
+			if err := validateSummaryPlanConfig(fmt.Sprintf("source %d watch", i), source.Watch.SummaryPlan); err != nil {
+				return err
+			}
+			if err := validateSnapshotConfig(fmt.Sprintf("source %d watch", i), source.Watch.Snapshot); err != nil {
+				return err
+			}
+			if err := validateEnrichConfig(fmt.Sprintf("source %d watch", i), source.Watch.Enrich); err != nil {
+				return err
+			}
+			if err := validateImageFetchConfig(fmt.Sprintf("source %d watch", i), source.Watch.ImageFetch); err != nil {
+				return err
+			}
+		}
+		if source.URLs != nil {
+			if err := validateURLsSource(fmt.Sprintf("source %d urls", i), source.URLs); err != nil {
+				return err
+			}
+			if err := validateSummaryPlanConfig(fmt.Sprintf("source %d urls", i), source.URLs.SummaryPlan); err != nil {
+				return err
+			}
+			if err := validateSnapshotConfig(fmt.Sprintf("source %d urls", i), source.URLs.Snapshot); err != nil {
+				return err
+			}
+			if err := validateEnrichConfig(fmt.Sprintf("source %d urls", i), source.URLs.Enrich); err != nil {
+				return err
+			}
+			if err := validateImageFetchConfig(fmt.Sprintf("source %d urls", i), source.URLs.ImageFetch); err != nil {
+				return err
+			}
 		}
 		if source.TestFile != nil {
 			if err := validateSummaryPlanConfig(fmt.Sprintf("source %d testfile", i), source.TestFile.SummaryPlan); err != nil {
@@ -527,6 +1230,12 @@ func (d *CuratorDocument) Validate() error {
 			if err := validateSnapshotConfig(fmt.Sprintf("source %d testfile", i), source.TestFile.Snapshot); err != nil {
 				return err
 			}
+			if err := validateEnrichConfig(fmt.Sprintf("source %d testfile", i), source.TestFile.Enrich); err != nil {
+				return err
+			}
+			if err := validateImageFetchConfig(fmt.Sprintf("source %d testfile", i), source.TestFile.ImageFetch); err != nil {
+				return err
+			}
 		}
 		if source.Scrape != nil {
 			if err := validateSummaryPlanConfig(fmt.Sprintf("source %d scrape", i), source.Scrape.SummaryPlan); err != nil {
@@ -535,12 +1244,18 @@ func (d *CuratorDocument) Validate() error {
 			if err := validateSnapshotConfig(fmt.Sprintf("source %d scrape", i), source.Scrape.Snapshot); err != nil {
 				return err
 			}
+			if err := validateEnrichConfig(fmt.Sprintf("source %d scrape", i), source.Scrape.Enrich); err != nil {
+				return err
+			}
+			if err := validateImageFetchConfig(fmt.Sprintf("source %d scrape", i), source.Scrape.ImageFetch); err != nil {
+				return err
+			}
 		}
 	}
 
 	// Validate quality processors
 	for i, quality := range d.Workflow.Quality {
-		if quality.QualityRule == nil && quality.LLM == nil {
+		if quality.QualityRule == nil && quality.LLM == nil && quality.Dedupe == nil {
 			return fmt.Errorf("quality %d: unsupported quality type", i)
 		}
 
@@ -548,11 +1263,8 @@ func (d *CuratorDocument) Validate() error {
 			if quality.QualityRule.Name == "" || quality.QualityRule.Rule == "" {
 				return fmt.Errorf("quality %d: rule name and expression are required", i)
 			}
-			if quality.QualityRule.ActionType != "pass_drop" {
-				return fmt.Errorf("quality %d: actionType must be 'pass_drop'", i)
-			}
-			if quality.QualityRule.Result != "pass" && quality.QualityRule.Result != "drop" {
-				return fmt.Errorf("quality %d: result must be 'pass' or 'drop'", i)
+			if err := validateQualityRuleAction(i, quality.QualityRule); err != nil {
+				return err
 			}
 			if err := validateSnapshotConfig(fmt.Sprintf("quality %d rule", i), quality.QualityRule.Snapshot); err != nil {
 				return err
@@ -576,6 +1288,12 @@ func (d *CuratorDocument) Validate() error {
 				return err
 			}
 		}
+
+		if quality.Dedupe != nil {
+			if err := validateDedupeQuality(fmt.Sprintf("quality %d dedupe", i), quality.Dedupe, d.Workflow.StateStore); err != nil {
+				return err
+			}
+		}
 	}
 
 	// Validate summaries
@@ -665,6 +1383,437 @@ func validateDedupeStoreConfig(cfg *DedupeStoreConfig) error {
 	}
 }
 
+func validateReaderCacheConfig(cfg *ReaderCacheConfig) error {
+	if cfg == nil {
+		return nil
+	}
+	switch strings.ToLower(cfg.Driver) {
+	case "", "sqlite":
+	default:
+		return fmt.Errorf("reader_cache driver must be \"sqlite\"")
+	}
+	if cfg.Crawl4AI != nil && cfg.Crawl4AI.TTL < 0 {
+		return fmt.Errorf("reader_cache crawl4ai ttl must be >= 0")
+	}
+	if cfg.Docling != nil && cfg.Docling.TTL < 0 {
+		return fmt.Errorf("reader_cache docling ttl must be >= 0")
+	}
+	return nil
+}
+
+func validateStateStoreConfig(cfg *StateStoreConfig) error {
+	if cfg == nil {
+		return nil
+	}
+	switch strings.ToLower(cfg.Driver) {
+	case "", "sqlite":
+		return nil
+	default:
+		return fmt.Errorf("state_store driver must be \"sqlite\"")
+	}
+}
+
+func validateArxivSource(label string, cfg *ArxivSource, stateStore *StateStoreConfig) error {
+	if cfg.Lookback != "" {
+		lookback, err := ParseDurationExtended(cfg.Lookback)
+		if err != nil {
+			return fmt.Errorf("%s lookback: %w", label, err)
+		}
+		if lookback <= 0 {
+			return fmt.Errorf("%s lookback must be > 0", label)
+		}
+	}
+	relative := cfg.SinceLastRun || cfg.Lookback != ""
+	if relative && (strings.TrimSpace(cfg.DateFrom) != "" || strings.TrimSpace(cfg.DateTo) != "") {
+		return fmt.Errorf("%s: date_from/date_to cannot be combined with lookback or since_last_run", label)
+	}
+	if cfg.SinceLastRun && stateStore == nil {
+		return fmt.Errorf("%s: since_last_run requires workflow.state_store", label)
+	}
+	if cfg.PageSize < 0 {
+		return fmt.Errorf("%s page_size must be >= 0", label)
+	}
+	switch cfg.FullText {
+	case "", "html_first", "pdf_only", "html_only":
+	default:
+		return fmt.Errorf("%s full_text must be one of html_first, pdf_only or html_only", label)
+	}
+	if err := validateWatchlistConfig(label, cfg.Watchlist); err != nil {
+		return err
+	}
+	return validateCitationsConfig(label, cfg.Citations)
+}
+
+func validateBiorxivSource(label string, cfg *BiorxivSource) error {
+	switch strings.ToLower(strings.TrimSpace(cfg.Server)) {
+	case "", "biorxiv", "medrxiv":
+	default:
+		return fmt.Errorf("%s server must be \"biorxiv\" or \"medrxiv\"", label)
+	}
+	if cfg.MaxResults < 0 {
+		return fmt.Errorf("%s max_results must be >= 0", label)
+	}
+	if cfg.Lookback != "" {
+		lookback, err := ParseDurationExtended(cfg.Lookback)
+		if err != nil {
+			return fmt.Errorf("%s lookback: %w", label, err)
+		}
+		if lookback <= 0 {
+			return fmt.Errorf("%s lookback must be > 0", label)
+		}
+		if strings.TrimSpace(cfg.DateFrom) != "" || strings.TrimSpace(cfg.DateTo) != "" {
+			return fmt.Errorf("%s: date_from/date_to cannot be combined with lookback", label)
+		}
+	}
+	for _, date := range []string{cfg.DateFrom, cfg.DateTo} {
+		if date == "" {
+			continue
+		}
+		if _, err := time.Parse("2006-01-02", date); err != nil {
+			return fmt.Errorf("%s dates must be YYYY-MM-DD: %q", label, date)
+		}
+	}
+	return nil
+}
+
+func validateHuggingFaceSource(label string, cfg *HuggingFaceSource) error {
+	if cfg.DailyPapers == nil && cfg.Models == nil {
+		return fmt.Errorf("%s requires daily_papers or models", label)
+	}
+	if papers := cfg.DailyPapers; papers != nil {
+		if papers.Date != "" {
+			if _, err := time.Parse("2006-01-02", papers.Date); err != nil {
+				return fmt.Errorf("%s daily_papers date must be YYYY-MM-DD: %q", label, papers.Date)
+			}
+		}
+		if papers.MinUpvotes < 0 || papers.Limit < 0 {
+			return fmt.Errorf("%s daily_papers min_upvotes and limit must be >= 0", label)
+		}
+	}
+	if models := cfg.Models; models != nil {
+		switch models.Sort {
+		case "", "trending", "created":
+		default:
+			return fmt.Errorf("%s models sort must be \"trending\" or \"created\"", label)
+		}
+		if models.MinDownloads < 0 || models.MinLikes < 0 || models.Limit < 0 {
+			return fmt.Errorf("%s models min_downloads, min_likes and limit must be >= 0", label)
+		}
+	}
+	return nil
+}
+
+func validateMastodonSource(label string, cfg *MastodonSource) error {
+	instance, err := url.Parse(strings.TrimSpace(cfg.Instance))
+	if err != nil || (instance.Scheme != "http" && instance.Scheme != "https") || instance.Host == "" {
+		return fmt.Errorf("%s instance must be an http(s) URL", label)
+	}
+	if len(cfg.Hashtags) == 0 && len(cfg.Lists) == 0 && len(cfg.Accounts) == 0 {
+		return fmt.Errorf("%s requires hashtags, lists or accounts", label)
+	}
+	if cfg.Limit < 0 || cfg.Limit > 40 {
+		return fmt.Errorf("%s limit must be between 0 and 40", label)
+	}
+	if cfg.MinBoosts < 0 || cfg.MinFavourites < 0 {
+		return fmt.Errorf("%s min_boosts and min_favourites must be >= 0", label)
+	}
+	return nil
+}
+
+func validateBlueskySource(label string, cfg *BlueskySource) error {
+	if len(cfg.Authors) == 0 && len(cfg.Feeds) == 0 && len(cfg.Searches) == 0 {
+		return fmt.Errorf("%s requires authors, feeds or searches", label)
+	}
+	for _, feed := range cfg.Feeds {
+		if !strings.HasPrefix(strings.TrimSpace(feed), "at://") {
+			return fmt.Errorf("%s feeds must be at:// URIs", label)
+		}
+	}
+	for _, entry := range append(append([]string{}, cfg.Authors...), cfg.Searches...) {
+		if strings.TrimSpace(entry) == "" {
+			return fmt.Errorf("%s authors and searches must not be empty", label)
+		}
+	}
+	if cfg.SearchSort != "" && cfg.SearchSort != "latest" && cfg.SearchSort != "top" {
+		return fmt.Errorf("%s search_sort must be latest or top", label)
+	}
+	if cfg.Limit < 0 || cfg.Limit > 100 {
+		return fmt.Errorf("%s limit must be between 0 and 100", label)
+	}
+	return nil
+}
+
+func validateForumSource(label string, cfg *ForumSource) error {
+	switch cfg.Flavour {
+	case "discourse":
+		if len(cfg.Communities) > 0 {
+			return fmt.Errorf("%s communities require flavour lemmy", label)
+		}
+	case "lemmy":
+		if len(cfg.Categories) > 0 {
+			return fmt.Errorf("%s categories require flavour discourse", label)
+		}
+	default:
+		return fmt.Errorf("%s flavour must be discourse or lemmy", label)
+	}
+	base, err := url.Parse(strings.TrimSpace(cfg.BaseURL))
+	if err != nil || (base.Scheme != "http" && base.Scheme != "https") || base.Host == "" {
+		return fmt.Errorf("%s base_url must be an http(s) URL", label)
+	}
+	if cfg.Sort != "" && cfg.Sort != "latest" && cfg.Sort != "top" {
+		return fmt.Errorf("%s sort must be latest or top", label)
+	}
+	if cfg.Period != "" && !slices.Contains([]string{"day", "week", "month", "year", "all"}, cfg.Period) {
+		return fmt.Errorf("%s period must be day, week, month, year or all", label)
+	}
+	if cfg.Limit < 0 || cfg.Limit > 50 {
+		return fmt.Errorf("%s limit must be between 0 and 50", label)
+	}
+	if cfg.MaxReplies < 0 || cfg.MaxReplies > 50 {
+		return fmt.Errorf("%s max_replies must be between 0 and 50", label)
+	}
+	return nil
+}
+
+func validateMediaSource(label string, cfg *MediaSource) error {
+	if len(cfg.Podcasts) == 0 && len(cfg.Channels) == 0 && len(cfg.Playlists) == 0 {
+		return fmt.Errorf("%s requires podcasts, channels or playlists", label)
+	}
+	for _, podcast := range cfg.Podcasts {
+		feed, err := url.Parse(strings.TrimSpace(podcast))
+		if err != nil || (feed.Scheme != "http" && feed.Scheme != "https") || feed.Host == "" {
+			return fmt.Errorf("%s podcasts must be http(s) feed URLs", label)
+		}
+	}
+	for _, id := range append(append([]string{}, cfg.Channels...), cfg.Playlists...) {
+		if strings.TrimSpace(id) == "" || strings.Contains(id, "/") {
+			return fmt.Errorf("%s channels and playlists must be YouTube IDs, not URLs", label)
+		}
+	}
+	if cfg.Limit < 0 || cfg.Limit > 50 {
+		return fmt.Errorf("%s limit must be between 0 and 50", label)
+	}
+	if cfg.Transcription != nil && cfg.Transcription.MaxAudioMB < 0 {
+		return fmt.Errorf("%s transcription max_audio_mb must be >= 0", label)
+	}
+	return nil
+}
+
+func validateWatchSource(label string, cfg *WatchSource, stateStore *StateStoreConfig) error {
+	if stateStore == nil {
+		return fmt.Errorf("%s requires workflow.state_store", label)
+	}
+	if len(cfg.Pages) == 0 {
+		return fmt.Errorf("%s requires at least one page", label)
+	}
+	via := strings.ToLower(strings.TrimSpace(cfg.Via))
+	switch via {
+	case "", "scrape", "reader":
+	default:
+		return fmt.Errorf("%s via must be \"scrape\" or \"reader\"", label)
+	}
+	usesSelectors := len(cfg.IgnoreSelectors) > 0
+	for j, page := range cfg.Pages {
+		pageURL, err := url.Parse(strings.TrimSpace(page.URL))
+		if err != nil || (pageURL.Scheme != "http" && pageURL.Scheme != "https") || pageURL.Host == "" {
+			return fmt.Errorf("%s page %d url must be an http(s) URL", label, j)
+		}
+		if strings.TrimSpace(page.Selector) != "" || len(page.IgnoreSelectors) > 0 {
+			usesSelectors = true
+		}
+	}
+	if via == "reader" && usesSelectors {
+		return fmt.Errorf("%s selectors require via \"scrape\"", label)
+	}
+	for _, pattern := range cfg.IgnorePatterns {
+		if _, err := regexp.Compile(pattern); err != nil {
+			return fmt.Errorf("%s ignore_patterns %q: %w", label, pattern, err)
+		}
+	}
+	if cfg.Threshold < 0 || cfg.Threshold > 1 {
+		return fmt.Errorf("%s threshold must be between 0 and 1", label)
+	}
+	if cfg.MinChangedLines < 0 {
+		return fmt.Errorf("%s min_changed_lines must be >= 0", label)
+	}
+	return nil
+}
+
+func validateURLsSource(label string, cfg *URLsSource) error {
+	if len(cfg.URLs) == 0 && strings.TrimSpace(cfg.File) == "" {
+		return fmt.Errorf("%s requires urls or file", label)
+	}
+	for _, raw := range cfg.URLs {
+		parsed, err := url.Parse(strings.TrimSpace(raw))
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			return fmt.Errorf("%s urls must be http(s) URLs, got %q", label, raw)
+		}
+	}
+	switch strings.ToLower(strings.TrimSpace(cfg.HTMLReader)) {
+	case "", "builtin", "crawl4ai":
+	default:
+		return fmt.Errorf("%s html_reader must be \"builtin\" or \"crawl4ai\"", label)
+	}
+	return nil
+}
+
+// validateQualityRuleAction checks that a rule's action type is known and
+// that the action has the arguments it needs.
+func validateQualityRuleAction(i int, rule *QualityRule) error {
+	switch rule.ActionType {
+	case RuleActionPassDrop:
+		if rule.Result != "pass" && rule.Result != "drop" {
+			return fmt.Errorf("quality %d: result must be 'pass' or 'drop'", i)
+		}
+	case RuleActionTag:
+		tag := strings.TrimSpace(rule.Tag)
+		if tag == "" {
+			return fmt.Errorf("quality %d: tag is required for action_type tag", i)
+		}
+		if strings.Contains(tag, ",") {
+			return fmt.Errorf("quality %d: tag must not contain commas", i)
+		}
+	case RuleActionScore:
+		if rule.Score == 0 || math.IsNaN(rule.Score) || math.IsInf(rule.Score, 0) {
+			return fmt.Errorf("quality %d: score must be a non-zero number for action_type score", i)
+		}
+	case RuleActionPriority:
+	case RuleActionSection:
+		if strings.TrimSpace(rule.Section) == "" {
+			return fmt.Errorf("quality %d: section is required for action_type section", i)
+		}
+	default:
+		return fmt.Errorf("quality %d: action_type must be one of pass_drop, tag, score, priority or section", i)
+	}
+	return nil
+}
+
+func validateDedupeQuality(label string, cfg *DedupeQuality, stateStore *StateStoreConfig) error {
+	if strings.TrimSpace(cfg.Name) == "" {
+		return fmt.Errorf("%s: name is required", label)
+	}
+	for _, signal := range cfg.Match {
+		switch signal {
+		case DedupeMatchURL, DedupeMatchLinks, DedupeMatchTitle, DedupeMatchContent:
+		default:
+			return fmt.Errorf("%s match: unknown signal %q (use url, links, title or content)", label, signal)
+		}
+	}
+	if cfg.TitleSimilarity != nil && (*cfg.TitleSimilarity < 0 || *cfg.TitleSimilarity > 1) {
+		return fmt.Errorf("%s title_similarity must be between 0 and 1", label)
+	}
+	if cfg.ContentDistance != nil && (*cfg.ContentDistance < 0 || *cfg.ContentDistance > 64) {
+		return fmt.Errorf("%s content_distance must be between 0 and 64", label)
+	}
+	if cfg.MinContentWords < 0 {
+		return fmt.Errorf("%s min_content_words must be >= 0", label)
+	}
+	switch cfg.Primary {
+	case "", DedupePrimaryFirst, DedupePrimaryMostComments, DedupePrimaryLongest:
+	default:
+		return fmt.Errorf("%s primary must be first, most_comments or longest", label)
+	}
+	if cfg.Remember != "" {
+		remember, err := ParseDurationExtended(cfg.Remember)
+		if err != nil {
+			return fmt.Errorf("%s remember: %w", label, err)
+		}
+		if remember <= 0 {
+			return fmt.Errorf("%s remember must be > 0", label)
+		}
+		if stateStore == nil {
+			return fmt.Errorf("%s: remember requires workflow.state_store", label)
+		}
+	}
+	return validateSnapshotConfig(label, cfg.Snapshot)
+}
+
+func validateWatchlistConfig(label string, cfg *WatchlistConfig) error {
+	if cfg == nil {
+		return nil
+	}
+	if len(cfg.Authors) == 0 && len(cfg.Affiliations) == 0 {
+		return fmt.Errorf("%s watchlist requires authors or affiliations", label)
+	}
+	for _, entry := range append(append([]string{}, cfg.Authors...), cfg.Affiliations...) {
+		if strings.TrimSpace(entry) == "" {
+			return fmt.Errorf("%s watchlist entries must not be empty", label)
+		}
+	}
+	return nil
+}
+
+func validateCitationsConfig(label string, cfg *CitationsConfig) error {
+	if cfg == nil {
+		return nil
+	}
+	if cfg.BatchSize < 0 || cfg.BatchSize > 500 {
+		return fmt.Errorf("%s citations batch_size must be between 0 and 500", label)
+	}
+	return nil
+}
+
+func validateFeedHealthConfig(cfg *FeedHealthConfig) error {
+	if cfg == nil {
+		return nil
+	}
+	switch strings.ToLower(cfg.Driver) {
+	case "", "sqlite":
+		return nil
+	default:
+		return fmt.Errorf("feed_health driver must be \"sqlite\"")
+	}
+}
+
+var (
+	redditListingSorts = []string{"hot", "new", "rising", "top", "controversial"}
+	redditSearchSorts  = []string{"relevance", "hot", "top", "new", "comments"}
+	redditUserSorts    = []string{"hot", "new", "top", "controversial"}
+	redditTimeFilters  = []string{"hour", "day", "week", "month", "year", "all"}
+)
+
+func validateRedditQuery(label string, query RedditQuery) error {
+	targets := 0
+	for _, value := range []string{query.Subreddit, query.User, query.Multireddit} {
+		if strings.TrimSpace(value) != "" {
+			targets++
+		}
+	}
+	search := strings.TrimSpace(query.Search) != ""
+	switch {
+	case targets > 1:
+		return fmt.Errorf("%s: only one of subreddit, user or multireddit may be set", label)
+	case targets == 0 && !search:
+		return fmt.Errorf("%s: one of subreddit, user, multireddit or search is required", label)
+	case search && (query.User != "" || query.Multireddit != ""):
+		return fmt.Errorf("%s: search can only be restricted to a subreddit", label)
+	}
+	if query.Multireddit != "" {
+		owner, name, ok := strings.Cut(query.Multireddit, "/")
+		if !ok || strings.TrimSpace(owner) == "" || strings.TrimSpace(name) == "" || strings.Contains(name, "/") {
+			return fmt.Errorf("%s: multireddit must be in the form owner/name", label)
+		}
+	}
+	if query.Limit < 0 {
+		return fmt.Errorf("%s: limit must be >= 0", label)
+	}
+	if query.Sort != "" {
+		sorts := redditListingSorts
+		if search {
+			sorts = redditSearchSorts
+		} else if query.User != "" {
+			sorts = redditUserSorts
+		}
+		if !slices.Contains(sorts, strings.ToLower(query.Sort)) {
+			return fmt.Errorf("%s: sort must be one of %s", label, strings.Join(sorts, ", "))
+		}
+	}
+	if query.TimeFilter != "" && !slices.Contains(redditTimeFilters, strings.ToLower(query.TimeFilter)) {
+		return fmt.Errorf("%s: time_filter must be one of %s", label, strings.Join(redditTimeFilters, ", "))
+	}
+	return nil
+}
+
 func validateSnapshotConfig(label string, cfg *core.SnapshotConfig) error {
 	if cfg == nil {
 		return nil
@@ -695,6 +1844,45 @@ func validateSummaryPlanConfig(label string, cfg *SummaryPlanConfig) error {
 	}
 }
 
+func validateEnrichConfig(label string, cfg *EnrichConfig) error {
+	if cfg == nil {
+		return nil
+	}
+	if cfg.MaxLinks < 0 {
+		return fmt.Errorf("%s: enrich.max_links must be >= 0", label)
+	}
+	if cfg.MaxImages < 0 {
+		return fmt.Errorf("%s: enrich.max_images must be >= 0", label)
+	}
+	if cfg.MaxConcurrency < 0 {
+		return fmt.Errorf("%s: enrich.max_concurrency must be >= 0", label)
+	}
+	for _, domain := range append(append([]string{}, cfg.IncludeDomains...), cfg.ExcludeDomains...) {
+		if strings.TrimSpace(domain) == "" {
+			return fmt.Errorf("%s: enrich domains must not be empty", label)
+		}
+	}
+	return nil
+}
+
+func validateImageFetchConfig(label string, cfg *ImageFetchConfig) error {
+	if cfg == nil {
+		return nil
+	}
+	if cfg.MaxBytes < 0 || cfg.MaxDimension < 0 || cfg.MinDimension < 0 || cfg.MaxConcurrency < 0 {
+		return fmt.Errorf("%s: image_fetch limits must be >= 0", label)
+	}
+	if cfg.MaxDimension > 0 && cfg.MinDimension > cfg.MaxDimension {
+		return fmt.Errorf("%s: image_fetch.min_dimension must be <= max_dimension", label)
+	}
+	for _, mime := range cfg.AllowedMIMETypes {
+		if !strings.HasPrefix(strings.ToLower(strings.TrimSpace(mime)), "image/") {
+			return fmt.Errorf("%s: image_fetch.allowed_mime_types entries must be image/* types", label)
+		}
+	}
+	return nil
+}
+
 func validateLLMTemperature(label string, temperature *float64) error {
 	if temperature == nil {
 		return nil
@@ -902,6 +2090,62 @@ func (d *CuratorDocument) Parse() (*ParsedFlow, error) {
 				Config: source.Arxiv,
 			})
 		}
+		if source.Biorxiv != nil {
+			flow.Sources = append(flow.Sources, ParsedProcessor{
+				Type:   ProcessorSourceBiorxiv,
+				Name:   "biorxiv",
+				Config: source.Biorxiv,
+			})
+		}
+		if source.HuggingFace != nil {
+			flow.Sources = append(flow.Sources, ParsedProcessor{
+				Type:   ProcessorSourceHF,
+				Name:   "huggingface",
+				Config: source.HuggingFace,
+			})
+		}
+		if source.Mastodon != nil {
+			flow.Sources = append(flow.Sources, ParsedProcessor{
+				Type:   ProcessorSourceMastodon,
+				Name:   "mastodon",
+				Config: source.Mastodon,
+			})
+		}
+		if source.Bluesky != nil {
+			flow.Sources = append(flow.Sources, ParsedProcessor{
+				Type:   ProcessorSourceBluesky,
+				Name:   "bluesky",
+				Config: source.Bluesky,
+			})
+		}
+		if source.Forum != nil {
+			flow.Sources = append(flow.Sources, ParsedProcessor{
+				Type:   ProcessorSourceForum,
+				Name:   "forum",
+				Config: source.Forum,
+			})
+		}
+		if source.Media != nil {
+			flow.Sources = append(flow.Sources, ParsedProcessor{
+				Type:   ProcessorSourceMedia,
+				Name:   "media",
+				Config: source.Media,
+			})
+		}
+		if source.Watch != nil {
+			flow.Sources = append(flow.Sources, ParsedProcessor{
+				Type:   ProcessorSourceWatch,
+				Name:   "watch",
+				Config: source.Watch,
+			})
+		}
+		if source.URLs != nil {
+			flow.Sources = append(flow.Sources, ParsedProcessor{
+				Type:   ProcessorSourceURLs,
+				Name:   "urls",
+				Config: source.URLs,
+			})
+		}
 		if source.Scrape != nil {
 			flow.Sources = append(flow.Sources, ParsedProcessor{
 				Type:   ProcessorSourceScrape,
@@ -932,6 +2176,12 @@ func (d *CuratorDocument) Parse() (*ParsedFlow, error) {
 				Name:   quality.LLM.Name,
 				Config: quality.LLM,
 			})
+		} else if quality.Dedupe != nil {
+			flow.Processors = append(flow.Processors, ParsedProcessor{
+				Type:   ProcessorQualityDedupe,
+				Name:   quality.Dedupe.Name,
+				Config: quality.Dedupe,
+			})
 		}
 	}
 
@@ -1001,6 +2251,21 @@ func (d *CuratorDocument) ParseToFlowWithFactory(factory ProcessorFactory) (*cor
 				return nil, err
 			}
 		}
+		if cacheFactory, ok := factory.(ReaderCacheConfigurer); ok {
+			if err := cacheFactory.ConfigureReaderCache(d.Workflow.ReaderCache); err != nil {
+				return nil, err
+			}
+		}
+		if healthFactory, ok := factory.(FeedHealthConfigurer); ok {
+			if err := healthFactory.ConfigureFeedHealth(d.Workflow.FeedHealth); err != nil {
+				return nil, err
+			}
+		}
+		if stateFactory, ok := factory.(StateStoreConfigurer); ok {
+			if err := stateFactory.ConfigureStateStore(d.Workflow.StateStore); err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	flow := newFlowFromDocument(d)
@@ -1078,6 +2343,54 @@ func buildSources(flow *core.Flow, sources []SourceConfig, factory ProcessorFact
 					return f.NewArxivSource(c)
 				}, factory)
 		}
+		if source.Biorxiv != nil {
+			buildSourceProcessor(flow, "biorxiv", core.SourceProcessorType, source.Biorxiv,
+				func(f ProcessorFactory, c *BiorxivSource) (core.SourceProcessor, error) {
+					return f.NewBiorxivSource(c)
+				}, factory)
+		}
+		if source.HuggingFace != nil {
+			buildSourceProcessor(flow, "huggingface", core.SourceProcessorType, source.HuggingFace,
+				func(f ProcessorFactory, c *HuggingFaceSource) (core.SourceProcessor, error) {
+					return f.NewHuggingFaceSource(c)
+				}, factory)
+		}
+		if source.Mastodon != nil {
+			buildSourceProcessor(flow, "mastodon", core.SourceProcessorType, source.Mastodon,
+				func(f ProcessorFactory, c *MastodonSource) (core.SourceProcessor, error) {
+					return f.NewMastodonSource(c)
+				}, factory)
+		}
+		if source.Bluesky != nil {
+			buildSourceProcessor(flow, "bluesky", core.SourceProcessorType, source.Bluesky,
+				func(f ProcessorFactory, c *BlueskySource) (core.SourceProcessor, error) {
+					return f.NewBlueskySource(c)
+				}, factory)
+		}
+		if source.Forum != nil {
+			buildSourceProcessor(flow, "forum", core.SourceProcessorType, source.Forum,
+				func(f ProcessorFactory, c *ForumSource) (core.SourceProcessor, error) {
+					return f.NewForumSource(c)
+				}, factory)
+		}
+		if source.Media != nil {
+			buildSourceProcessor(flow, "media", core.SourceProcessorType, source.Media,
+				func(f ProcessorFactory, c *MediaSource) (core.SourceProcessor, error) {
+					return f.NewMediaSource(c)
+				}, factory)
+		}
+		if source.Watch != nil {
+			buildSourceProcessor(flow, "watch", core.SourceProcessorType, source.Watch,
+				func(f ProcessorFactory, c *WatchSource) (core.SourceProcessor, error) {
+					return f.NewWatchSource(c)
+				}, factory)
+		}
+		if source.URLs != nil {
+			buildSourceProcessor(flow, "urls", core.SourceProcessorType, source.URLs,
+				func(f ProcessorFactory, c *URLsSource) (core.SourceProcessor, error) {
+					return f.NewURLsSource(c)
+				}, factory)
+		}
 		if source.Scrape != nil {
 			buildSourceProcessor(flow, "scrape", core.SourceProcessorType, source.Scrape,
 				func(f ProcessorFactory, c *ScrapeSource) (core.SourceProcessor, error) {
@@ -1119,6 +2432,11 @@ func buildQuality(flow *core.Flow, quality []QualityConfig, maxConcurrency int,
 				func(f ProcessorFactory, c *LLMQuality) (core.QualityProcessor, error) {
 					return f.NewLLMQuality(c)
 				}, factory)
+		} else if q.Dedupe != nil {
+			buildQualityProcessor(flow, q.Dedupe.Name, q.Dedupe,
+				func(f ProcessorFactory, c *DedupeQuality) (core.QualityProcessor, error) {
+					return f.NewDedupeQuality(c)
+				}, factory)
 		}
 	}
 }
@@ -2,6 +2,7 @@ package config
 
 import (
 	"context"
+	"fmt"
 	"strings"
 	"testing"
 	"time"
@@ -514,6 +515,58 @@ workflow:
 	}
 }
 
+func TestReaderCachePoliciesParse(t *testing.T) {
+	data := []byte(`
+workflow:
+  name: "Test Flow"
+  reader_cache:
+    dsn: ":memory:"
+    crawl4ai:
+      ttl: "1d"
+    docling:
+      ttl: "30d"
+      serve_stale: false
+  trigger:
+    - cron:
+        schedule: "0 0 * * *"
+  sources:
+    - reddit:
+        subreddits: ["MachineLearning"]
+        summary_plan:
+          mode: full
+  output:
+    - email:
+        template: "Hello"
+        to: "test@example.com"
+        from: "noreply@example.com"
+        subject: "Daily Report"
+`)
+
+	var doc CuratorDocument
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		t.Fatalf("Failed to unmarshal YAML: %v", err)
+	}
+	cache := doc.Workflow.ReaderCache
+	if cache == nil || cache.Crawl4AI == nil || cache.Docling == nil {
+		t.Fatalf("Expected reader_cache policies to be set")
+	}
+	if cache.Crawl4AI.TTL != 24*time.Hour {
+		t.Fatalf("Expected crawl4ai ttl 24h, got %v", cache.Crawl4AI.TTL)
+	}
+	if !cache.Crawl4AI.ServeStaleOrDefault() {
+		t.Fatalf("Expected crawl4ai serve_stale to default to true")
+	}
+	if cache.Docling.TTL != 30*24*time.Hour {
+		t.Fatalf("Expected docling ttl 720h, got %v", cache.Docling.TTL)
+	}
+	if cache.Docling.ServeStaleOrDefault() {
+		t.Fatalf("Expected docling serve_stale to be false")
+	}
+	if err := doc.Validate(); err != nil {
+		t.Fatalf("Document validation failed: %v", err)
+	}
+}
+
 func TestDedupeStoreTTLRejectsInvalidUnit(t *testing.T) {
 	data := []byte(`
 workflow:
@@ -754,7 +807,7 @@ func TestValidation(t *testing.T) {
 				},
 			},
 			expectError: true,
-			errorMsg:    "actionType must be 'pass_drop'",
+			errorMsg:    "action_type must be one of pass_drop, tag, score, priority or section",
 		},
 		{
 			name: "Valid minimal configuration",
@@ -1104,6 +1157,74 @@ workflow:
 	}
 }
 
+func TestValidate_RedditQueries(t *testing.T) {
+	data := []byte(`
+workflow:
+  name: "Test Flow"
+  trigger:
+    - cron:
+        schedule: "0 0 * * *"
+  sources:
+    - reddit:
+        queries:
+          - subreddit: MachineLearning
+            sort: top
+            time_filter: day
+          - subreddit: LocalLLaMA
+            sort: hot
+            limit: 10
+            min_score: 0
+          - search: "llama.cpp"
+            subreddit: LocalLLaMA
+            sort: new
+          - user: someone
+          - multireddit: someone/ml
+  output:
+    - email:
+        template: "Hello"
+        to: "test@example.com"
+        from: "noreply@example.com"
+        subject: "Daily Report"
+`)
+
+	var doc CuratorDocument
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		t.Fatalf("Failed to unmarshal YAML: %v", err)
+	}
+	if err := doc.Validate(); err != nil {
+		t.Fatalf("Document validation failed: %v", err)
+	}
+	queries := doc.Workflow.Sources[0].Reddit.Queries
+	if len(queries) != 5 {
+		t.Fatalf("expected 5 queries, got %d", len(queries))
+	}
+	if queries[1].Limit != 10 || queries[1].MinScore == nil || *queries[1].MinScore != 0 {
+		t.Fatalf("unexpected per-query overrides: %+v", queries[1])
+	}
+
+	invalid := []RedditQuery{
+		{},
+		{Subreddit: "a", User: "b"},
+		{User: "b", Search: "x"},
+		{Multireddit: "missing-owner"},
+		{Subreddit: "a", Sort: "relevance"},
+		{Search: "x", Sort: "rising"},
+		{Subreddit: "a", TimeFilter: "decade"},
+	}
+	for _, query := range invalid {
+		doc.Workflow.Sources[0].Reddit.Queries = []RedditQuery{query}
+		if err := doc.Validate(); err == nil {
+			t.Errorf("expected validation error for query %+v", query)
+		}
+	}
+
+	doc.Workflow.Sources[0].Reddit.Queries = []RedditQuery{{Subreddit: "LocalLLaMA"}}
+	doc.Workflow.Sources[0].Reddit.Subreddits = []string{"MachineLearning"}
+	if err := doc.Validate(); err == nil || !strings.Contains(err.Error(), "cannot be combined") {
+		t.Fatalf("expected subreddits with queries to be rejected, got %v", err)
+	}
+}
+
 type mockFactory struct{}
 
 func (m *mockFactory) NewCronTrigger(config *CronTrigger) (core.TriggerProcessor, error) {
@@ -1122,6 +1243,38 @@ func (m *mockFactory) NewArxivSource(config *ArxivSource) (core.SourceProcessor,
 	return &mockSource{}, nil
 }
 
+func (m *mockFactory) NewBiorxivSource(config *BiorxivSource) (core.SourceProcessor, error) {
+	return &mockSource{}, nil
+}
+
+func (m *mockFactory) NewHuggingFaceSource(config *HuggingFaceSource) (core.SourceProcessor, error) {
+	return &mockSource{}, nil
+}
+
+func (m *mockFactory) NewMastodonSource(config *MastodonSource) (core.SourceProcessor, error) {
+	return &mockSource{}, nil
+}
+
+func (m *mockFactory) NewBlueskySource(config *BlueskySource) (core.SourceProcessor, error) {
+	return &mockSource{}, nil
+}
+
+func (m *mockFactory) NewForumSource(config *ForumSource) (core.SourceProcessor, error) {
+	return &mockSource{}, nil
+}
+
+func (m *mockFactory) NewMediaSource(config *MediaSource) (core.SourceProcessor, error) {
+	return &mockSource{}, nil
+}
+
+func (m *mockFactory) NewWatchSource(config *WatchSource) (core.SourceProcessor, error) {
+	return &mockSource{}, nil
+}
+
+func (m *mockFactory) NewURLsSource(config *URLsSource) (core.SourceProcessor, error) {
+	return &mockSource{}, nil
+}
+
 func (m *mockFactory) NewScrapeSource(config *ScrapeSource) (core.SourceProcessor, error) {
 	return &mockSource{}, nil
 }
@@ -1138,6 +1291,10 @@ func (m *mockFactory) NewLLMQuality(config *LLMQuality) (core.QualityProcessor,
 	return &mockQuality{}, nil
 }
 
+func (m *mockFactory) NewDedupeQuality(config *DedupeQuality) (core.QualityProcessor, error) {
+	return &mockQuality{}, nil
+}
+
 func (m *mockFactory) NewLLMSummary(config *LLMSummary) (core.SummaryProcessor, error) {
 	return &mockSummary{}, nil
 }
@@ -1210,3 +1367,569 @@ func (m *mockOutput) Validate() error                               { return nil
 func (m *mockOutput) Deliver(ctx context.Context, blocks []*core.PostBlock, runSummary *core.RunSummary) error {
 	return nil
 }
+
+func TestValidate_ArxivWindow(t *testing.T) {
+	base := `
+workflow:
+  name: "Papers"
+  trigger:
+    - cron:
+        schedule: "0 0 * * *"
+%s
+  sources:
+    - arxiv:
+        categories: ["cs.CL"]
+%s
+  output:
+    - email:
+        template: "Hello"
+        to: "test@example.com"
+        from: "noreply@example.com"
+        subject: "Papers"
+`
+	cases := []struct {
+		name     string
+		workflow string
+		arxiv    string
+		wantErr  string
+	}{
+		{name: "lookback only", arxiv: "        lookback: 2d"},
+		{name: "since last run with state store", workflow: "  state_store:\n    dsn: state.db", arxiv: "        since_last_run: true\n        page_size: 50"},
+		{name: "since last run without state store", arxiv: "        since_last_run: true", wantErr: "requires workflow.state_store"},
+		{name: "bad lookback", arxiv: "        lookback: soon", wantErr: "lookback"},
+		{name: "lookback with fixed dates", arxiv: "        lookback: 2d\n        date_from: 2024-01-01", wantErr: "cannot be combined"},
+		{name: "watchlist", arxiv: "        watchlist:\n          authors: [\"Yann LeCun\"]\n          must_read: true"},
+		{name: "empty watchlist", arxiv: "        watchlist:\n          must_read: true", wantErr: "requires authors or affiliations"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var doc CuratorDocument
+			if err := yaml.Unmarshal([]byte(fmt.Sprintf(base, tc.workflow, tc.arxiv)), &doc); err != nil {
+				t.Fatalf("Failed to unmarshal YAML: %v", err)
+			}
+			err := doc.Validate()
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected validation error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestValidate_BiorxivSource(t *testing.T) {
+	base := `
+workflow:
+  name: "Preprints"
+  trigger:
+    - cron:
+        schedule: "0 0 * * *"
+  sources:
+    - biorxiv:
+%s
+  output:
+    - email:
+        template: "Hello"
+        to: "test@example.com"
+        from: "noreply@example.com"
+        subject: "Preprints"
+`
+	cases := []struct {
+		name    string
+		source  string
+		wantErr string
+	}{
+		{name: "defaults", source: "        categories: [bioinformatics]"},
+		{name: "medrxiv with dates", source: "        server: medrxiv\n        date_from: 2024-01-01\n        date_to: 2024-01-31"},
+		{name: "unknown server", source: "        server: chemrxiv", wantErr: "server"},
+		{name: "bad date", source: "        date_from: 01/02/2024", wantErr: "YYYY-MM-DD"},
+		{name: "lookback with dates", source: "        lookback: 7d\n        date_to: 2024-01-31", wantErr: "cannot be combined"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var doc CuratorDocument
+			if err := yaml.Unmarshal([]byte(fmt.Sprintf(base, tc.source)), &doc); err != nil {
+				t.Fatalf("Failed to unmarshal YAML: %v", err)
+			}
+			err := doc.Validate()
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected validation error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestValidate_HuggingFaceSource(t *testing.T) {
+	base := `
+workflow:
+  name: "Models"
+  trigger:
+    - cron:
+        schedule: "0 * * * *"
+  sources:
+    - huggingface:
+%s
+  output:
+    - email:
+        template: "Hello"
+        to: "test@example.com"
+        from: "noreply@example.com"
+        subject: "Models"
+`
+	cases := []struct {
+		name    string
+		source  string
+		wantErr string
+	}{
+		{name: "papers and models", source: "        daily_papers:\n          min_upvotes: 5\n        models:\n          sort: created\n          pipeline_tag: text-generation"},
+		{name: "empty", source: "        snapshot: null", wantErr: "requires daily_papers or models"},
+		{name: "bad sort", source: "        models:\n          sort: popular", wantErr: "sort"},
+		{name: "bad date", source: "        daily_papers:\n          date: yesterday", wantErr: "YYYY-MM-DD"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var doc CuratorDocument
+			if err := yaml.Unmarshal([]byte(fmt.Sprintf(base, tc.source)), &doc); err != nil {
+				t.Fatalf("Failed to unmarshal YAML: %v", err)
+			}
+			err := doc.Validate()
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected validation error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestValidate_MastodonSource(t *testing.T) {
+	base := `
+workflow:
+  name: "Fediverse"
+  trigger:
+    - cron:
+        schedule: "0 * * * *"
+  sources:
+    - mastodon:
+%s
+  output:
+    - email:
+        template: "Hello"
+        to: "test@example.com"
+        from: "noreply@example.com"
+        subject: "Fediverse"
+`
+	cases := []struct {
+		name    string
+		source  string
+		wantErr string
+	}{
+		{name: "hashtags", source: "        instance: https://mastodon.social\n        hashtags: [MachineLearning]\n        threads: true\n        min_boosts: 5"},
+		{name: "bad instance", source: "        instance: mastodon.social\n        hashtags: [ml]", wantErr: "http(s) URL"},
+		{name: "no timelines", source: "        instance: https://mastodon.social", wantErr: "requires hashtags, lists or accounts"},
+		{name: "limit too high", source: "        instance: https://mastodon.social\n        accounts: [alice]\n        limit: 80", wantErr: "limit"},
+		{name: "negative threshold", source: "        instance: https://mastodon.social\n        accounts: [alice]\n        min_favourites: -1", wantErr: "min_favourites"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var doc CuratorDocument
+			if err := yaml.Unmarshal([]byte(fmt.Sprintf(base, tc.source)), &doc); err != nil {
+				t.Fatalf("Failed to unmarshal YAML: %v", err)
+			}
+			err := doc.Validate()
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected validation error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestValidate_BlueskySource(t *testing.T) {
+	base := `
+workflow:
+  name: "Skyline"
+  trigger:
+    - cron:
+        schedule: "0 * * * *"
+  sources:
+    - bluesky:
+%s
+  output:
+    - email:
+        template: "Hello"
+        to: "test@example.com"
+        from: "noreply@example.com"
+        subject: "Skyline"
+`
+	cases := []struct {
+		name    string
+		source  string
+		wantErr string
+	}{
+		{name: "authors and feeds", source: "        authors: [alice.bsky.social]\n        feeds: [\"at://did:plc:x/app.bsky.feed.generator/ml\"]\n        threads: true"},
+		{name: "no feeds", source: "        limit: 10", wantErr: "requires authors, feeds or searches"},
+		{name: "feed not at uri", source: "        feeds: [https://bsky.app/profile/x/feed/ml]", wantErr: "at:// URIs"},
+		{name: "bad sort", source: "        searches: [llm]\n        search_sort: popular", wantErr: "search_sort"},
+		{name: "limit too high", source: "        searches: [llm]\n        limit: 200", wantErr: "limit"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var doc CuratorDocument
+			if err := yaml.Unmarshal([]byte(fmt.Sprintf(base, tc.source)), &doc); err != nil {
+				t.Fatalf("Failed to unmarshal YAML: %v", err)
+			}
+			err := doc.Validate()
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected validation error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestValidate_ForumSource(t *testing.T) {
+	base := `
+workflow:
+  name: "Forums"
+  trigger:
+    - cron:
+        schedule: "0 * * * *"
+  sources:
+    - forum:
+%s
+  output:
+    - email:
+        template: "Hello"
+        to: "test@example.com"
+        from: "noreply@example.com"
+        subject: "Forums"
+`
+	cases := []struct {
+		name    string
+		source  string
+		wantErr string
+	}{
+		{name: "discourse", source: "        flavour: discourse\n        base_url: https://discuss.pytorch.org\n        categories: [distributed]\n        sort: top\n        period: month"},
+		{name: "lemmy", source: "        flavour: lemmy\n        base_url: https://lemmy.world\n        communities: [localllama]\n        summary_plan:\n          mode: map_reduce"},
+		{name: "bad flavour", source: "        flavour: phpbb\n        base_url: https://forum.example", wantErr: "flavour"},
+		{name: "communities on discourse", source: "        flavour: discourse\n        base_url: https://forum.example\n        communities: [x]", wantErr: "communities require flavour lemmy"},
+		{name: "bad base url", source: "        flavour: lemmy\n        base_url: lemmy.world", wantErr: "base_url"},
+		{name: "bad period", source: "        flavour: lemmy\n        base_url: https://lemmy.world\n        period: quarter", wantErr: "period"},
+		{name: "too many replies", source: "        flavour: lemmy\n        base_url: https://lemmy.world\n        max_replies: 100", wantErr: "max_replies"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var doc CuratorDocument
+			if err := yaml.Unmarshal([]byte(fmt.Sprintf(base, tc.source)), &doc); err != nil {
+				t.Fatalf("Failed to unmarshal YAML: %v", err)
+			}
+			err := doc.Validate()
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected validation error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestValidate_MediaSource(t *testing.T) {
+	base := `
+workflow:
+  name: "Talks"
+  trigger:
+    - cron:
+        schedule: "0 * * * *"
+  sources:
+    - media:
+%s
+  output:
+    - email:
+        template: "Hello"
+        to: "test@example.com"
+        from: "noreply@example.com"
+        subject: "Talks"
+`
+	cases := []struct {
+		name    string
+		source  string
+		wantErr string
+	}{
+		{name: "podcast with transcription", source: "        podcasts: [https://feeds.example/pod.xml]\n        transcription:\n          model: whisper-1\n          language: en\n        summary_plan:\n          mode: map_reduce"},
+		{name: "youtube", source: "        channels: [UCbfYPyITQ-7l4upoX8nvctg]\n        playlists: [PL123]\n        subtitle_languages: [en, de]"},
+		{name: "empty", source: "        limit: 3", wantErr: "requires podcasts, channels or playlists"},
+		{name: "bad podcast url", source: "        podcasts: [feeds.example/pod.xml]", wantErr: "podcasts must be http(s)"},
+		{name: "channel url", source: "        channels: [https://www.youtube.com/@channel]", wantErr: "YouTube IDs"},
+		{name: "bad limit", source: "        channels: [UC1]\n        limit: 100", wantErr: "limit"},
+		{name: "bad max audio", source: "        podcasts: [https://feeds.example/pod.xml]\n        transcription:\n          max_audio_mb: -1", wantErr: "max_audio_mb"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var doc CuratorDocument
+			if err := yaml.Unmarshal([]byte(fmt.Sprintf(base, tc.source)), &doc); err != nil {
+				t.Fatalf("Failed to unmarshal YAML: %v", err)
+			}
+			err := doc.Validate()
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected validation error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestValidate_WatchSource(t *testing.T) {
+	base := `
+workflow:
+  name: "Watch"
+%s
+  trigger:
+    - cron:
+        schedule: "0 * * * *"
+  sources:
+    - watch:
+%s
+  output:
+    - email:
+        template: "Hello"
+        to: "test@example.com"
+        from: "noreply@example.com"
+        subject: "Watch"
+`
+	stateStore := "  state_store:\n    dsn: state.db"
+	cases := []struct {
+		name       string
+		stateStore string
+		source     string
+		wantErr    string
+	}{
+		{name: "scrape", stateStore: stateStore, source: "        pages:\n          - url: https://lab.example/pricing\n            selector: main\n            ignore_selectors: [.ad]\n        ignore_selectors: [time]\n        ignore_patterns: ['^Updated ']\n        threshold: 0.05"},
+		{name: "reader", stateStore: stateStore, source: "        via: reader\n        pages:\n          - url: https://lab.example/leaderboard\n        min_changed_lines: 3"},
+		{name: "missing state store", source: "        pages:\n          - url: https://lab.example/pricing", wantErr: "requires workflow.state_store"},
+		{name: "no pages", stateStore: stateStore, source: "        via: scrape", wantErr: "at least one page"},
+		{name: "bad url", stateStore: stateStore, source: "        pages:\n          - url: lab.example/pricing", wantErr: "page 0 url"},
+		{name: "selectors with reader", stateStore: stateStore, source: "        via: reader\n        pages:\n          - url: https://lab.example/pricing\n            selector: main", wantErr: "selectors require via"},
+		{name: "bad via", stateStore: stateStore, source: "        via: browser\n        pages:\n          - url: https://lab.example/pricing", wantErr: "via must be"},
+		{name: "bad pattern", stateStore: stateStore, source: "        pages:\n          - url: https://lab.example/pricing\n        ignore_patterns: ['(']", wantErr: "ignore_patterns"},
+		{name: "bad threshold", stateStore: stateStore, source: "        pages:\n          - url: https://lab.example/pricing\n        threshold: 5", wantErr: "threshold"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var doc CuratorDocument
+			if err := yaml.Unmarshal([]byte(fmt.Sprintf(base, tc.stateStore, tc.source)), &doc); err != nil {
+				t.Fatalf("Failed to unmarshal YAML: %v", err)
+			}
+			err := doc.Validate()
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected validation error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestValidate_URLsSource(t *testing.T) {
+	base := `
+workflow:
+  name: "Reading list"
+  trigger:
+    - cron:
+        schedule: "0 * * * *"
+  sources:
+    - urls:
+%s
+  output:
+    - email:
+        template: "Hello"
+        to: "test@example.com"
+        from: "noreply@example.com"
+        subject: "Reading list"
+`
+	cases := []struct {
+		name    string
+		source  string
+		wantErr string
+	}{
+		{name: "inline", source: "        urls: [https://conf.example/talk, https://papers.example/a.pdf]\n        summary_plan:\n          mode: map_reduce"},
+		{name: "file with crawl4ai", source: "        file: reading-list.txt\n        html_reader: crawl4ai"},
+		{name: "empty", source: "        html_reader: builtin", wantErr: "requires urls or file"},
+		{name: "bad url", source: "        urls: [conf.example/talk]", wantErr: "must be http(s) URLs"},
+		{name: "bad reader", source: "        urls: [https://conf.example/talk]\n        html_reader: docling", wantErr: "html_reader"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var doc CuratorDocument
+			if err := yaml.Unmarshal([]byte(fmt.Sprintf(base, tc.source)), &doc); err != nil {
+				t.Fatalf("Failed to unmarshal YAML: %v", err)
+			}
+			err := doc.Validate()
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected validation error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestValidate_QualityRuleActions(t *testing.T) {
+	base := `
+workflow:
+  name: "Rule actions"
+  trigger:
+    - cron:
+        schedule: "0 * * * *"
+  sources:
+    - rss:
+        feeds: [https://example.com/feed.xml]
+  quality:
+    - quality_rule:
+        name: "security"
+        rule: 'title.contains("CVE")'
+%s
+  output:
+    - email:
+        template: "Hello"
+        to: "test@example.com"
+        from: "noreply@example.com"
+        subject: "Rule actions"
+`
+	cases := []struct {
+		name    string
+		action  string
+		wantErr string
+	}{
+		{name: "pass_drop", action: "        action_type: pass_drop\n        result: drop"},
+		{name: "tag", action: "        action_type: tag\n        tag: security"},
+		{name: "score", action: "        action_type: score\n        score: -0.2"},
+		{name: "priority", action: "        action_type: priority"},
+		{name: "section", action: "        action_type: section\n        section: Security"},
+		{name: "pass_drop without result", action: "        action_type: pass_drop", wantErr: "result must be 'pass' or 'drop'"},
+		{name: "tag without tag", action: "        action_type: tag", wantErr: "tag is required"},
+		{name: "tag with comma", action: "        action_type: tag\n        tag: a,b", wantErr: "must not contain commas"},
+		{name: "zero score", action: "        action_type: score", wantErr: "score must be a non-zero number"},
+		{name: "section without name", action: "        action_type: section", wantErr: "section is required"},
+		{name: "unknown", action: "        action_type: highlight", wantErr: "action_type must be one of"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var doc CuratorDocument
+			if err := yaml.Unmarshal([]byte(fmt.Sprintf(base, tc.action)), &doc); err != nil {
+				t.Fatalf("Failed to unmarshal YAML: %v", err)
+			}
+			err := doc.Validate()
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected validation error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestValidate_DedupeQuality(t *testing.T) {
+	base := `
+workflow:
+  name: "Dedupe"
+  trigger:
+    - cron:
+        schedule: "0 * * * *"
+%s
+  sources:
+    - rss:
+        feeds: [https://example.com/feed.xml]
+  quality:
+    - dedupe:
+%s
+  output:
+    - email:
+        template: "Hello"
+        to: "test@example.com"
+        from: "noreply@example.com"
+        subject: "Dedupe"
+`
+	stateStore := "  state_store:\n    driver: sqlite\n    dsn: state.db"
+	cases := []struct {
+		name       string
+		stateStore string
+		dedupe     string
+		wantErr    string
+	}{
+		{name: "defaults", dedupe: "        name: stories"},
+		{name: "tuned", dedupe: "        name: stories\n        match: [url, title]\n        title_similarity: 0.7\n        content_distance: 5\n        primary: most_comments"},
+		{name: "remember", stateStore: stateStore, dedupe: "        name: stories\n        remember: 7d"},
+		{name: "missing name", dedupe: "        primary: first", wantErr: "name is required"},
+		{name: "unknown signal", dedupe: "        name: stories\n        match: [url, simhash]", wantErr: "unknown signal"},
+		{name: "bad similarity", dedupe: "        name: stories\n        title_similarity: 1.5", wantErr: "title_similarity"},
+		{name: "bad distance", dedupe: "        name: stories\n        content_distance: 65", wantErr: "content_distance"},
+		{name: "exact content only", dedupe: "        name: stories\n        content_distance: 0"},
+		{name: "bad primary", dedupe: "        name: stories\n        primary: newest", wantErr: "primary must be"},
+		{name: "bad remember", stateStore: stateStore, dedupe: "        name: stories\n        remember: soon", wantErr: "remember"},
+		{name: "remember without state store", dedupe: "        name: stories\n        remember: 7d", wantErr: "requires workflow.state_store"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var doc CuratorDocument
+			if err := yaml.Unmarshal([]byte(fmt.Sprintf(base, tc.stateStore, tc.dedupe)), &doc); err != nil {
+				t.Fatalf("Failed to unmarshal YAML: %v", err)
+			}
+			err := doc.Validate()
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected validation error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
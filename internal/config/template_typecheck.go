@@ -223,7 +223,17 @@ func samplePostBlockForTemplateValidation() *core.PostBlock {
 		Author:    "example",
 		CreatedAt: now,
 		Comments: []core.CommentBlock{
-			{ID: "comment", Author: "commenter", Content: "comment", CreatedAt: now},
+			{
+				ID:        "comment",
+				Author:    "commenter",
+				Content:   "comment",
+				CreatedAt: now,
+				Score:     1,
+				Permalink: "https://example.com/post/comment",
+				Replies: []core.CommentBlock{
+					{ID: "reply", Author: "example", Content: "reply", CreatedAt: now, IsSubmitter: true},
+				},
+			},
 		},
 		WebBlocks: []core.WebBlock{
 			{URL: "https://example.com"},
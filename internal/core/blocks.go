@@ -2,6 +2,9 @@ package core
 
 import (
 	"net/http"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -14,6 +17,7 @@ type PostBlock struct {
 	Title       string            `json:"title" yaml:"title"`
 	Content     string            `json:"content" yaml:"content"`
 	Author      string            `json:"author" yaml:"author"`
+	Authors     []Author          `json:"authors,omitempty" yaml:"authors,omitempty"`
 	CreatedAt   time.Time         `json:"created_at" yaml:"created_at"`
 	Comments    []CommentBlock    `json:"comments,omitempty" yaml:"comments,omitempty"`
 	WebBlocks   []WebBlock        `json:"web_blocks,omitempty" yaml:"web_blocks,omitempty"`
@@ -23,8 +27,81 @@ type PostBlock struct {
 	Metadata    map[string]string `json:"metadata,omitempty" yaml:"metadata,omitempty"`
 	Summary     *SummaryResult    `json:"summary,omitempty" yaml:"summary,omitempty"`
 	Quality     *QualityResult    `json:"quality,omitempty" yaml:"quality,omitempty"`
-	ProcessedAt time.Time         `json:"processed_at" yaml:"processed_at"`
-	Errors      []ProcessError    `json:"errors,omitempty" yaml:"errors,omitempty"`
+	// QualityHistory lists every quality result the block received, in
+	// processor order; Quality is the latest of them. The runner fills it.
+	QualityHistory []QualityResult `json:"quality_history,omitempty" yaml:"quality_history,omitempty"`
+	// Alternates are other posts about the same story that a dedupe quality
+	// processor merged into this one.
+	Alternates  []Alternate    `json:"alternates,omitempty" yaml:"alternates,omitempty"`
+	ProcessedAt time.Time      `json:"processed_at" yaml:"processed_at"`
+	Errors      []ProcessError `json:"errors,omitempty" yaml:"errors,omitempty"`
+}
+
+// Author is a structured post author. Sources with several authors per post
+// (papers) fill PostBlock.Authors; Author keeps the joined display string.
+type Author struct {
+	Name         string   `json:"name" yaml:"name"`
+	Affiliations []string `json:"affiliations,omitempty" yaml:"affiliations,omitempty"`
+}
+
+// Metadata keys with pipeline-wide meaning.
+const (
+	// MetadataQualityBypass set to "true" makes the runner skip quality
+	// processors for the block.
+	MetadataQualityBypass = "quality_bypass"
+	// MetadataMustRead set to "true" places the block in the email digest's
+	// "Must read" section.
+	MetadataMustRead = "must_read"
+	// MetadataSource names the source processor that emitted the block
+	// (e.g. "reddit", "arxiv"); the runner sets it after each fetch.
+	MetadataSource = "source"
+	// MetadataTags holds comma-separated tags added by quality rules. It is
+	// separate from the space-separated "tags" that sources such as Mastodon
+	// record. Use Tags and AddTag rather than reading it directly.
+	MetadataTags = "rule_tags"
+	// MetadataRuleScore is the running total of quality rule scores. LLM
+	// quality processors add it to their own score before thresholding.
+	MetadataRuleScore = "rule_score"
+	// MetadataSection names the email digest section the block is listed in.
+	MetadataSection = "section"
+)
+
+// Tags returns the tags recorded in metadata under MetadataTags.
+func Tags(metadata map[string]string) []string {
+	var tags []string
+	for _, tag := range strings.Split(metadata[MetadataTags], ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
+// HasTag reports whether metadata carries tag.
+func HasTag(metadata map[string]string, tag string) bool {
+	return slices.Contains(Tags(metadata), strings.TrimSpace(tag))
+}
+
+// AddTag appends tag to the block's tags unless it is already present.
+func (b *PostBlock) AddTag(tag string) {
+	tag = strings.TrimSpace(tag)
+	if tag == "" || HasTag(b.Metadata, tag) {
+		return
+	}
+	if b.Metadata == nil {
+		b.Metadata = map[string]string{}
+	}
+	b.Metadata[MetadataTags] = strings.Join(append(Tags(b.Metadata), tag), ",")
+}
+
+// RuleScore returns the running quality rule score, or zero when no score
+// rule has matched.
+func RuleScore(metadata map[string]string) float64 {
+	score, err := strconv.ParseFloat(metadata[MetadataRuleScore], 64)
+	if err != nil {
+		return 0
+	}
+	return score
 }
 
 // SummaryMode describes how summarization processors should interpret a PostBlock.
@@ -41,6 +118,10 @@ const (
 type ContentChunk struct {
 	Content string `json:"content" yaml:"content"`
 	Summary string `json:"summary,omitempty" yaml:"summary,omitempty"`
+	// Start and End locate a transcript chunk in its audio or video; both
+	// are zero for text chunks.
+	Start time.Duration `json:"start,omitempty" yaml:"start,omitempty"`
+	End   time.Duration `json:"end,omitempty" yaml:"end,omitempty"`
 }
 
 // SummaryPlan is an intent signal for summary processors describing how to handle the PostBlock.
@@ -51,7 +132,9 @@ type SummaryPlan struct {
 	ChunkLimit    int         `json:"chunk_limit,omitempty" yaml:"chunk_limit,omitempty"`
 }
 
-// CommentBlock contains data and metadata representing a Comment attached to a Post
+// CommentBlock contains data and metadata representing a Comment attached to a Post.
+// Replies nest recursively to form a comment tree; IsSubmitter marks comments
+// written by the post's author.
 type CommentBlock struct {
 	ID            string         `json:"id" yaml:"id"`
 	Author        string         `json:"author" yaml:"author"`
@@ -63,13 +146,21 @@ type CommentBlock struct {
 	WasSummarised bool           `json:"was_summarised" yaml:"was_summarised"`
 	Summary       string         `json:"summary,omitempty" yaml:"summary,omitempty"`
 	Quality       *QualityResult `json:"quality,omitempty" yaml:"quality,omitempty"`
+	Score         int            `json:"score,omitempty" yaml:"score,omitempty"`
+	Permalink     string         `json:"permalink,omitempty" yaml:"permalink,omitempty"`
+	IsSubmitter   bool           `json:"is_submitter,omitempty" yaml:"is_submitter,omitempty"`
+	Replies       []CommentBlock `json:"replies,omitempty" yaml:"replies,omitempty"`
 }
 
 // WebBlock contains the data and metadata of a website
 // This can represent a URL attached to a post and can optionally be used
 // to scrape data from that page for further processing
 type WebBlock struct {
-	URL           string         `json:"url" yaml:"url"`
+	URL string `json:"url" yaml:"url"`
+	// Title and Description come from a source's link preview (e.g. a
+	// Mastodon card), when it provides one.
+	Title         string         `json:"title,omitempty" yaml:"title,omitempty"`
+	Description   string         `json:"description,omitempty" yaml:"description,omitempty"`
 	WasFetched    bool           `json:"was_fetched" yaml:"was_fetched"`
 	Page          string         `json:"page,omitempty" yaml:"page,omitempty"`
 	Request       *http.Request  `json:"request,omitempty" yaml:"request,omitempty"`
@@ -82,12 +173,18 @@ type WebBlock struct {
 // An Image might start life as a URL parsed from another Block that matches
 // existing patterns for a URL that contains an image
 type ImageBlock struct {
-	URL           string         `json:"url" yaml:"url"`
+	URL string `json:"url" yaml:"url"`
+	// AltText is the author-provided image description, when the source has one.
+	AltText       string         `json:"alt_text,omitempty" yaml:"alt_text,omitempty"`
 	ImageData     []byte         `json:"image_data,omitempty" yaml:"image_data,omitempty"`
 	WasFetched    bool           `json:"was_fetched" yaml:"was_fetched"`
 	Summary       string         `json:"summary,omitempty" yaml:"summary,omitempty"`
 	WasSummarised bool           `json:"was_summarised" yaml:"was_summarised"`
 	Quality       *QualityResult `json:"quality,omitempty" yaml:"quality,omitempty"`
+
+	// ImageFile is only set in snapshots, where ImageData is written to a file
+	// next to the snapshot JSON instead of being inlined as base64.
+	ImageFile string `json:"image_file,omitempty" yaml:"image_file,omitempty"`
 }
 
 // QualityResult represents the output of quality assessment processors
@@ -100,6 +197,25 @@ type QualityResult struct {
 	ProcessedAt   time.Time         `json:"processed_at" yaml:"processed_at"`
 }
 
+// Alternate is a post merged into another as a duplicate. Its comments and web
+// blocks move to the primary block; this keeps the link to the discussion.
+type Alternate struct {
+	ID       string `json:"id" yaml:"id"`
+	URL      string `json:"url" yaml:"url"`
+	Title    string `json:"title" yaml:"title"`
+	Source   string `json:"source,omitempty" yaml:"source,omitempty"`
+	Comments int    `json:"comments,omitempty" yaml:"comments,omitempty"`
+}
+
+// DroppedBlock records a block a quality processor removed from the run and
+// why, so outputs and run reports can explain what was excluded.
+type DroppedBlock struct {
+	Block     *PostBlock `json:"block" yaml:"block"`
+	Processor string     `json:"processor" yaml:"processor"`
+	Reason    string     `json:"reason,omitempty" yaml:"reason,omitempty"`
+	DroppedAt time.Time  `json:"dropped_at" yaml:"dropped_at"`
+}
+
 // SummaryResult represents the output of summarization processors
 type SummaryResult struct {
 	ProcessorName string            `json:"processor_name" yaml:"processor_name"`
@@ -1,6 +1,10 @@
 package core
 
-import "context"
+import (
+	"context"
+	"sync"
+	"time"
+)
 
 type flowIDKey struct{}
 type runIDKey struct{}
@@ -38,3 +42,171 @@ func RunIDFromContext(ctx context.Context) string {
 	}
 	return ""
 }
+
+type runErrorsKey struct{}
+
+// RunErrors collects run-level errors that are not tied to a single block, such
+// as one feed of a multi-feed source failing while the others succeed.
+type RunErrors struct {
+	mu     sync.Mutex
+	errors []ProcessError
+}
+
+func (r *RunErrors) Add(err ProcessError) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.errors = append(r.errors, err)
+}
+
+func (r *RunErrors) Errors() []ProcessError {
+	if r == nil {
+		return nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]ProcessError(nil), r.errors...)
+}
+
+func WithRunErrors(ctx context.Context, errors *RunErrors) context.Context {
+	if ctx == nil || errors == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, runErrorsKey{}, errors)
+}
+
+// RecordRunError adds err to the collector attached to ctx. It is a no-op when
+// no collector is attached, so processors can call it unconditionally.
+func RecordRunError(ctx context.Context, err ProcessError) {
+	if ctx == nil {
+		return
+	}
+	if errors, ok := ctx.Value(runErrorsKey{}).(*RunErrors); ok {
+		errors.Add(err)
+	}
+}
+
+type droppedBlocksKey struct{}
+
+// DroppedBlocks collects the blocks quality processors removed during a run.
+type DroppedBlocks struct {
+	mu     sync.Mutex
+	blocks []DroppedBlock
+}
+
+func (d *DroppedBlocks) Add(block DroppedBlock) {
+	if d == nil {
+		return
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.blocks = append(d.blocks, block)
+}
+
+// Replace swaps the collected blocks, e.g. for those restored from a snapshot.
+func (d *DroppedBlocks) Replace(blocks []DroppedBlock) {
+	if d == nil {
+		return
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.blocks = append([]DroppedBlock(nil), blocks...)
+}
+
+func (d *DroppedBlocks) Blocks() []DroppedBlock {
+	if d == nil {
+		return nil
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return append([]DroppedBlock(nil), d.blocks...)
+}
+
+func WithDroppedBlocks(ctx context.Context, dropped *DroppedBlocks) context.Context {
+	if ctx == nil || dropped == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, droppedBlocksKey{}, dropped)
+}
+
+// DroppedBlocksFromContext returns the blocks dropped so far in the run
+// attached to ctx, or nil when there is none.
+func DroppedBlocksFromContext(ctx context.Context) []DroppedBlock {
+	if ctx == nil {
+		return nil
+	}
+	if dropped, ok := ctx.Value(droppedBlocksKey{}).(*DroppedBlocks); ok {
+		return dropped.Blocks()
+	}
+	return nil
+}
+
+type successHooksKey struct{}
+
+// SuccessHooks collects work that should only happen once a run has delivered
+// its outputs, such as advancing progress stored between runs. A run that fails
+// never calls them, so the next run repeats the same work.
+type SuccessHooks struct {
+	mu    sync.Mutex
+	hooks []successHook
+}
+
+type successHook struct {
+	processor string
+	fn        func(context.Context) error
+}
+
+func (h *SuccessHooks) Add(processorName string, fn func(context.Context) error) {
+	if h == nil || fn == nil {
+		return
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.hooks = append(h.hooks, successHook{processor: processorName, fn: fn})
+}
+
+// Run calls the collected hooks in order and empties the collector. Every hook
+// runs even when an earlier one fails; failures are returned as ProcessErrors.
+func (h *SuccessHooks) Run(ctx context.Context) []ProcessError {
+	if h == nil {
+		return nil
+	}
+	h.mu.Lock()
+	hooks := h.hooks
+	h.hooks = nil
+	h.mu.Unlock()
+
+	var errors []ProcessError
+	for _, hook := range hooks {
+		if err := hook.fn(ctx); err != nil {
+			errors = append(errors, ProcessError{
+				ProcessorName: hook.processor,
+				Stage:         "complete",
+				Error:         err.Error(),
+				OccurredAt:    time.Now().UTC(),
+			})
+		}
+	}
+	return errors
+}
+
+func WithSuccessHooks(ctx context.Context, hooks *SuccessHooks) context.Context {
+	if ctx == nil || hooks == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, successHooksKey{}, hooks)
+}
+
+// OnRunSuccess defers fn until the run attached to ctx completes. Without a
+// run, e.g. when a processor is used on its own, fn is called immediately.
+func OnRunSuccess(ctx context.Context, processorName string, fn func(context.Context) error) error {
+	if ctx != nil {
+		if hooks, ok := ctx.Value(successHooksKey{}).(*SuccessHooks); ok {
+			hooks.Add(processorName, fn)
+			return nil
+		}
+	}
+	return fn(ctx)
+}
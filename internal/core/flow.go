@@ -65,6 +65,7 @@ type Run struct {
 	Blocks      []*PostBlock           `json:"blocks,omitempty" yaml:"blocks,omitempty"`
 	RunSummary  *RunSummary            `json:"run_summary,omitempty" yaml:"run_summary,omitempty"`
 	Errors      []ProcessError         `json:"errors,omitempty" yaml:"errors,omitempty"`
+	Dropped     []DroppedBlock         `json:"dropped,omitempty" yaml:"dropped,omitempty"`
 	Metadata    map[string]interface{} `json:"metadata,omitempty" yaml:"metadata,omitempty"`
 }
 
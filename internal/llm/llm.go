@@ -1,6 +1,10 @@
 package llm
 
-import "context"
+import (
+	"context"
+	"io"
+	"time"
+)
 
 type MessageRole string
 
@@ -48,3 +52,32 @@ type ChatResponse struct {
 type Client interface {
 	ChatCompletion(ctx context.Context, request ChatRequest) (ChatResponse, error)
 }
+
+// TranscriptionRequest asks a speech-to-text model for a timestamped
+// transcript of one audio file.
+type TranscriptionRequest struct {
+	Model string
+	Audio io.Reader
+	// Filename carries the audio format (e.g. "episode.mp3") to the endpoint.
+	Filename string
+	// Language is an optional ISO-639-1 hint.
+	Language string
+}
+
+// TranscriptSegment is a span of speech, with offsets from the start of the audio.
+type TranscriptSegment struct {
+	Start time.Duration
+	End   time.Duration
+	Text  string
+}
+
+type TranscriptionResponse struct {
+	Text     string
+	Segments []TranscriptSegment
+}
+
+// Transcriber turns audio into text through an OpenAI-compatible
+// /audio/transcriptions endpoint.
+type Transcriber interface {
+	Transcribe(ctx context.Context, request TranscriptionRequest) (TranscriptionResponse, error)
+}
@@ -0,0 +1,94 @@
+package openai
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	openai "github.com/openai/openai-go"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+
+	"github.com/bakkerme/curator-ai/internal/core"
+	"github.com/bakkerme/curator-ai/internal/llm"
+)
+
+// verboseTranscription is the verbose_json body; the SDK type only exposes text.
+type verboseTranscription struct {
+	Text     string `json:"text"`
+	Segments []struct {
+		Start float64 `json:"start"`
+		End   float64 `json:"end"`
+		Text  string  `json:"text"`
+	} `json:"segments"`
+}
+
+// Transcribe requests a verbose_json transcript with segment timestamps.
+// Endpoints that ignore the format and return plain JSON yield a single
+// untimed segment.
+func (c *Client) Transcribe(ctx context.Context, request llm.TranscriptionRequest) (llm.TranscriptionResponse, error) {
+	tracer := otel.Tracer("curator-ai/llm/openai")
+	ctx, span := tracer.Start(ctx, "llm.openai.audio.transcriptions")
+	span.SetAttributes(
+		attribute.String("llm.provider", "openai"),
+		attribute.String("llm.model", request.Model),
+		attribute.String("flow.id", core.FlowIDFromContext(ctx)),
+		attribute.String("run.id", core.RunIDFromContext(ctx)),
+	)
+	defer span.End()
+
+	filename := request.Filename
+	if filename == "" {
+		filename = "audio.mp3"
+	}
+	params := openai.AudioTranscriptionNewParams{
+		File:                   openai.File(request.Audio, filename, ""),
+		Model:                  openai.AudioModel(request.Model),
+		ResponseFormat:         openai.AudioResponseFormatVerboseJSON,
+		TimestampGranularities: []string{"segment"},
+	}
+	if request.Language != "" {
+		params.Language = openai.String(request.Language)
+	}
+
+	response, err := c.client.Audio.Transcriptions.New(ctx, params)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return llm.TranscriptionResponse{}, err
+	}
+
+	var verbose verboseTranscription
+	if raw := response.RawJSON(); raw != "" {
+		if err := json.Unmarshal([]byte(raw), &verbose); err != nil {
+			err = fmt.Errorf("openai: decode transcription: %w", err)
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return llm.TranscriptionResponse{}, err
+		}
+	}
+	result := llm.TranscriptionResponse{Text: strings.TrimSpace(response.Text)}
+	for _, segment := range verbose.Segments {
+		text := strings.TrimSpace(segment.Text)
+		if text == "" {
+			continue
+		}
+		result.Segments = append(result.Segments, llm.TranscriptSegment{
+			Start: seconds(segment.Start),
+			End:   seconds(segment.End),
+			Text:  text,
+		})
+	}
+	if len(result.Segments) == 0 && result.Text != "" {
+		result.Segments = []llm.TranscriptSegment{{Text: result.Text}}
+	}
+	span.SetStatus(codes.Ok, "")
+	return result, nil
+}
+
+func seconds(value float64) time.Duration {
+	return time.Duration(value * float64(time.Second))
+}
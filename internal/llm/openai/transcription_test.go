@@ -0,0 +1,86 @@
+package openai
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bakkerme/curator-ai/internal/config"
+	"github.com/bakkerme/curator-ai/internal/llm"
+)
+
+func TestTranscribe_ParsesVerboseSegments(t *testing.T) {
+	t.Parallel()
+
+	type upload struct {
+		path, model, format, language, filename, audio string
+	}
+	uploads := make(chan upload, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			t.Errorf("parse multipart form: %v", err)
+		}
+		got := upload{
+			path:     r.URL.Path,
+			model:    r.FormValue("model"),
+			format:   r.FormValue("response_format"),
+			language: r.FormValue("language"),
+		}
+		if file, header, err := r.FormFile("file"); err == nil {
+			data, _ := io.ReadAll(file)
+			got.filename, got.audio = header.Filename, string(data)
+		}
+		uploads <- got
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"text": "Hello there. General Kenobi.", "segments": [
+			{"start": 0.0, "end": 1.5, "text": " Hello there."},
+			{"start": 1.5, "end": 3.25, "text": " General Kenobi."}
+		]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(config.OpenAIEnvConfig{BaseURL: server.URL, APIKey: "test"})
+	response, err := client.Transcribe(context.Background(), llm.TranscriptionRequest{
+		Model:    "whisper-1",
+		Audio:    strings.NewReader("fake-audio"),
+		Filename: "episode.m4a",
+		Language: "en",
+	})
+	if err != nil {
+		t.Fatalf("Transcribe returned error: %v", err)
+	}
+
+	got := <-uploads
+	if got.path != "/audio/transcriptions" || got.model != "whisper-1" || got.format != "verbose_json" || got.language != "en" {
+		t.Fatalf("unexpected request %+v", got)
+	}
+	if got.filename != "episode.m4a" || got.audio != "fake-audio" {
+		t.Fatalf("unexpected uploaded file %q with %q", got.filename, got.audio)
+	}
+	if len(response.Segments) != 2 || response.Segments[1].Text != "General Kenobi." || response.Segments[1].End != 3250*time.Millisecond {
+		t.Fatalf("unexpected segments %+v", response.Segments)
+	}
+}
+
+func TestTranscribe_PlainJSONBecomesOneSegment(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"text": "Only text."}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(config.OpenAIEnvConfig{BaseURL: server.URL, APIKey: "test"})
+	response, err := client.Transcribe(context.Background(), llm.TranscriptionRequest{Model: "whisper-1", Audio: strings.NewReader("x")})
+	if err != nil {
+		t.Fatalf("Transcribe returned error: %v", err)
+	}
+	if len(response.Segments) != 1 || response.Segments[0].Text != "Only text." {
+		t.Fatalf("unexpected segments %+v", response.Segments)
+	}
+}
@@ -3,6 +3,7 @@ package email
 import (
 	"html/template"
 
+	"github.com/bakkerme/curator-ai/internal/core"
 	rendermarkdown "github.com/bakkerme/curator-ai/internal/render/markdown"
 )
 
@@ -16,5 +17,8 @@ func TemplateFuncs() template.FuncMap {
 			}
 			return template.HTML(rendered), nil
 		},
+		"hasTag": func(metadata map[string]string, tag string) bool {
+			return core.HasTag(metadata, tag)
+		},
 	}
 }
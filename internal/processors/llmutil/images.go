@@ -176,25 +176,34 @@ captionLoop:
 	}
 }
 
+// imageURLForMessage prefers fetched image bytes (sent as a data URL) so the
+// provider never has to fetch an expired or hotlink-protected URL itself. The
+// original URL is used only when no usable bytes are attached.
 func imageURLForMessage(image *core.ImageBlock) (string, bool) {
 	if image == nil {
 		return "", false
 	}
+	if dataURL, ok := imageDataURL(image.ImageData); ok {
+		return dataURL, true
+	}
 	if image.URL != "" {
 		return image.URL, true
 	}
-	if len(image.ImageData) == 0 {
+	return "", false
+}
+
+func imageDataURL(data []byte) (string, bool) {
+	if len(data) == 0 {
 		return "", false
 	}
-
-	contentType := mimetype.Detect(image.ImageData).String()
+	contentType := mimetype.Detect(data).String()
 	if contentType == "" || contentType == "application/octet-stream" {
-		contentType = http.DetectContentType(image.ImageData)
+		contentType = http.DetectContentType(data)
 	}
 	if !strings.HasPrefix(contentType, "image/") {
 		return "", false
 	}
-	encoded := base64.StdEncoding.EncodeToString(image.ImageData)
+	encoded := base64.StdEncoding.EncodeToString(data)
 	return fmt.Sprintf("data:%s;base64,%s", contentType, encoded), true
 }
 
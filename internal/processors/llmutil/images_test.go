@@ -324,7 +324,21 @@ func TestImageURLForMessage_ReturnsFalseForUnknownBytes(t *testing.T) {
 	}
 }
 
-func TestImageURLForMessage_UsesURLWhenPresent(t *testing.T) {
+func TestImageURLForMessage_PrefersImageDataOverURL(t *testing.T) {
+	img := &core.ImageBlock{URL: "https://example.com/a.png", ImageData: []byte{
+		0x89, 0x50, 0x4E, 0x47, 0x0D, 0x0A, 0x1A, 0x0A, // PNG signature
+		0x00, 0x00, 0x00, 0x0D,
+	}}
+	url, ok := imageURLForMessage(img)
+	if !ok {
+		t.Fatalf("expected ok=true")
+	}
+	if !strings.HasPrefix(url, "data:image/png;base64,") {
+		t.Fatalf("expected data url, got %q", url)
+	}
+}
+
+func TestImageURLForMessage_FallsBackToURLForUnusableData(t *testing.T) {
 	img := &core.ImageBlock{URL: "https://example.com/a.jpg", ImageData: []byte("not-an-image")}
 	url, ok := imageURLForMessage(img)
 	if !ok {
@@ -59,7 +59,7 @@ func (p *EmailProcessor) Deliver(ctx context.Context, blocks []*core.PostBlock,
 	if err := p.Validate(); err != nil {
 		return fmt.Errorf("email processor validation failed: %w", err)
 	}
-	body, err := executeEmailTemplate(p.template, blocks, runSummary)
+	body, err := executeEmailTemplate(p.template, blocks, runSummary, core.DroppedBlocksFromContext(ctx))
 	if err != nil {
 		return fmt.Errorf("render email template failed: %w", err)
 	}
@@ -71,12 +71,12 @@ func (p *EmailProcessor) Deliver(ctx context.Context, blocks []*core.PostBlock,
 	})
 }
 
-func renderEmailTemplate(templateText string, blocks []*core.PostBlock, runSummary *core.RunSummary) (string, error) {
+func renderEmailTemplate(templateText string, blocks []*core.PostBlock, runSummary *core.RunSummary, dropped []core.DroppedBlock) (string, error) {
 	tmpl, err := parseEmailTemplate(templateText)
 	if err != nil {
 		return "", err
 	}
-	return executeEmailTemplate(tmpl, blocks, runSummary)
+	return executeEmailTemplate(tmpl, blocks, runSummary, dropped)
 }
 
 // parseEmailTemplate compiles the email template with Curator's template helpers.
@@ -89,9 +89,9 @@ func parseEmailTemplate(templateText string) (*template.Template, error) {
 }
 
 // executeEmailTemplate renders a compiled email template against the current run data.
-func executeEmailTemplate(tmpl *template.Template, blocks []*core.PostBlock, runSummary *core.RunSummary) (string, error) {
+func executeEmailTemplate(tmpl *template.Template, blocks []*core.PostBlock, runSummary *core.RunSummary, dropped []core.DroppedBlock) (string, error) {
 	var builder strings.Builder
-	data := newEmailTemplateData(blocks, runSummary)
+	data := newEmailTemplateData(blocks, runSummary, dropped)
 	if err := tmpl.Execute(&builder, data); err != nil {
 		return "", fmt.Errorf("execute email template failed: %w", err)
 	}
@@ -99,8 +99,24 @@ func executeEmailTemplate(tmpl *template.Template, blocks []*core.PostBlock, run
 }
 
 type emailTemplateData struct {
-	Blocks     []*emailPostBlock
+	Blocks []*emailPostBlock
+	// MustRead holds blocks flagged must_read (e.g. by a watchlist or a
+	// priority rule), Sections the remaining blocks routed to a named section
+	// by a section rule, and Others the rest. Blocks still lists everything.
+	MustRead   []*emailPostBlock
+	Sections   []*emailSection
+	Others     []*emailPostBlock
 	RunSummary *emailRunSummary
+	// Dropped lists the blocks quality processors removed this run, with the
+	// processor and reason, for "why was this excluded?" sections.
+	Dropped []core.DroppedBlock
+}
+
+// emailSection groups blocks that share Metadata["section"], in the order the
+// sections first appear.
+type emailSection struct {
+	Name   string
+	Blocks []*emailPostBlock
 }
 
 type emailPostBlock struct {
@@ -118,8 +134,11 @@ type emailRunSummary struct {
 	HTML template.HTML
 }
 
-func newEmailTemplateData(blocks []*core.PostBlock, runSummary *core.RunSummary) emailTemplateData {
+func newEmailTemplateData(blocks []*core.PostBlock, runSummary *core.RunSummary, dropped []core.DroppedBlock) emailTemplateData {
 	emailBlocks := make([]*emailPostBlock, 0, len(blocks))
+	var mustRead, others []*emailPostBlock
+	var sections []*emailSection
+	sectionIndex := make(map[string]*emailSection)
 	for _, block := range blocks {
 		var summary *emailSummaryResult
 		if block != nil && block.Summary != nil {
@@ -128,10 +147,29 @@ func newEmailTemplateData(blocks []*core.PostBlock, runSummary *core.RunSummary)
 				HTML:          template.HTML(block.Summary.HTML),
 			}
 		}
-		emailBlocks = append(emailBlocks, &emailPostBlock{
+		emailBlock := &emailPostBlock{
 			PostBlock: block,
 			Summary:   summary,
-		})
+		}
+		emailBlocks = append(emailBlocks, emailBlock)
+		var sectionName string
+		if block != nil {
+			sectionName = block.Metadata[core.MetadataSection]
+		}
+		switch {
+		case block != nil && block.Metadata[core.MetadataMustRead] == "true":
+			mustRead = append(mustRead, emailBlock)
+		case sectionName != "":
+			section, ok := sectionIndex[sectionName]
+			if !ok {
+				section = &emailSection{Name: sectionName}
+				sectionIndex[sectionName] = section
+				sections = append(sections, section)
+			}
+			section.Blocks = append(section.Blocks, emailBlock)
+		default:
+			others = append(others, emailBlock)
+		}
 	}
 
 	var emailRun *emailRunSummary
@@ -144,6 +182,10 @@ func newEmailTemplateData(blocks []*core.PostBlock, runSummary *core.RunSummary)
 
 	return emailTemplateData{
 		Blocks:     emailBlocks,
+		MustRead:   mustRead,
+		Sections:   sections,
+		Others:     others,
 		RunSummary: emailRun,
+		Dropped:    dropped,
 	}
 }
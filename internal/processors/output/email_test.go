@@ -23,6 +23,7 @@ func TestRenderEmailTemplate_RendersSummaryHTMLUnescaped(t *testing.T) {
 		&core.RunSummary{
 			HTML: `<p>Run summary</p>`,
 		},
+		nil,
 	)
 	if err != nil {
 		t.Fatalf("renderEmailTemplate failed: %v", err)
@@ -53,6 +54,7 @@ func TestRenderEmailTemplate_RendersMarkdownViaToHTML(t *testing.T) {
 		&core.RunSummary{
 			Summary: "# Run summary",
 		},
+		nil,
 	)
 	if err != nil {
 		t.Fatalf("renderEmailTemplate failed: %v", err)
@@ -70,7 +72,7 @@ func TestRenderEmailTemplate_RendersMarkdownViaToHTML(t *testing.T) {
 }
 
 func TestRenderEmailTemplate_ToHTMLSupportsEmptyInput(t *testing.T) {
-	body, err := renderEmailTemplate(`before{{toHTML .RunSummary.Summary}}after`, nil, &core.RunSummary{})
+	body, err := renderEmailTemplate(`before{{toHTML .RunSummary.Summary}}after`, nil, &core.RunSummary{}, nil)
 	if err != nil {
 		t.Fatalf("renderEmailTemplate failed: %v", err)
 	}
@@ -93,7 +95,67 @@ func TestExecuteEmailTemplate_ToHTMLPropagatesErrors(t *testing.T) {
 		},
 	}).Parse(`{{toHTML .RunSummary.Summary}}`))
 
-	if _, err := executeEmailTemplate(tmpl, nil, &core.RunSummary{Summary: "x"}); err == nil {
+	if _, err := executeEmailTemplate(tmpl, nil, &core.RunSummary{Summary: "x"}, nil); err == nil {
 		t.Fatal("expected executeEmailTemplate to return template function errors")
 	}
 }
+
+func TestRenderEmailTemplate_SplitsMustReadBlocks(t *testing.T) {
+	body, err := renderEmailTemplate(
+		`must:{{range .MustRead}}{{.Title}};{{end}}others:{{range .Others}}{{.Title}};{{end}}all:{{len .Blocks}}`,
+		[]*core.PostBlock{
+			{Title: "regular"},
+			{Title: "watched", Metadata: map[string]string{core.MetadataMustRead: "true"}},
+		},
+		nil,
+		nil,
+	)
+	if err != nil {
+		t.Fatalf("renderEmailTemplate failed: %v", err)
+	}
+	if body != "must:watched;others:regular;all:2" {
+		t.Fatalf("unexpected body %q", body)
+	}
+}
+
+func TestRenderEmailTemplate_GroupsSectionsAndTags(t *testing.T) {
+	body, err := renderEmailTemplate(
+		`must:{{range .MustRead}}{{.Title}};{{end}}`+
+			`{{range .Sections}}[{{.Name}}]{{range .Blocks}}{{.Title}};{{end}}{{end}}`+
+			`others:{{range .Others}}{{if hasTag .Metadata "security"}}!{{end}}{{.Title}};{{end}}`,
+		[]*core.PostBlock{
+			{Title: "cve", Metadata: map[string]string{core.MetadataSection: "Security", core.MetadataTags: "security"}},
+			{Title: "release", Metadata: map[string]string{core.MetadataSection: "Releases"}},
+			{Title: "advisory", Metadata: map[string]string{core.MetadataSection: "Security"}},
+			{Title: "watched", Metadata: map[string]string{core.MetadataSection: "Security", core.MetadataMustRead: "true"}},
+			{Title: "patch", Metadata: map[string]string{core.MetadataTags: "news,security"}},
+			{Title: "regular"},
+		},
+		nil,
+		nil,
+	)
+	if err != nil {
+		t.Fatalf("renderEmailTemplate failed: %v", err)
+	}
+	if body != "must:watched;[Security]cve;advisory;[Releases]release;others:!patch;regular;" {
+		t.Fatalf("unexpected body %q", body)
+	}
+}
+
+func TestRenderEmailTemplate_ListsDroppedBlocks(t *testing.T) {
+	body, err := renderEmailTemplate(
+		`kept:{{len .Blocks}}{{range .Dropped}};{{.Block.Title}} by {{.Processor}}: {{.Reason}}{{end}}`,
+		[]*core.PostBlock{{Title: "kept"}},
+		nil,
+		[]core.DroppedBlock{
+			{Block: &core.PostBlock{Title: "quiet"}, Processor: "min_comments", Reason: "matched rule: comment_count < 5"},
+			{Block: &core.PostBlock{Title: "off topic"}, Processor: "is_relevant", Reason: "not about LLMs"},
+		},
+	)
+	if err != nil {
+		t.Fatalf("renderEmailTemplate failed: %v", err)
+	}
+	if body != "kept:1;quiet by min_comments: matched rule: comment_count &lt; 5;off topic by is_relevant: not about LLMs" {
+		t.Fatalf("unexpected body %q", body)
+	}
+}
@@ -0,0 +1,397 @@
+package quality
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/bakkerme/curator-ai/internal/config"
+	"github.com/bakkerme/curator-ai/internal/core"
+	"github.com/bakkerme/curator-ai/internal/state"
+)
+
+const (
+	dedupeStateNamespace   = "dedupe_fingerprints"
+	defaultTitleSimilarity = 0.8
+	defaultContentDistance = 3
+	defaultMinContentWords = 50
+	// maxRememberedFingerprints caps what one processor keeps between runs;
+	// the oldest fingerprints go first.
+	maxRememberedFingerprints = 5000
+)
+
+// DedupeProcessor clusters posts about the same story, whichever sources
+// they came from, and merges each cluster into one primary block. The other
+// posts are dropped; their comments and web blocks move to the primary and
+// their links are kept as Alternates. With remember set, fingerprints are
+// kept in the state store so later re-posts of a story are dropped too.
+type DedupeProcessor struct {
+	name       string
+	config     config.DedupeQuality
+	match      map[string]bool
+	remember   time.Duration
+	stateStore state.Store
+	logger     *slog.Logger
+	now        func() time.Time
+}
+
+// NewDedupeProcessor wires a dedupe quality processor. stateStore is only
+// needed with remember.
+func NewDedupeProcessor(cfg *config.DedupeQuality, stateStore state.Store, logger *slog.Logger) (*DedupeProcessor, error) {
+	if cfg == nil {
+		return nil, fmt.Errorf("dedupe config is required")
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+	signals := cfg.Match
+	if len(signals) == 0 {
+		signals = []string{config.DedupeMatchURL, config.DedupeMatchLinks, config.DedupeMatchTitle, config.DedupeMatchContent}
+	}
+	match := make(map[string]bool, len(signals))
+	for _, signal := range signals {
+		match[signal] = true
+	}
+	var remember time.Duration
+	if cfg.Remember != "" {
+		parsed, err := config.ParseDurationExtended(cfg.Remember)
+		if err != nil {
+			return nil, fmt.Errorf("dedupe remember: %w", err)
+		}
+		remember = parsed
+	}
+	return &DedupeProcessor{
+		name:       cfg.Name,
+		config:     *cfg,
+		match:      match,
+		remember:   remember,
+		stateStore: stateStore,
+		logger:     logger,
+		now:        time.Now,
+	}, nil
+}
+
+func (p *DedupeProcessor) Name() string {
+	return p.name
+}
+
+func (p *DedupeProcessor) Configure(config map[string]interface{}) error {
+	return nil
+}
+
+func (p *DedupeProcessor) Validate() error {
+	if p.config.Name == "" {
+		return fmt.Errorf("dedupe name is required")
+	}
+	if p.remember > 0 && p.stateStore == nil {
+		return fmt.Errorf("dedupe remember requires a state store")
+	}
+	return nil
+}
+
+func (p *DedupeProcessor) Evaluate(ctx context.Context, blocks []*core.PostBlock) ([]*core.PostBlock, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	logger := core.LoggerFromContext(ctx).With("stage", "quality", "processor", p.name)
+	now := p.now().UTC()
+
+	var posts []*core.PostBlock
+	for _, block := range blocks {
+		if block != nil {
+			posts = append(posts, block)
+		}
+	}
+	fingerprints := make([]fingerprint, len(posts))
+	for i, block := range posts {
+		fingerprints[i] = newFingerprint(block, p.minContentWords())
+		fingerprints[i].SeenAt = now
+	}
+
+	remembered, err := p.loadFingerprints(ctx, now)
+	if err != nil {
+		return nil, err
+	}
+
+	kept := make(map[*core.PostBlock]bool, len(posts))
+	var fresh []fingerprint
+	merged, suppressed := 0, 0
+	for _, members := range p.cluster(fingerprints) {
+		if previous, reason, ok := p.seenBefore(fingerprints, members, remembered); ok {
+			for _, i := range members {
+				posts[i].Quality = &core.QualityResult{
+					ProcessorName: p.name,
+					Result:        "drop",
+					Reason:        fmt.Sprintf("already seen on %s: %s (%s)", previous.SeenAt.Format("2006-01-02"), previous.URL, reason),
+					ProcessedAt:   now,
+				}
+			}
+			suppressed += len(members)
+			continue
+		}
+		primary := p.primary(posts, members)
+		kept[posts[primary]] = true
+		for _, i := range members {
+			fresh = append(fresh, fingerprints[i])
+			if i == primary {
+				continue
+			}
+			reason, ok := p.similar(fingerprints[primary], fingerprints[i])
+			if !ok {
+				reason = "matches a related duplicate"
+			}
+			p.merge(posts[primary], posts[i], reason, now)
+			merged++
+		}
+	}
+
+	filtered := make([]*core.PostBlock, 0, len(kept))
+	for _, block := range posts {
+		if kept[block] {
+			filtered = append(filtered, block)
+		}
+	}
+	if merged > 0 || suppressed > 0 {
+		logger.Info("Deduplicated posts", "blocks", len(posts), "merged", merged, "seen_before", suppressed)
+	}
+
+	// Fingerprints are stored once the run delivers its outputs; a failed run
+	// must not suppress the same posts on the retry.
+	if p.remember > 0 {
+		all := append(remembered, fresh...)
+		if err := core.OnRunSuccess(ctx, p.name, func(ctx context.Context) error {
+			return p.saveFingerprints(ctx, all)
+		}); err != nil {
+			return nil, err
+		}
+	}
+	return filtered, nil
+}
+
+// cluster groups the indexes of matching fingerprints, transitively, in the
+// order each cluster's first post appears.
+func (p *DedupeProcessor) cluster(fingerprints []fingerprint) [][]int {
+	parent := make([]int, len(fingerprints))
+	for i := range parent {
+		parent[i] = i
+	}
+	find := func(i int) int {
+		for parent[i] != i {
+			parent[i] = parent[parent[i]]
+			i = parent[i]
+		}
+		return i
+	}
+	for i := range fingerprints {
+		for j := i + 1; j < len(fingerprints); j++ {
+			if _, ok := p.similar(fingerprints[i], fingerprints[j]); !ok {
+				continue
+			}
+			// Keep the earliest post as the root so clusters stay in order.
+			ri, rj := find(i), find(j)
+			if ri == rj {
+				continue
+			}
+			if rj < ri {
+				ri, rj = rj, ri
+			}
+			parent[rj] = ri
+		}
+	}
+
+	var clusters [][]int
+	index := make(map[int]int)
+	for i := range fingerprints {
+		root := find(i)
+		if c, ok := index[root]; ok {
+			clusters[c] = append(clusters[c], i)
+			continue
+		}
+		index[root] = len(clusters)
+		clusters = append(clusters, []int{i})
+	}
+	return clusters
+}
+
+// similar reports whether two posts are about the same story and which
+// signal matched.
+func (p *DedupeProcessor) similar(a, b fingerprint) (string, bool) {
+	if p.match[config.DedupeMatchURL] && sharesAny(a.URLs, b.URLs) {
+		return "same URL", true
+	}
+	// Links only match the other post's own URL. Two posts that merely link
+	// the same page (a license, a project homepage) are not the same story,
+	// and since matches are transitive one shared link could chain a whole
+	// run together.
+	if p.match[config.DedupeMatchLinks] && (sharesAny(a.Links, b.URLs) || sharesAny(a.URLs, b.Links)) {
+		return "same link", true
+	}
+	if p.match[config.DedupeMatchTitle] && len(a.Tokens) >= minTitleTokens && len(b.Tokens) >= minTitleTokens &&
+		jaccard(a.Tokens, b.Tokens) >= p.titleSimilarity() {
+		return "similar title", true
+	}
+	if p.match[config.DedupeMatchContent] && a.SimHash != 0 && b.SimHash != 0 &&
+		hammingDistance(a.SimHash, b.SimHash) <= p.contentDistance() {
+		return "similar content", true
+	}
+	return "", false
+}
+
+// seenBefore returns the remembered fingerprint that any member of a cluster
+// matches.
+func (p *DedupeProcessor) seenBefore(fingerprints []fingerprint, members []int, remembered []fingerprint) (fingerprint, string, bool) {
+	for _, i := range members {
+		for _, previous := range remembered {
+			if reason, ok := p.similar(fingerprints[i], previous); ok {
+				return previous, reason, true
+			}
+		}
+	}
+	return fingerprint{}, "", false
+}
+
+// primary picks the member a cluster is merged into.
+func (p *DedupeProcessor) primary(posts []*core.PostBlock, members []int) int {
+	best := members[0]
+	for _, i := range members[1:] {
+		switch p.config.Primary {
+		case config.DedupePrimaryMostComments:
+			if commentCount(posts[i]) > commentCount(posts[best]) {
+				best = i
+			}
+		case config.DedupePrimaryLongest:
+			if len(posts[i].Content) > len(posts[best].Content) {
+				best = i
+			}
+		}
+	}
+	return best
+}
+
+// commentCount prefers the fetched comments and falls back to the count a
+// source reported without fetching them (e.g. Reddit's num_comments).
+func commentCount(block *core.PostBlock) int {
+	return max(len(block.Comments), int(metadataInt(block.Metadata, "num_comments")))
+}
+
+// merge moves alternate's discussion into primary and marks it dropped.
+func (p *DedupeProcessor) merge(primary *core.PostBlock, alternate *core.PostBlock, reason string, now time.Time) {
+	primary.Alternates = append(primary.Alternates, core.Alternate{
+		ID:       alternate.ID,
+		URL:      alternate.URL,
+		Title:    alternate.Title,
+		Source:   alternate.Metadata[core.MetadataSource],
+		Comments: commentCount(alternate),
+	})
+	primary.Alternates = append(primary.Alternates, alternate.Alternates...)
+	primary.Comments = append(primary.Comments, alternate.Comments...)
+
+	known := map[string]bool{linkKey(primary.URL): true}
+	for _, web := range primary.WebBlocks {
+		known[linkKey(web.URL)] = true
+	}
+	for _, web := range alternate.WebBlocks {
+		if key := linkKey(web.URL); !known[key] {
+			known[key] = true
+			primary.WebBlocks = append(primary.WebBlocks, web)
+		}
+	}
+
+	alternate.Quality = &core.QualityResult{
+		ProcessorName: p.name,
+		Result:        "drop",
+		Reason:        fmt.Sprintf("duplicate of %s (%s)", primary.URL, reason),
+		ProcessedAt:   now,
+	}
+}
+
+func linkKey(raw string) string {
+	if canonical := canonicalURL(raw); canonical != "" {
+		return canonical
+	}
+	return strings.TrimSpace(raw)
+}
+
+func sharesAny(a, b []string) bool {
+	for _, value := range a {
+		if slices.Contains(b, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *DedupeProcessor) titleSimilarity() float64 {
+	if p.config.TitleSimilarity != nil {
+		return *p.config.TitleSimilarity
+	}
+	return defaultTitleSimilarity
+}
+
+func (p *DedupeProcessor) contentDistance() int {
+	if p.config.ContentDistance != nil {
+		return *p.config.ContentDistance
+	}
+	return defaultContentDistance
+}
+
+func (p *DedupeProcessor) minContentWords() int {
+	if p.config.MinContentWords > 0 {
+		return p.config.MinContentWords
+	}
+	return defaultMinContentWords
+}
+
+// loadFingerprints returns the remembered fingerprints that are still within
+// the remember window.
+func (p *DedupeProcessor) loadFingerprints(ctx context.Context, now time.Time) ([]fingerprint, error) {
+	if p.remember <= 0 {
+		return nil, nil
+	}
+	raw, ok, err := p.stateStore.Get(ctx, dedupeStateNamespace, p.stateKey(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("load dedupe fingerprints: %w", err)
+	}
+	if !ok {
+		return nil, nil
+	}
+	var stored []fingerprint
+	if err := json.Unmarshal([]byte(raw), &stored); err != nil {
+		return nil, fmt.Errorf("parse dedupe fingerprints: %w", err)
+	}
+	cutoff := now.Add(-p.remember)
+	fresh := stored[:0]
+	for _, fp := range stored {
+		if fp.SeenAt.After(cutoff) {
+			fresh = append(fresh, fp)
+		}
+	}
+	return fresh, nil
+}
+
+func (p *DedupeProcessor) saveFingerprints(ctx context.Context, fingerprints []fingerprint) error {
+	if len(fingerprints) > maxRememberedFingerprints {
+		fingerprints = fingerprints[len(fingerprints)-maxRememberedFingerprints:]
+	}
+	raw, err := json.Marshal(fingerprints)
+	if err != nil {
+		return err
+	}
+	if err := p.stateStore.Set(ctx, dedupeStateNamespace, p.stateKey(ctx), string(raw)); err != nil {
+		return fmt.Errorf("save dedupe fingerprints: %w", err)
+	}
+	return nil
+}
+
+// stateKey scopes remembered fingerprints to the flow and processor.
+func (p *DedupeProcessor) stateKey(ctx context.Context) string {
+	flowID := core.FlowIDFromContext(ctx)
+	if flowID == "" {
+		flowID = "default"
+	}
+	return flowID + "/" + p.name
+}
@@ -0,0 +1,183 @@
+package quality
+
+import (
+	"hash/fnv"
+	"math/bits"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/bakkerme/curator-ai/internal/core"
+)
+
+// shingleSize is the number of consecutive words hashed together for the
+// content SimHash; short runs of words keep reworded copies close.
+const shingleSize = 3
+
+// minTitleTokens keeps short generic titles ("Weekly thread") from matching
+// on title alone.
+const minTitleTokens = 3
+
+// trackingParams are query parameters that identify a click, not a page.
+var trackingParams = map[string]bool{
+	"fbclid": true, "gclid": true, "dclid": true, "msclkid": true, "igshid": true,
+	"mc_cid": true, "mc_eid": true, "ref": true, "ref_src": true, "si": true,
+}
+
+var titleStopwords = map[string]bool{
+	"a": true, "an": true, "the": true, "of": true, "for": true, "to": true, "in": true, "on": true,
+	"and": true, "or": true, "is": true, "are": true, "with": true, "at": true, "by": true, "from": true,
+}
+
+// fingerprint is what dedupe compares posts by. It is also the form kept in
+// the state store when remember is set.
+type fingerprint struct {
+	URL    string   `json:"url"`
+	URLs   []string `json:"urls,omitempty"`
+	Links  []string `json:"links,omitempty"`
+	Tokens []string `json:"tokens,omitempty"`
+	// SimHash is zero when the content is too short to compare.
+	SimHash uint64    `json:"simhash,omitempty"`
+	SeenAt  time.Time `json:"seen_at"`
+}
+
+func newFingerprint(block *core.PostBlock, minContentWords int) fingerprint {
+	fp := fingerprint{URL: block.URL, Tokens: titleTokens(block.Title)}
+	if canonical := canonicalURL(block.URL); canonical != "" {
+		fp.URLs = []string{canonical}
+	}
+	for _, web := range block.WebBlocks {
+		if canonical := canonicalURL(web.URL); canonical != "" && !slices.Contains(fp.Links, canonical) {
+			fp.Links = append(fp.Links, canonical)
+		}
+	}
+	if words := contentWords(block.Content); len(words) >= minContentWords {
+		fp.SimHash = simHash(words)
+	}
+	return fp
+}
+
+// canonicalURL reduces a URL to the page it identifies: no scheme, www. or
+// m. prefix, fragment, trailing slash or tracking parameters, with the query
+// sorted. arXiv PDF and versioned links map to the abstract and youtu.be to
+// youtube.com. Site roots and non-http(s) URLs return "" since they don't
+// identify a story.
+func canonicalURL(raw string) string {
+	parsed, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return ""
+	}
+	host := strings.ToLower(parsed.Hostname())
+	for _, prefix := range []string{"www.", "m.", "mobile."} {
+		host = strings.TrimPrefix(host, prefix)
+	}
+	path := strings.TrimRight(parsed.EscapedPath(), "/")
+	query := parsed.Query()
+	switch host {
+	case "arxiv.org":
+		if id, ok := strings.CutPrefix(path, "/pdf/"); ok {
+			path = "/abs/" + strings.TrimSuffix(id, ".pdf")
+		}
+		if strings.HasPrefix(path, "/abs/") {
+			if i := strings.LastIndex(path, "v"); i > len("/abs/") && isDigits(path[i+1:]) {
+				path = path[:i]
+			}
+		}
+	case "youtu.be":
+		if id := strings.Trim(path, "/"); id != "" {
+			host, path = "youtube.com", "/watch"
+			query.Set("v", id)
+		}
+	}
+	for key := range query {
+		if strings.HasPrefix(strings.ToLower(key), "utm_") || trackingParams[strings.ToLower(key)] {
+			query.Del(key)
+		}
+	}
+	if path == "" && len(query) == 0 {
+		return ""
+	}
+	if encoded := query.Encode(); encoded != "" {
+		return host + path + "?" + encoded
+	}
+	return host + path
+}
+
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// titleTokens returns the sorted, distinct lowercase words of a title without
+// stopwords.
+func titleTokens(title string) []string {
+	var tokens []string
+	for _, word := range strings.FieldsFunc(strings.ToLower(title), isWordSeparator) {
+		if !titleStopwords[word] && !slices.Contains(tokens, word) {
+			tokens = append(tokens, word)
+		}
+	}
+	slices.Sort(tokens)
+	return tokens
+}
+
+func contentWords(content string) []string {
+	return strings.FieldsFunc(strings.ToLower(content), isWordSeparator)
+}
+
+func isWordSeparator(r rune) bool {
+	return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+}
+
+// jaccard is the share of distinct tokens two sorted token lists have in
+// common.
+func jaccard(a, b []string) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+	shared := 0
+	for _, token := range a {
+		if _, found := slices.BinarySearch(b, token); found {
+			shared++
+		}
+	}
+	return float64(shared) / float64(len(a)+len(b)-shared)
+}
+
+// simHash is a 64-bit SimHash over word shingles: near-identical texts get
+// hashes that differ in few bits.
+func simHash(words []string) uint64 {
+	var weights [64]int
+	for i := 0; i+shingleSize <= len(words); i++ {
+		h := fnv.New64a()
+		h.Write([]byte(strings.Join(words[i:i+shingleSize], " ")))
+		sum := h.Sum64()
+		for bit := range weights {
+			if sum&(1<<bit) != 0 {
+				weights[bit]++
+			} else {
+				weights[bit]--
+			}
+		}
+	}
+	var hash uint64
+	for bit, weight := range weights {
+		if weight > 0 {
+			hash |= 1 << bit
+		}
+	}
+	return hash
+}
+
+func hammingDistance(a, b uint64) int {
+	return bits.OnesCount64(a ^ b)
+}
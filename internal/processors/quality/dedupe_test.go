@@ -0,0 +1,249 @@
+package quality
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bakkerme/curator-ai/internal/config"
+	"github.com/bakkerme/curator-ai/internal/core"
+	"github.com/bakkerme/curator-ai/internal/state"
+)
+
+func TestCanonicalURL(t *testing.T) {
+	cases := map[string]string{
+		"https://www.Example.com/news/launch/?utm_source=rss&id=7#comments": "example.com/news/launch?id=7",
+		"http://m.example.com/news/launch?fbclid=abc":                       "example.com/news/launch",
+		"https://arxiv.org/pdf/2401.01234v2.pdf":                            "arxiv.org/abs/2401.01234",
+		"https://arxiv.org/abs/2401.01234v3":                                "arxiv.org/abs/2401.01234",
+		"https://youtu.be/dQw4w9WgXcQ?si=share":                             "youtube.com/watch?v=dQw4w9WgXcQ",
+		"https://example.com/":                                              "",
+		"mailto:team@example.com":                                           "",
+	}
+	for raw, want := range cases {
+		if got := canonicalURL(raw); got != want {
+			t.Errorf("canonicalURL(%q) = %q, want %q", raw, got, want)
+		}
+	}
+}
+
+func TestDedupeProcessorMergesClustersAcrossSources(t *testing.T) {
+	article := &core.PostBlock{
+		ID:        "rss-1",
+		URL:       "https://blog.example.com/posts/model-release?utm_source=feed",
+		Title:     "Example Labs releases Orca 2 open weights model",
+		WebBlocks: []core.WebBlock{{URL: "https://github.com/example/orca"}},
+		Metadata:  map[string]string{core.MetadataSource: "rss"},
+	}
+	reddit := &core.PostBlock{
+		ID:        "reddit-1",
+		URL:       "https://www.reddit.com/r/LocalLLaMA/comments/abc/orca_2/",
+		Title:     "Orca 2 weights are out",
+		Comments:  []core.CommentBlock{{ID: "c1"}, {ID: "c2"}},
+		WebBlocks: []core.WebBlock{{URL: "https://blog.example.com/posts/model-release"}, {URL: "https://huggingface.co/example/orca-2"}},
+		Metadata:  map[string]string{core.MetadataSource: "reddit", "num_comments": "40"},
+	}
+	forum := &core.PostBlock{
+		ID:       "forum-1",
+		URL:      "https://discuss.example.org/t/orca/99",
+		Title:    "Example Labs releases Orca 2, open weights model",
+		Comments: []core.CommentBlock{{ID: "c3"}},
+		Metadata: map[string]string{core.MetadataSource: "forum"},
+	}
+	paperPDF := &core.PostBlock{ID: "arxiv-1", URL: "https://arxiv.org/pdf/2401.01234v1.pdf", Title: "Paper"}
+	paperAbs := &core.PostBlock{ID: "hf-1", URL: "https://arxiv.org/abs/2401.01234v2", Title: "Daily paper"}
+	other := &core.PostBlock{ID: "rss-2", URL: "https://blog.example.com/posts/other", Title: "Unrelated benchmark results"}
+
+	processor, err := NewDedupeProcessor(&config.DedupeQuality{Name: "dedupe"}, nil, nil)
+	if err != nil {
+		t.Fatalf("NewDedupeProcessor error: %v", err)
+	}
+	kept, err := processor.Evaluate(context.Background(), []*core.PostBlock{article, reddit, forum, paperPDF, other, paperAbs})
+	if err != nil {
+		t.Fatalf("Evaluate error: %v", err)
+	}
+
+	if len(kept) != 3 || kept[0] != article || kept[1] != paperPDF || kept[2] != other {
+		t.Fatalf("expected the first post of each cluster and the unrelated post, got %v", blockIDs(kept))
+	}
+	if got := article.Alternates; len(got) != 2 || got[0].ID != "reddit-1" || got[0].Source != "reddit" || got[0].Comments != 40 || got[1].ID != "forum-1" {
+		t.Fatalf("unexpected alternates %+v", got)
+	}
+	if len(article.Comments) != 3 {
+		t.Fatalf("expected the discussions' comments merged, got %d", len(article.Comments))
+	}
+	if len(article.WebBlocks) != 2 || article.WebBlocks[1].URL != "https://huggingface.co/example/orca-2" {
+		t.Fatalf("expected new web blocks merged without the primary's own URL, got %+v", article.WebBlocks)
+	}
+	if article.Quality != nil {
+		t.Fatalf("expected the primary's quality to be left alone, got %+v", article.Quality)
+	}
+	if got := reddit.Quality; got == nil || got.Result != "drop" || !strings.Contains(got.Reason, "same link") {
+		t.Fatalf("expected the reddit post dropped as a duplicate link, got %+v", got)
+	}
+	if got := forum.Quality; got == nil || !strings.Contains(got.Reason, "similar title") {
+		t.Fatalf("expected the forum post dropped on title, got %+v", got)
+	}
+	if got := paperAbs.Quality; got == nil || !strings.Contains(got.Reason, "duplicate of https://arxiv.org/pdf/2401.01234v1.pdf (same URL)") {
+		t.Fatalf("expected arXiv variants to share a URL, got %+v", got)
+	}
+}
+
+func TestDedupeProcessorIgnoresSharedBoilerplateLinks(t *testing.T) {
+	license := core.WebBlock{URL: "https://www.apache.org/licenses/LICENSE-2.0"}
+	first := &core.PostBlock{ID: "a", URL: "https://github.com/example/tokenizer", Title: "Fast tokenizer in Rust", WebBlocks: []core.WebBlock{license}}
+	second := &core.PostBlock{ID: "b", URL: "https://github.com/example/vector-db", Title: "Embedded vector database", WebBlocks: []core.WebBlock{license}}
+	third := &core.PostBlock{
+		ID:        "c",
+		URL:       "https://news.example/launch",
+		Title:     "Weekly launch roundup",
+		WebBlocks: []core.WebBlock{license, {URL: "https://github.com/example/tokenizer"}},
+	}
+
+	processor, err := NewDedupeProcessor(&config.DedupeQuality{Name: "dedupe"}, nil, nil)
+	if err != nil {
+		t.Fatalf("NewDedupeProcessor error: %v", err)
+	}
+	kept, err := processor.Evaluate(context.Background(), []*core.PostBlock{first, second, third})
+	if err != nil {
+		t.Fatalf("Evaluate error: %v", err)
+	}
+	if len(kept) != 2 || kept[0] != first || kept[1] != second {
+		t.Fatalf("expected only the post linking the first one merged, got %v", blockIDs(kept))
+	}
+	if second.Quality != nil || len(first.Alternates) != 1 || first.Alternates[0].ID != "c" {
+		t.Fatalf("expected a shared license link not to chain posts together, got alternates %+v", first.Alternates)
+	}
+}
+
+func TestDedupeProcessorMatchesContentAndPicksPrimary(t *testing.T) {
+	text := strings.Repeat("the new scheduler cuts tail latency for batch inference across mixed hardware fleets ", 8)
+	first := &core.PostBlock{ID: "a", URL: "https://one.example/a", Title: "Scheduler news", Content: text}
+	second := &core.PostBlock{ID: "b", URL: "https://two.example/b", Title: "Latency write-up", Content: text + "Updated with benchmarks.", Comments: []core.CommentBlock{{ID: "c"}}}
+	third := &core.PostBlock{ID: "c", URL: "https://three.example/c", Title: "Gardening tips", Content: strings.Repeat("tomatoes need sun water and patience through the long summer months ", 8)}
+
+	processor, err := NewDedupeProcessor(&config.DedupeQuality{Name: "dedupe", Match: []string{config.DedupeMatchContent}, Primary: config.DedupePrimaryMostComments}, nil, nil)
+	if err != nil {
+		t.Fatalf("NewDedupeProcessor error: %v", err)
+	}
+	kept, err := processor.Evaluate(context.Background(), []*core.PostBlock{first, second, third})
+	if err != nil {
+		t.Fatalf("Evaluate error: %v", err)
+	}
+	if len(kept) != 2 || kept[0] != second || kept[1] != third {
+		t.Fatalf("expected the most discussed copy and the unrelated post, got %v", blockIDs(kept))
+	}
+	if got := first.Quality; got == nil || !strings.Contains(got.Reason, "similar content") {
+		t.Fatalf("expected the other copy dropped on content, got %+v", got)
+	}
+}
+
+func TestDedupeProcessorContentDistanceZeroMatchesOnlyIdenticalHashes(t *testing.T) {
+	text := strings.Repeat("the new scheduler cuts tail latency for batch inference across mixed hardware fleets ", 8)
+	first := &core.PostBlock{ID: "a", URL: "https://one.example/a", Title: "Scheduler news", Content: text}
+	copied := &core.PostBlock{ID: "b", URL: "https://two.example/b", Title: "Scheduler repost", Content: text}
+	edited := &core.PostBlock{ID: "c", URL: "https://three.example/c", Title: "Latency write-up", Content: text + "Updated with benchmarks, a new appendix and notes from the maintainers."}
+
+	distance := 0
+	processor, err := NewDedupeProcessor(&config.DedupeQuality{Name: "dedupe", Match: []string{config.DedupeMatchContent}, ContentDistance: &distance}, nil, nil)
+	if err != nil {
+		t.Fatalf("NewDedupeProcessor error: %v", err)
+	}
+	if got := processor.contentDistance(); got != 0 {
+		t.Fatalf("expected configured distance 0, got %d", got)
+	}
+	kept, err := processor.Evaluate(context.Background(), []*core.PostBlock{first, copied, edited})
+	if err != nil {
+		t.Fatalf("Evaluate error: %v", err)
+	}
+	if len(kept) != 2 || kept[0] != first || kept[1] != edited {
+		t.Fatalf("expected only the identical copy merged, got %v", blockIDs(kept))
+	}
+}
+
+func TestDedupeProcessorRemembersAcrossRuns(t *testing.T) {
+	store, err := state.NewSQLiteStore(filepath.Join(t.TempDir(), "state.db"), "")
+	if err != nil {
+		t.Fatalf("open state store: %v", err)
+	}
+	t.Cleanup(func() { _ = store.Close() })
+
+	processor, err := NewDedupeProcessor(&config.DedupeQuality{Name: "dedupe", Remember: "2d"}, store, nil)
+	if err != nil {
+		t.Fatalf("NewDedupeProcessor error: %v", err)
+	}
+	now := time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)
+	processor.now = func() time.Time { return now }
+	ctx := core.WithFlowID(context.Background(), "flow-1")
+
+	run := func(blocks ...*core.PostBlock) []*core.PostBlock {
+		t.Helper()
+		kept, err := processor.Evaluate(ctx, blocks)
+		if err != nil {
+			t.Fatalf("Evaluate error: %v", err)
+		}
+		return kept
+	}
+
+	if kept := run(&core.PostBlock{ID: "1", URL: "https://news.example/story"}); len(kept) != 1 {
+		t.Fatalf("expected the first sighting to be kept, got %v", blockIDs(kept))
+	}
+
+	now = now.Add(24 * time.Hour)
+	repost := &core.PostBlock{ID: "2", URL: "https://news.example/story/?utm_campaign=x"}
+	if kept := run(repost, &core.PostBlock{ID: "3", URL: "https://news.example/other"}); len(kept) != 1 || kept[0].ID != "3" {
+		t.Fatalf("expected the re-post to be suppressed, got %v", blockIDs(kept))
+	}
+	if got := repost.Quality; got == nil || got.Reason != "already seen on 2026-10-01: https://news.example/story (same URL)" {
+		t.Fatalf("unexpected suppression reason %+v", got)
+	}
+
+	now = now.Add(72 * time.Hour)
+	if kept := run(&core.PostBlock{ID: "4", URL: "https://news.example/story"}); len(kept) != 1 {
+		t.Fatalf("expected fingerprints to expire after remember, got %v", blockIDs(kept))
+	}
+}
+
+func TestDedupeProcessorRemembersOnlyAfterRunSucceeds(t *testing.T) {
+	store, err := state.NewSQLiteStore(filepath.Join(t.TempDir(), "state.db"), "")
+	if err != nil {
+		t.Fatalf("open state store: %v", err)
+	}
+	t.Cleanup(func() { _ = store.Close() })
+
+	processor, err := NewDedupeProcessor(&config.DedupeQuality{Name: "dedupe", Remember: "2d"}, store, nil)
+	if err != nil {
+		t.Fatalf("NewDedupeProcessor error: %v", err)
+	}
+	story := func() *core.PostBlock { return &core.PostBlock{ID: "1", URL: "https://news.example/story"} }
+
+	// A run whose outputs fail never calls its success hooks.
+	failed := core.WithSuccessHooks(core.WithFlowID(context.Background(), "flow-1"), &core.SuccessHooks{})
+	if kept, err := processor.Evaluate(failed, []*core.PostBlock{story()}); err != nil || len(kept) != 1 {
+		t.Fatalf("expected the first sighting to be kept, got %v, %v", kept, err)
+	}
+
+	hooks := &core.SuccessHooks{}
+	retry := core.WithSuccessHooks(core.WithFlowID(context.Background(), "flow-1"), hooks)
+	if kept, err := processor.Evaluate(retry, []*core.PostBlock{story()}); err != nil || len(kept) != 1 {
+		t.Fatalf("expected the retry to keep the post the failed run never delivered, got %v, %v", kept, err)
+	}
+	if errs := hooks.Run(retry); len(errs) != 0 {
+		t.Fatalf("success hooks failed: %v", errs)
+	}
+
+	next := core.WithFlowID(context.Background(), "flow-1")
+	if kept, err := processor.Evaluate(next, []*core.PostBlock{story()}); err != nil || len(kept) != 0 {
+		t.Fatalf("expected the delivered post to be remembered, got %v, %v", blockIDs(kept), err)
+	}
+}
+
+func blockIDs(blocks []*core.PostBlock) []string {
+	ids := make([]string, 0, len(blocks))
+	for _, block := range blocks {
+		ids = append(ids, block.ID)
+	}
+	return ids
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"strconv"
 	"sync"
 	"text/template"
 	"time"
@@ -204,17 +205,27 @@ func (p *LLMProcessor) Evaluate(ctx context.Context, blocks []*core.PostBlock) (
 			return false, fmt.Errorf("could not parse llm quality response: %w", err)
 		}
 
+		// Scores from earlier score rules shift the LLM score before the
+		// threshold is applied; both parts are kept in the result metadata.
+		ruleScore := core.RuleScore(block.Metadata)
+		score := parsed.Score + ruleScore
 		result := "drop"
-		if parsed.Score >= threshold {
+		if score >= threshold {
 			result = "pass"
 		}
 		block.Quality = &core.QualityResult{
 			ProcessorName: p.name,
 			Result:        result,
-			Score:         parsed.Score,
+			Score:         score,
 			Reason:        parsed.Reason,
 			ProcessedAt:   time.Now().UTC(),
 		}
+		if ruleScore != 0 {
+			block.Quality.Metadata = map[string]string{
+				"llm_score":  strconv.FormatFloat(parsed.Score, 'f', -1, 64),
+				"rule_score": strconv.FormatFloat(ruleScore, 'f', -1, 64),
+			}
+		}
 		return result == "pass", nil
 	}
 
@@ -225,6 +236,7 @@ func (p *LLMProcessor) Evaluate(ctx context.Context, blocks []*core.PostBlock) (
 			if err != nil {
 				if policy == config.BlockErrorPolicyDrop {
 					logger.Warn("llm quality failed for block (dropping)", "block_id", block.ID, "error", err)
+					p.recordBlockError(block, err)
 					continue
 				}
 				return nil, fmt.Errorf("llm quality failed for block. Failing due to block_error_policy being set to fail. To ignore, change policy to drop. Block ID: %s, error: %w", block.ID, err)
@@ -261,6 +273,7 @@ loop:
 			if err != nil {
 				if policy == config.BlockErrorPolicyDrop {
 					logger.Warn("llm quality failed for block (dropping)", "block_id", block.ID, "error", err)
+					p.recordBlockError(block, err)
 					passResults[i] = false
 					return
 				}
@@ -288,3 +301,14 @@ loop:
 		return filtered, nil
 	}
 }
+
+// recordBlockError keeps the failure on a block dropped by block_error_policy,
+// so the run's dropped list can say why it went.
+func (p *LLMProcessor) recordBlockError(block *core.PostBlock, err error) {
+	block.Errors = append(block.Errors, core.ProcessError{
+		ProcessorName: p.name,
+		Stage:         "quality",
+		Error:         err.Error(),
+		OccurredAt:    time.Now().UTC(),
+	})
+}
@@ -84,3 +84,44 @@ func TestLLMProcessor_ParsesJSONCodeFence(t *testing.T) {
 		t.Fatalf("expected block to pass quality, got: %#v", blocks[0].Quality)
 	}
 }
+
+func TestLLMProcessor_AddsRuleScoreBeforeThreshold(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.LLMQuality{
+		Name:           "q",
+		Model:          "test-model",
+		SystemTemplate: "system",
+		PromptTemplate: "title={{.Title}}",
+		Threshold:      0.6,
+	}
+	client := &llmmock.Client{
+		Responses: []llm.ChatResponse{
+			{Content: `{"score":0.5,"reason":"ok"}`},
+			{Content: `{"score":0.5,"reason":"ok"}`},
+		},
+	}
+	processor, err := NewLLMProcessor(cfg, client, "default-model")
+	if err != nil {
+		t.Fatalf("NewLLMProcessor error: %v", err)
+	}
+
+	blocks := []*core.PostBlock{
+		{ID: "boosted", Title: "hello", Metadata: map[string]string{core.MetadataRuleScore: "0.25"}},
+		{ID: "plain", Title: "hello"},
+	}
+	filtered, err := processor.Evaluate(context.Background(), blocks)
+	if err != nil {
+		t.Fatalf("Evaluate error: %v", err)
+	}
+	if len(filtered) != 1 || filtered[0].ID != "boosted" {
+		t.Fatalf("expected only the boosted block to pass, got %d blocks", len(filtered))
+	}
+	quality := blocks[0].Quality
+	if quality.Score != 0.75 || quality.Metadata["llm_score"] != "0.5" || quality.Metadata["rule_score"] != "0.25" {
+		t.Fatalf("expected combined score with both parts recorded, got %#v", quality)
+	}
+	if blocks[1].Quality.Metadata != nil {
+		t.Fatalf("expected no score breakdown without a rule score, got %#v", blocks[1].Quality.Metadata)
+	}
+}
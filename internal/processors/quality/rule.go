@@ -3,6 +3,9 @@ package quality
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/google/cel-go/cel"
@@ -15,6 +18,9 @@ type RuleProcessor struct {
 	name   string
 	config config.QualityRule
 	prg    cel.Program
+	now    func() time.Time
+	// patterns holds the rule's literal regex patterns, compiled once.
+	patterns map[string]*regexp.Regexp
 }
 
 func NewRuleProcessor(cfg *config.QualityRule) (core.QualityProcessor, error) {
@@ -29,16 +35,53 @@ func NewRuleProcessor(cfg *config.QualityRule) (core.QualityProcessor, error) {
 }
 
 func newCELRuleProcessor(cfg *config.QualityRule) (*RuleProcessor, error) {
-	env, err := cel.NewEnv(
+	processor := &RuleProcessor{
+		name:   cfg.Name,
+		config: *cfg,
+		now:    time.Now,
+	}
+	options := []cel.EnvOption{
+		cel.Variable("id", cel.StringType),
 		cel.Variable("title", cel.StringType),
 		cel.Variable("content", cel.StringType),
 		cel.Variable("author", cel.StringType),
 		cel.Variable("url", cel.StringType),
 		cel.Variable("created_at", cel.TimestampType),
 		cel.Variable("comment_count", cel.IntType),
+		cel.Variable("comments", cel.ListType(cel.MapType(cel.StringType, cel.DynType))),
+		cel.Variable("all_comments", cel.ListType(cel.MapType(cel.StringType, cel.DynType))),
 		cel.Variable("title_length", cel.IntType),
 		cel.Variable("content_length", cel.IntType),
-	)
+		cel.Variable("metadata", cel.MapType(cel.StringType, cel.StringType)),
+		cel.Variable("subreddit", cel.StringType),
+		cel.Variable("score", cel.IntType),
+		cel.Variable("upvote_ratio", cel.DoubleType),
+		cel.Variable("num_comments", cel.IntType),
+		cel.Variable("flair", cel.StringType),
+		cel.Variable("is_self", cel.BoolType),
+		cel.Variable("over_18", cel.BoolType),
+		cel.Variable("domain", cel.StringType),
+		cel.Variable("crosspost_parent", cel.StringType),
+		cel.Variable("citation_count", cel.IntType),
+		cel.Variable("influential_citation_count", cel.IntType),
+		cel.Variable("venue", cel.StringType),
+		cel.Variable("tldr", cel.StringType),
+		cel.Variable("has_code", cel.BoolType),
+		cel.Variable("source", cel.StringType),
+		cel.Variable("authors", cel.ListType(cel.MapType(cel.StringType, cel.DynType))),
+		cel.Variable("web_blocks", cel.ListType(cel.MapType(cel.StringType, cel.DynType))),
+		cel.Variable("image_blocks", cel.ListType(cel.MapType(cel.StringType, cel.DynType))),
+		cel.Variable("chunks", cel.ListType(cel.MapType(cel.StringType, cel.DynType))),
+		cel.Variable("quality", cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable("summary", cel.StringType),
+		cel.Variable("errors", cel.ListType(cel.MapType(cel.StringType, cel.DynType))),
+		cel.Variable("tags", cel.ListType(cel.StringType)),
+		cel.Variable("rule_score", cel.DoubleType),
+		cel.Variable("alternates", cel.ListType(cel.MapType(cel.StringType, cel.DynType))),
+	}
+	// age() reads the clock through the processor so tests can pin it.
+	options = append(options, celFunctions(func() time.Time { return processor.now() }, processor.compilePattern)...)
+	env, err := cel.NewEnv(options...)
 	if err != nil {
 		return nil, fmt.Errorf("create CEL env: %w", err)
 	}
@@ -53,11 +96,18 @@ func newCELRuleProcessor(cfg *config.QualityRule) (*RuleProcessor, error) {
 	if err != nil {
 		return nil, fmt.Errorf("create CEL program: %w", err)
 	}
-	return &RuleProcessor{
-		name:   cfg.Name,
-		config: *cfg,
-		prg:    prg,
-	}, nil
+	processor.prg = prg
+	processor.patterns = compileLiteralPatterns(ast)
+	return processor, nil
+}
+
+// compilePattern returns the precompiled regexp for a literal pattern in the
+// rule, or compiles a pattern built during evaluation.
+func (p *RuleProcessor) compilePattern(pattern string) (*regexp.Regexp, error) {
+	if re, ok := p.patterns[pattern]; ok {
+		return re, nil
+	}
+	return regexp.Compile(pattern)
 }
 
 func (p *RuleProcessor) Name() string {
@@ -83,15 +133,56 @@ func (p *RuleProcessor) Evaluate(ctx context.Context, blocks []*core.PostBlock)
 	filtered := make([]*core.PostBlock, 0, len(blocks))
 
 	for _, block := range blocks {
+		metadata := block.Metadata
+		if metadata == nil {
+			metadata = map[string]string{}
+		}
+		summary := ""
+		if block.Summary != nil {
+			summary = block.Summary.Summary
+		}
+		tags := core.Tags(metadata)
+		if tags == nil {
+			tags = []string{}
+		}
 		activation := map[string]interface{}{
-			"title":          block.Title,
-			"content":        block.Content,
-			"author":         block.Author,
-			"url":            block.URL,
-			"created_at":     block.CreatedAt,
-			"comment_count":  int64(len(block.Comments)),
-			"title_length":   int64(len(block.Title)),
-			"content_length": int64(len(block.Content)),
+			"id":                         block.ID,
+			"title":                      block.Title,
+			"content":                    block.Content,
+			"author":                     block.Author,
+			"url":                        block.URL,
+			"created_at":                 block.CreatedAt,
+			"comment_count":              int64(len(block.Comments)),
+			"comments":                   celCommentTree(block.Comments, 0),
+			"all_comments":               celCommentsFlat(block.Comments, 0, nil),
+			"title_length":               int64(len(block.Title)),
+			"content_length":             int64(len(block.Content)),
+			"metadata":                   metadata,
+			"subreddit":                  metadata["subreddit"],
+			"score":                      metadataInt(metadata, "score"),
+			"upvote_ratio":               metadataFloat(metadata, "upvote_ratio"),
+			"num_comments":               metadataInt(metadata, "num_comments"),
+			"flair":                      metadata["flair"],
+			"is_self":                    metadataBool(metadata, "is_self"),
+			"over_18":                    metadataBool(metadata, "over_18"),
+			"domain":                     metadata["domain"],
+			"crosspost_parent":           metadata["crosspost_parent"],
+			"citation_count":             metadataInt(metadata, "citation_count"),
+			"influential_citation_count": metadataInt(metadata, "influential_citation_count"),
+			"venue":                      metadata["venue"],
+			"tldr":                       metadata["tldr"],
+			"has_code":                   metadataBool(metadata, "has_code"),
+			"source":                     metadata[core.MetadataSource],
+			"authors":                    celAuthors(block.Authors),
+			"web_blocks":                 celWebBlocks(block.WebBlocks),
+			"image_blocks":               celImageBlocks(block.ImageBlocks),
+			"chunks":                     celChunks(block.Chunks),
+			"quality":                    celQuality(block.Quality),
+			"summary":                    summary,
+			"errors":                     celErrors(block.Errors),
+			"tags":                       tags,
+			"rule_score":                 core.RuleScore(metadata),
+			"alternates":                 celAlternates(block.Alternates),
 		}
 
 		out, _, err := p.prg.Eval(activation)
@@ -110,6 +201,16 @@ func (p *RuleProcessor) Evaluate(ctx context.Context, blocks []*core.PostBlock)
 			return nil, fmt.Errorf("quality rule did not return bool")
 		}
 
+		if p.config.ActionType != "" && p.config.ActionType != config.RuleActionPassDrop {
+			// Annotating actions keep every block and leave block.Quality to
+			// the processors that actually judge it.
+			if matched {
+				p.annotate(block)
+			}
+			filtered = append(filtered, block)
+			continue
+		}
+
 		shouldDrop := matched && p.config.Result == "drop"
 		block.Quality = &core.QualityResult{
 			ProcessorName: p.name,
@@ -118,6 +219,7 @@ func (p *RuleProcessor) Evaluate(ctx context.Context, blocks []*core.PostBlock)
 		}
 		if shouldDrop {
 			block.Quality.Result = "drop"
+			block.Quality.Reason = fmt.Sprintf("matched rule: %s", p.config.Rule)
 			continue
 		}
 		filtered = append(filtered, block)
@@ -125,3 +227,198 @@ func (p *RuleProcessor) Evaluate(ctx context.Context, blocks []*core.PostBlock)
 
 	return filtered, nil
 }
+
+// annotate applies a tag, score, priority or section action to a matching
+// block. The outcome is stored in metadata so later processors and output
+// templates can read it.
+func (p *RuleProcessor) annotate(block *core.PostBlock) {
+	if block.Metadata == nil {
+		block.Metadata = map[string]string{}
+	}
+	switch p.config.ActionType {
+	case config.RuleActionTag:
+		block.AddTag(p.config.Tag)
+	case config.RuleActionScore:
+		score := core.RuleScore(block.Metadata) + p.config.Score
+		block.Metadata[core.MetadataRuleScore] = strconv.FormatFloat(score, 'f', -1, 64)
+	case config.RuleActionPriority:
+		block.Metadata[core.MetadataMustRead] = "true"
+	case config.RuleActionSection:
+		block.Metadata[core.MetadataSection] = strings.TrimSpace(p.config.Section)
+	}
+}
+
+// celComment converts a comment into a CEL map. depth is zero for top-level comments.
+func celComment(c core.CommentBlock, depth int) map[string]interface{} {
+	return map[string]interface{}{
+		"id":           c.ID,
+		"author":       c.Author,
+		"content":      c.Content,
+		"score":        int64(c.Score),
+		"permalink":    c.Permalink,
+		"is_submitter": c.IsSubmitter,
+		"created_at":   c.CreatedAt,
+		"depth":        int64(depth),
+		"reply_count":  int64(len(c.Replies)),
+	}
+}
+
+// celCommentTree exposes comments with their replies nested under "replies".
+func celCommentTree(comments []core.CommentBlock, depth int) []map[string]interface{} {
+	out := make([]map[string]interface{}, 0, len(comments))
+	for _, c := range comments {
+		m := celComment(c, depth)
+		m["replies"] = celCommentTree(c.Replies, depth+1)
+		out = append(out, m)
+	}
+	return out
+}
+
+// celCommentsFlat flattens the comment tree depth-first so rules can match
+// replies at any level without recursion (CEL has no recursive macros).
+func celCommentsFlat(comments []core.CommentBlock, depth int, out []map[string]interface{}) []map[string]interface{} {
+	if out == nil {
+		out = make([]map[string]interface{}, 0, len(comments))
+	}
+	for _, c := range comments {
+		out = append(out, celComment(c, depth))
+		out = celCommentsFlat(c.Replies, depth+1, out)
+	}
+	return out
+}
+
+func celAuthors(authors []core.Author) []map[string]interface{} {
+	out := make([]map[string]interface{}, 0, len(authors))
+	for _, a := range authors {
+		affiliations := a.Affiliations
+		if affiliations == nil {
+			affiliations = []string{}
+		}
+		out = append(out, map[string]interface{}{
+			"name":         a.Name,
+			"affiliations": affiliations,
+		})
+	}
+	return out
+}
+
+func celWebBlocks(webBlocks []core.WebBlock) []map[string]interface{} {
+	out := make([]map[string]interface{}, 0, len(webBlocks))
+	for _, w := range webBlocks {
+		out = append(out, map[string]interface{}{
+			"url":         w.URL,
+			"title":       w.Title,
+			"description": w.Description,
+			"was_fetched": w.WasFetched,
+			"page":        w.Page,
+			"summary":     w.Summary,
+		})
+	}
+	return out
+}
+
+func celImageBlocks(images []core.ImageBlock) []map[string]interface{} {
+	out := make([]map[string]interface{}, 0, len(images))
+	for _, img := range images {
+		out = append(out, map[string]interface{}{
+			"url":         img.URL,
+			"alt_text":    img.AltText,
+			"was_fetched": img.WasFetched,
+			"summary":     img.Summary,
+		})
+	}
+	return out
+}
+
+func celChunks(chunks []core.ContentChunk) []map[string]interface{} {
+	out := make([]map[string]interface{}, 0, len(chunks))
+	for _, c := range chunks {
+		out = append(out, map[string]interface{}{
+			"content": c.Content,
+			"summary": c.Summary,
+			"start":   c.Start,
+			"end":     c.End,
+		})
+	}
+	return out
+}
+
+// celQuality exposes the result of the previous quality processor. Blocks
+// that have not been assessed yet get empty values, so rules can test
+// quality.result == "" rather than guarding every access with has().
+func celQuality(q *core.QualityResult) map[string]interface{} {
+	if q == nil {
+		return map[string]interface{}{
+			"processor": "",
+			"result":    "",
+			"score":     0.0,
+			"reason":    "",
+			"metadata":  map[string]string{},
+		}
+	}
+	metadata := q.Metadata
+	if metadata == nil {
+		metadata = map[string]string{}
+	}
+	return map[string]interface{}{
+		"processor": q.ProcessorName,
+		"result":    q.Result,
+		"score":     q.Score,
+		"reason":    q.Reason,
+		"metadata":  metadata,
+	}
+}
+
+func celAlternates(alternates []core.Alternate) []map[string]interface{} {
+	out := make([]map[string]interface{}, 0, len(alternates))
+	for _, a := range alternates {
+		out = append(out, map[string]interface{}{
+			"id":       a.ID,
+			"url":      a.URL,
+			"title":    a.Title,
+			"source":   a.Source,
+			"comments": int64(a.Comments),
+		})
+	}
+	return out
+}
+
+func celErrors(processErrors []core.ProcessError) []map[string]interface{} {
+	out := make([]map[string]interface{}, 0, len(processErrors))
+	for _, e := range processErrors {
+		out = append(out, map[string]interface{}{
+			"processor":   e.ProcessorName,
+			"stage":       e.Stage,
+			"error":       e.Error,
+			"occurred_at": e.OccurredAt,
+		})
+	}
+	return out
+}
+
+// metadataInt, metadataFloat and metadataBool read typed values from the string
+// metadata sources attach to blocks. Missing or malformed values read as zero so
+// rules written for one source still evaluate against blocks from another.
+func metadataInt(metadata map[string]string, key string) int64 {
+	v, err := strconv.ParseInt(metadata[key], 10, 64)
+	if err != nil {
+		return 0
+	}
+	return v
+}
+
+func metadataFloat(metadata map[string]string, key string) float64 {
+	v, err := strconv.ParseFloat(metadata[key], 64)
+	if err != nil {
+		return 0
+	}
+	return v
+}
+
+func metadataBool(metadata map[string]string, key string) bool {
+	v, err := strconv.ParseBool(metadata[key])
+	if err != nil {
+		return false
+	}
+	return v
+}
@@ -0,0 +1,98 @@
+package quality
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/cel-go/cel"
+	celast "github.com/google/cel-go/common/ast"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+)
+
+// regexFunctions are the rule functions whose second argument is a pattern.
+var regexFunctions = map[string]bool{"regex_extract": true, "regex_count": true}
+
+// compileLiteralPatterns compiles the string literals passed as patterns to
+// the regex functions in a checked rule, so every evaluation reuses them.
+// Invalid patterns are left out and fail when the rule is evaluated.
+func compileLiteralPatterns(ast *cel.Ast) map[string]*regexp.Regexp {
+	patterns := map[string]*regexp.Regexp{}
+	celast.PreOrderVisit(ast.NativeRep().Expr(), celast.NewExprVisitor(func(expr celast.Expr) {
+		if expr.Kind() != celast.CallKind {
+			return
+		}
+		call := expr.AsCall()
+		if !regexFunctions[call.FunctionName()] || len(call.Args()) != 2 || call.Args()[1].Kind() != celast.LiteralKind {
+			return
+		}
+		pattern, ok := call.Args()[1].AsLiteral().(types.String)
+		if !ok {
+			return
+		}
+		if re, err := regexp.Compile(string(pattern)); err == nil {
+			patterns[string(pattern)] = re
+		}
+	}))
+	return patterns
+}
+
+// celFunctions declares the custom rule functions. now is read on every
+// age() call so tests can pin the clock; compile resolves regex patterns.
+func celFunctions(now func() time.Time, compile func(pattern string) (*regexp.Regexp, error)) []cel.EnvOption {
+	return []cel.EnvOption{
+		cel.Function("regex_extract",
+			cel.Overload("regex_extract_string_string", []*cel.Type{cel.StringType, cel.StringType}, cel.StringType,
+				cel.BinaryBinding(func(text, pattern ref.Val) ref.Val {
+					re, err := compile(string(pattern.(types.String)))
+					if err != nil {
+						return types.NewErr("regex_extract: %v", err)
+					}
+					match := re.FindStringSubmatch(string(text.(types.String)))
+					switch {
+					case match == nil:
+						return types.String("")
+					case len(match) > 1:
+						return types.String(match[1])
+					default:
+						return types.String(match[0])
+					}
+				}))),
+		cel.Function("regex_count",
+			cel.Overload("regex_count_string_string", []*cel.Type{cel.StringType, cel.StringType}, cel.IntType,
+				cel.BinaryBinding(func(text, pattern ref.Val) ref.Val {
+					re, err := compile(string(pattern.(types.String)))
+					if err != nil {
+						return types.NewErr("regex_count: %v", err)
+					}
+					return types.Int(len(re.FindAllStringIndex(string(text.(types.String)), -1)))
+				}))),
+		cel.Function("url_domain",
+			cel.Overload("url_domain_string", []*cel.Type{cel.StringType}, cel.StringType,
+				cel.UnaryBinding(func(raw ref.Val) ref.Val {
+					return types.String(urlDomain(string(raw.(types.String))))
+				}))),
+		cel.Function("word_count",
+			cel.Overload("word_count_string", []*cel.Type{cel.StringType}, cel.IntType,
+				cel.UnaryBinding(func(text ref.Val) ref.Val {
+					return types.Int(len(strings.Fields(string(text.(types.String)))))
+				}))),
+		cel.Function("age",
+			cel.Overload("age_timestamp", []*cel.Type{cel.TimestampType}, cel.DurationType,
+				cel.UnaryBinding(func(ts ref.Val) ref.Val {
+					return types.Duration{Duration: now().Sub(ts.(types.Timestamp).Time)}
+				}))),
+	}
+}
+
+// urlDomain returns the lowercased host of raw without a leading "www.", or
+// an empty string when raw is not an absolute URL.
+func urlDomain(raw string) string {
+	parsed, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil {
+		return ""
+	}
+	return strings.TrimPrefix(strings.ToLower(parsed.Hostname()), "www.")
+}
@@ -2,7 +2,9 @@ package quality
 
 import (
 	"context"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/bakkerme/curator-ai/internal/config"
 	"github.com/bakkerme/curator-ai/internal/core"
@@ -36,6 +38,9 @@ func TestRuleProcessorCompilesCommentCount(t *testing.T) {
 	if filtered[0].ID != "1" {
 		t.Errorf("expected block 1 to remain, got %s", filtered[0].ID)
 	}
+	if got := blocks[1].Quality; got.Result != "drop" || got.Reason != "matched rule: comment_count > 1" {
+		t.Errorf("expected the dropped block to record the matching rule, got %+v", got)
+	}
 }
 
 func TestRuleProcessorEvaluatesTitleLength(t *testing.T) {
@@ -67,3 +72,333 @@ func TestRuleProcessorEvaluatesTitleLength(t *testing.T) {
 		t.Errorf("expected short title to remain, got %s", filtered[0].ID)
 	}
 }
+
+func TestRuleProcessorEvaluatesNestedComments(t *testing.T) {
+	cfg := &config.QualityRule{
+		Name:       "deep_submitter_reply",
+		Rule:       `all_comments.exists(c, c.depth >= 2 && c.is_submitter && c.score > 10) || comments.exists(c, c.replies.size() > 2)`,
+		ActionType: "pass_drop",
+		Result:     "drop",
+	}
+
+	processor, err := NewRuleProcessor(cfg)
+	if err != nil {
+		t.Fatalf("expected rule to compile, got error: %v", err)
+	}
+
+	blocks := []*core.PostBlock{
+		{
+			ID: "deep",
+			Comments: []core.CommentBlock{{
+				ID: "c1",
+				Replies: []core.CommentBlock{{
+					ID:      "c2",
+					Replies: []core.CommentBlock{{ID: "c3", Score: 42, IsSubmitter: true}},
+				}},
+			}},
+		},
+		{ID: "shallow", Comments: []core.CommentBlock{{ID: "c1", Score: 42, IsSubmitter: true}}},
+	}
+
+	filtered, err := processor.Evaluate(context.Background(), blocks)
+	if err != nil {
+		t.Fatalf("evaluate failed: %v", err)
+	}
+	if len(filtered) != 1 || filtered[0].ID != "shallow" {
+		t.Fatalf("expected only the shallow block to remain, got %d", len(filtered))
+	}
+	if len(filtered[0].Errors) != 0 {
+		t.Fatalf("expected no evaluation errors, got %v", filtered[0].Errors)
+	}
+}
+
+func TestRuleProcessorEvaluatesRedditMetadata(t *testing.T) {
+	cfg := &config.QualityRule{
+		Name:       "reddit_rule",
+		Rule:       `!(flair == "New Model" || score > 300) || over_18`,
+		ActionType: "pass_drop",
+		Result:     "drop",
+	}
+
+	processor, err := NewRuleProcessor(cfg)
+	if err != nil {
+		t.Fatalf("expected rule to compile, got error: %v", err)
+	}
+
+	blocks := []*core.PostBlock{
+		{ID: "flair", Metadata: map[string]string{"flair": "New Model", "score": "12"}},
+		{ID: "score", Metadata: map[string]string{"score": "301", "upvote_ratio": "0.97"}},
+		{ID: "nsfw", Metadata: map[string]string{"score": "500", "over_18": "true"}},
+		{ID: "low", Metadata: map[string]string{"flair": "Discussion", "score": "5"}},
+		{ID: "none"},
+	}
+
+	filtered, err := processor.Evaluate(context.Background(), blocks)
+	if err != nil {
+		t.Fatalf("evaluate failed: %v", err)
+	}
+	if len(filtered) != 2 || filtered[0].ID != "flair" || filtered[1].ID != "score" {
+		t.Fatalf("expected flair and score blocks to remain, got %v", filtered)
+	}
+}
+
+func TestRuleProcessorEvaluatesCitationMetadata(t *testing.T) {
+	cfg := &config.QualityRule{
+		Name:       "citations_rule",
+		Rule:       `!has_code && citation_count < 10 && venue == ""`,
+		ActionType: "pass_drop",
+		Result:     "drop",
+	}
+
+	processor, err := NewRuleProcessor(cfg)
+	if err != nil {
+		t.Fatalf("expected rule to compile, got error: %v", err)
+	}
+
+	blocks := []*core.PostBlock{
+		{ID: "code", Metadata: map[string]string{"has_code": "true", "citation_count": "0"}},
+		{ID: "cited", Metadata: map[string]string{"has_code": "false", "citation_count": "42", "influential_citation_count": "3"}},
+		{ID: "venue", Metadata: map[string]string{"has_code": "false", "venue": "NeurIPS", "tldr": "A summary."}},
+		{ID: "neither", Metadata: map[string]string{"has_code": "false", "citation_count": "1"}},
+	}
+
+	filtered, err := processor.Evaluate(context.Background(), blocks)
+	if err != nil {
+		t.Fatalf("evaluate failed: %v", err)
+	}
+	if len(filtered) != 3 || filtered[2].ID != "venue" {
+		t.Fatalf("expected only the uncited paper without code to be dropped, got %v", filtered)
+	}
+}
+
+func TestRuleProcessorEvaluatesMastodonEngagement(t *testing.T) {
+	cfg := &config.QualityRule{
+		Name:       "engagement_rule",
+		Rule:       `source == "mastodon" && int(metadata["boosts"]) + int(metadata["favourites"]) < 5`,
+		ActionType: "pass_drop",
+		Result:     "drop",
+	}
+
+	processor, err := NewRuleProcessor(cfg)
+	if err != nil {
+		t.Fatalf("expected rule to compile, got error: %v", err)
+	}
+
+	blocks := []*core.PostBlock{
+		{ID: "quiet", Metadata: map[string]string{core.MetadataSource: "mastodon", "boosts": "1", "favourites": "2"}},
+		{ID: "shared", Metadata: map[string]string{core.MetadataSource: "mastodon", "boosts": "4", "favourites": "9"}},
+		{ID: "rss", Metadata: map[string]string{core.MetadataSource: "rss"}},
+	}
+
+	filtered, err := processor.Evaluate(context.Background(), blocks)
+	if err != nil {
+		t.Fatalf("evaluate failed: %v", err)
+	}
+	if len(filtered) != 2 || filtered[0].ID != "shared" || len(filtered[1].Errors) != 0 {
+		t.Fatalf("expected the shared post and the other source's post to remain, got %v", filtered)
+	}
+}
+
+func TestRuleProcessorEvaluatesFullBlock(t *testing.T) {
+	blocks := func() []*core.PostBlock {
+		return []*core.PostBlock{
+			{
+				ID:        "repo",
+				WebBlocks: []core.WebBlock{{URL: "https://github.com/org/model"}},
+				Chunks:    []core.ContentChunk{{Content: "a"}, {Content: "b"}},
+				Quality:   &core.QualityResult{ProcessorName: "llm", Result: "pass", Score: 0.9},
+				Summary:   &core.SummaryResult{Summary: "A new model release."},
+				Metadata:  map[string]string{core.MetadataSource: "reddit"},
+			},
+			{
+				ID:          "blog",
+				WebBlocks:   []core.WebBlock{{URL: "https://blog.example/post"}},
+				ImageBlocks: []core.ImageBlock{{URL: "https://img.example/a.png", AltText: "chart"}},
+				Quality:     &core.QualityResult{ProcessorName: "llm", Result: "pass", Score: 0.4},
+				Errors:      []core.ProcessError{{ProcessorName: "enrich", Stage: "source", Error: "timeout"}},
+				Metadata:    map[string]string{core.MetadataSource: "rss"},
+				Alternates:  []core.Alternate{{ID: "hn-1", URL: "https://news.example/item/1", Source: "forum", Comments: 12}},
+			},
+			{ID: "bare"},
+		}
+	}
+
+	cases := []struct {
+		rule string
+		kept []string
+	}{
+		{rule: `size(web_blocks.filter(w, w.url.contains("github.com"))) > 0`, kept: []string{"blog", "bare"}},
+		{rule: `quality.score < 0.5 && quality.result != ""`, kept: []string{"repo", "bare"}},
+		{rule: `size(chunks) > 1 || summary.contains("release")`, kept: []string{"blog", "bare"}},
+		{rule: `errors.exists(e, e.stage == "source")`, kept: []string{"repo", "bare"}},
+		{rule: `image_blocks.exists(i, i.alt_text == "chart")`, kept: []string{"repo", "bare"}},
+		{rule: `source == "reddit"`, kept: []string{"blog", "bare"}},
+		{rule: `id == "bare" && quality.processor == "" && size(authors) == 0`, kept: []string{"repo", "blog"}},
+		{rule: `alternates.exists(a, a.source == "forum" && a.comments > 10)`, kept: []string{"repo", "bare"}},
+	}
+	for _, tc := range cases {
+		processor, err := NewRuleProcessor(&config.QualityRule{Name: "full_block", Rule: tc.rule, ActionType: "pass_drop", Result: "drop"})
+		if err != nil {
+			t.Fatalf("%s: expected rule to compile, got error: %v", tc.rule, err)
+		}
+		filtered, err := processor.Evaluate(context.Background(), blocks())
+		if err != nil {
+			t.Fatalf("%s: evaluate failed: %v", tc.rule, err)
+		}
+		var kept []string
+		for _, block := range filtered {
+			if len(block.Errors) > 0 && block.Errors[len(block.Errors)-1].ProcessorName == "full_block" {
+				t.Fatalf("%s: evaluation error on %s: %s", tc.rule, block.ID, block.Errors[len(block.Errors)-1].Error)
+			}
+			kept = append(kept, block.ID)
+		}
+		if strings.Join(kept, ",") != strings.Join(tc.kept, ",") {
+			t.Fatalf("%s: expected %v to remain, got %v", tc.rule, tc.kept, kept)
+		}
+	}
+}
+
+func TestRuleProcessorCustomFunctions(t *testing.T) {
+	now := time.Date(2026, 5, 10, 12, 0, 0, 0, time.UTC)
+	block := &core.PostBlock{
+		ID:        "post",
+		URL:       "https://www.GitHub.com/org/repo",
+		Title:     "Llama-4 70B released under Apache-2.0",
+		Content:   "one two three four",
+		CreatedAt: now.Add(-72 * time.Hour),
+	}
+	rules := []string{
+		`url_domain(url) == "github.com"`,
+		`url_domain("not a url") == ""`,
+		`regex_extract(title, "(\\d+)B") == "70"`,
+		`regex_extract(title, "Apache-\\d\\.\\d") == "Apache-2.0"`,
+		`regex_extract(title, "MIT") == ""`,
+		`regex_count(content, "\\w+") == 4`,
+		`word_count(content) == 4 && word_count("") == 0`,
+		`age(created_at) > duration("48h") && age(created_at) < duration("73h")`,
+	}
+	for _, rule := range rules {
+		parsed, err := newCELRuleProcessor(&config.QualityRule{Name: "functions", Rule: rule, ActionType: "pass_drop", Result: "drop"})
+		if err != nil {
+			t.Fatalf("%s: expected rule to compile, got error: %v", rule, err)
+		}
+		parsed.now = func() time.Time { return now }
+		filtered, err := parsed.Evaluate(context.Background(), []*core.PostBlock{block})
+		if err != nil {
+			t.Fatalf("%s: evaluate failed: %v", rule, err)
+		}
+		if len(filtered) != 0 {
+			t.Fatalf("%s: expected the rule to match, errors: %v", rule, block.Errors)
+		}
+	}
+
+	processor, err := newCELRuleProcessor(&config.QualityRule{Name: "bad_regex", Rule: `regex_count(title, "(") > 0`, ActionType: "pass_drop", Result: "drop"})
+	if err != nil {
+		t.Fatalf("expected rule to compile, got error: %v", err)
+	}
+	filtered, err := processor.Evaluate(context.Background(), []*core.PostBlock{{ID: "x", Title: "t"}})
+	if err != nil || len(filtered) != 1 || len(filtered[0].Errors) != 1 {
+		t.Fatalf("expected an invalid pattern to be recorded as a block error, got %v, %v", filtered, err)
+	}
+}
+
+func TestRuleProcessorPrecompilesLiteralPatterns(t *testing.T) {
+	processor, err := newCELRuleProcessor(&config.QualityRule{
+		Name:       "patterns",
+		Rule:       `regex_count(title, "\\d+B") > 0 && regex_extract(title, metadata["license"]) != "" && regex_count(title, "(") == 0`,
+		ActionType: "pass_drop",
+		Result:     "drop",
+	})
+	if err != nil {
+		t.Fatalf("expected rule to compile, got error: %v", err)
+	}
+	if len(processor.patterns) != 1 || processor.patterns[`\d+B`] == nil {
+		t.Fatalf("expected only the valid literal pattern precompiled, got %v", processor.patterns)
+	}
+	re, err := processor.compilePattern("Apache-[0-9.]+")
+	if err != nil || re.FindString("Apache-2.0") != "Apache-2.0" {
+		t.Fatalf("expected dynamic patterns to compile on use, got %v, %v", re, err)
+	}
+	if _, ok := processor.patterns["Apache-[0-9.]+"]; ok {
+		t.Fatalf("expected dynamic patterns not to be kept")
+	}
+}
+
+func TestRuleProcessorTagsLeaveSourceHashtagsAlone(t *testing.T) {
+	block := &core.PostBlock{
+		ID:       "toot",
+		Title:    "CVE in a popular tokenizer",
+		Metadata: map[string]string{core.MetadataSource: "mastodon", "tags": "ai ml"},
+	}
+	for _, cfg := range []*config.QualityRule{
+		{Name: "security_tag", Rule: `title.contains("CVE")`, ActionType: config.RuleActionTag, Tag: "security"},
+		{Name: "only_rule_tags", Rule: `tags == ["security"] && metadata["tags"] == "ai ml"`, ActionType: config.RuleActionPassDrop, Result: "drop"},
+	} {
+		processor, err := NewRuleProcessor(cfg)
+		if err != nil {
+			t.Fatalf("%s: expected rule to compile, got error: %v", cfg.Name, err)
+		}
+		kept, err := processor.Evaluate(context.Background(), []*core.PostBlock{block})
+		if err != nil {
+			t.Fatalf("%s: evaluate failed: %v", cfg.Name, err)
+		}
+		if cfg.Result == "drop" && len(kept) != 0 {
+			t.Fatalf("expected CEL tags to hold only the rule tag, got %v", core.Tags(block.Metadata))
+		}
+	}
+	if block.Metadata["tags"] != "ai ml" || block.Metadata[core.MetadataTags] != "security" {
+		t.Fatalf("expected hashtags and rule tags in separate keys, got %v", block.Metadata)
+	}
+	if !core.HasTag(block.Metadata, "security") || core.HasTag(block.Metadata, "ai ml") || core.HasTag(block.Metadata, "ai") {
+		t.Fatalf("expected hasTag to see only rule tags, got %v", core.Tags(block.Metadata))
+	}
+}
+
+func TestRuleProcessorAnnotatingActions(t *testing.T) {
+	rules := []*config.QualityRule{
+		{Name: "security_tag", Rule: `title.contains("CVE")`, ActionType: config.RuleActionTag, Tag: "security"},
+		{Name: "security_tag_again", Rule: `title.contains("CVE")`, ActionType: config.RuleActionTag, Tag: "security"},
+		{Name: "has_code", Rule: `content.contains("github.com")`, ActionType: config.RuleActionScore, Score: 0.25},
+		{Name: "short", Rule: `word_count(content) < 3`, ActionType: config.RuleActionScore, Score: -0.5},
+		{Name: "tagged_priority", Rule: `"security" in tags && rule_score > 0.0`, ActionType: config.RuleActionPriority},
+		{Name: "security_section", Rule: `"security" in tags`, ActionType: config.RuleActionSection, Section: " Security "},
+	}
+	blocks := []*core.PostBlock{
+		{ID: "cve", Title: "CVE-2026-1234 in libfoo", Content: "patch at github.com/libfoo/libfoo now"},
+		{ID: "short", Title: "Release notes", Content: "see github.com"},
+		{ID: "plain", Title: "Weekly roundup", Content: "nothing much happened", Metadata: map[string]string{core.MetadataTags: "news"}},
+	}
+	for _, cfg := range rules {
+		processor, err := NewRuleProcessor(cfg)
+		if err != nil {
+			t.Fatalf("%s: expected rule to compile, got error: %v", cfg.Name, err)
+		}
+		blocks, err = processor.Evaluate(context.Background(), blocks)
+		if err != nil {
+			t.Fatalf("%s: evaluate failed: %v", cfg.Name, err)
+		}
+	}
+
+	if len(blocks) != 3 {
+		t.Fatalf("expected annotating rules to keep every block, got %d", len(blocks))
+	}
+	want := []map[string]string{
+		{core.MetadataTags: "security", "rule_score": "0.25", "must_read": "true", "section": "Security"},
+		{"rule_score": "-0.25"},
+		{core.MetadataTags: "news"},
+	}
+	for i, block := range blocks {
+		if len(block.Metadata) != len(want[i]) {
+			t.Errorf("%s: expected metadata %v, got %v", block.ID, want[i], block.Metadata)
+			continue
+		}
+		for key, value := range want[i] {
+			if block.Metadata[key] != value {
+				t.Errorf("%s: expected %s=%q, got %q", block.ID, key, value, block.Metadata[key])
+			}
+		}
+		if block.Quality != nil {
+			t.Errorf("%s: expected annotating rules to leave Quality unset, got %+v", block.ID, block.Quality)
+		}
+	}
+}
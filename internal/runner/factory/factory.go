@@ -20,15 +20,38 @@ import (
 	"github.com/bakkerme/curator-ai/internal/runner/snapshot"
 	"github.com/bakkerme/curator-ai/internal/sources/arxiv"
 	arxivimpl "github.com/bakkerme/curator-ai/internal/sources/arxiv/impl"
+	"github.com/bakkerme/curator-ai/internal/sources/biorxiv"
+	biorxivimpl "github.com/bakkerme/curator-ai/internal/sources/biorxiv/impl"
+	"github.com/bakkerme/curator-ai/internal/sources/bluesky"
+	blueskyimpl "github.com/bakkerme/curator-ai/internal/sources/bluesky/impl"
 	crawl4aiimpl "github.com/bakkerme/curator-ai/internal/sources/crawl4ai/impl"
 	doclingimpl "github.com/bakkerme/curator-ai/internal/sources/docling/impl"
+	"github.com/bakkerme/curator-ai/internal/sources/enrich"
+	"github.com/bakkerme/curator-ai/internal/sources/forum"
+	forumimpl "github.com/bakkerme/curator-ai/internal/sources/forum/impl"
+	"github.com/bakkerme/curator-ai/internal/sources/huggingface"
+	huggingfaceimpl "github.com/bakkerme/curator-ai/internal/sources/huggingface/impl"
+	"github.com/bakkerme/curator-ai/internal/sources/images"
+	imagesimpl "github.com/bakkerme/curator-ai/internal/sources/images/impl"
+	"github.com/bakkerme/curator-ai/internal/sources/mastodon"
+	mastodonimpl "github.com/bakkerme/curator-ai/internal/sources/mastodon/impl"
+	"github.com/bakkerme/curator-ai/internal/sources/media"
+	mediaimpl "github.com/bakkerme/curator-ai/internal/sources/media/impl"
 	"github.com/bakkerme/curator-ai/internal/sources/reader"
+	readercache "github.com/bakkerme/curator-ai/internal/sources/reader/cache"
 	"github.com/bakkerme/curator-ai/internal/sources/reddit"
 	"github.com/bakkerme/curator-ai/internal/sources/rss"
+	"github.com/bakkerme/curator-ai/internal/sources/rss/health"
 	rssimpl "github.com/bakkerme/curator-ai/internal/sources/rss/impl"
+	"github.com/bakkerme/curator-ai/internal/sources/scholar"
+	scholarimpl "github.com/bakkerme/curator-ai/internal/sources/scholar/impl"
 	"github.com/bakkerme/curator-ai/internal/sources/scrape"
 	scrapeimpl "github.com/bakkerme/curator-ai/internal/sources/scrape/impl"
 	"github.com/bakkerme/curator-ai/internal/sources/testfile"
+	"github.com/bakkerme/curator-ai/internal/sources/urls"
+	urlsimpl "github.com/bakkerme/curator-ai/internal/sources/urls/impl"
+	"github.com/bakkerme/curator-ai/internal/sources/watch"
+	"github.com/bakkerme/curator-ai/internal/state"
 )
 
 type Factory struct {
@@ -40,12 +63,33 @@ type Factory struct {
 	WebReader               reader.Reader
 	ArxivReader             reader.Reader
 	ArxivFetcher            arxiv.Fetcher
+	BiorxivFetcher          biorxiv.Fetcher
+	HuggingFaceClient       huggingface.Client
+	MastodonClient          mastodon.Client
+	MastodonToken           string
+	BlueskyClient           bluesky.Client
+	DiscourseClient         forum.Client
+	LemmyClient             forum.Client
+	MediaFeeds              media.Feeds
+	MediaExtractor          media.Extractor
+	Transcriber             llm.Transcriber
+	URLFetcher              urls.Fetcher
 	RedditFetcher           reddit.Fetcher
 	RedditPublicJSONFetcher reddit.Fetcher
 	RSSFetcher              rss.Fetcher
 	ScrapeFetcher           scrape.Fetcher
+	ImageFetcher            images.Fetcher
+	ScholarClient           scholar.Client
 	EmailSender             email.Sender
 	SeenStore               dedupe.SeenStore
+	FeedHealthStore         health.Store
+	StateStore              state.Store
+
+	// readerCache and the unwrapped readers are kept so ConfigureReaderCache can
+	// be called again (e.g. on config reload) without stacking cache layers.
+	readerCache     readercache.Store
+	baseWebReader   reader.Reader
+	baseArxivReader reader.Reader
 }
 
 func NewFromEnvConfig(logger *slog.Logger, env config.EnvConfig) (*Factory, error) {
@@ -72,11 +116,24 @@ func NewFromEnvConfig(logger *slog.Logger, env config.EnvConfig) (*Factory, erro
 		SMTPDefaults:            env.SMTP,
 		WebReader:               crawl4aiimpl.NewReader(env.Crawl4AI.HTTPTimeout, env.Crawl4AI.BaseURL),
 		ArxivReader:             doclingimpl.NewReader(env.Docling.HTTPTimeout, env.Docling.BaseURL),
-		ArxivFetcher:            arxivimpl.NewFetcher(env.Arxiv.HTTPTimeout, env.Arxiv.UserAgent, env.Arxiv.BaseURL),
+		ArxivFetcher:            arxivimpl.NewFetcher(env.Arxiv.HTTPTimeout, env.Arxiv.UserAgent, env.Arxiv.BaseURL, env.Arxiv.RequestDelay),
+		BiorxivFetcher:          biorxivimpl.NewFetcher(env.Biorxiv.HTTPTimeout, env.Biorxiv.UserAgent, env.Biorxiv.BaseURL),
+		HuggingFaceClient:       huggingfaceimpl.NewClient(env.HuggingFace.HTTPTimeout, env.HuggingFace.BaseURL, env.HuggingFace.Token, ""),
+		MastodonClient:          mastodonimpl.NewClient(env.Mastodon.HTTPTimeout, env.Mastodon.UserAgent),
+		MastodonToken:           env.Mastodon.AccessToken,
+		BlueskyClient:           blueskyimpl.NewClient(env.Bluesky.HTTPTimeout, env.Bluesky.BaseURL, env.Bluesky.UserAgent),
+		DiscourseClient:         forumimpl.NewDiscourseClient(env.Forum.HTTPTimeout, env.Forum.UserAgent),
+		LemmyClient:             forumimpl.NewLemmyClient(env.Forum.HTTPTimeout, env.Forum.UserAgent),
+		MediaFeeds:              mediaimpl.NewFeeds(env.Media.HTTPTimeout, env.Media.UserAgent),
+		MediaExtractor:          mediaimpl.NewYTDLP(env.Media.YTDLPPath),
+		Transcriber:             llmClient,
+		URLFetcher:              urlsimpl.NewFetcher(env.URLs.HTTPTimeout, env.URLs.UserAgent),
 		RedditFetcher:           reddit.NewFetcher(logger, env.Reddit.HTTPTimeout, env.Reddit.UserAgent, env.Reddit.ClientID, env.Reddit.ClientSecret, env.Reddit.Username, env.Reddit.Password, redditProxyURL),
 		RedditPublicJSONFetcher: reddit.NewFetcher(logger, env.Reddit.HTTPTimeout, env.Reddit.UserAgent, "", "", "", "", redditProxyURL),
 		RSSFetcher:              rssimpl.NewFetcher(env.RSS.HTTPTimeout, env.RSS.UserAgent),
 		ScrapeFetcher:           scrapeimpl.NewFetcher(env.Scrape.HTTPTimeout, env.Scrape.UserAgent),
+		ImageFetcher:            imagesimpl.NewFetcher(env.Images.HTTPTimeout, env.Images.UserAgent),
+		ScholarClient:           scholarimpl.NewClient(env.SemanticScholar.HTTPTimeout, env.SemanticScholar.BaseURL, env.SemanticScholar.APIKey, env.Arxiv.UserAgent),
 		// Leave EmailSender nil so the output processor can build it from the merged
 		// YAML config + env defaults. This allows per-flow SMTP overrides in the Curator
 		// Document to take effect.
@@ -116,7 +173,7 @@ func (f *Factory) NewRedditSource(cfg *config.RedditSource) (core.SourceProcesso
 	if err != nil {
 		return nil, err
 	}
-	return snapshot.WrapSource(processor, cfg.Snapshot), nil
+	return f.wrapSource(processor, cfg.Enrich, cfg.ImageFetch, cfg.Snapshot), nil
 }
 
 func (f *Factory) NewRedditPublicJSONSource(cfg *config.RedditSource) (core.SourceProcessor, error) {
@@ -124,23 +181,93 @@ func (f *Factory) NewRedditPublicJSONSource(cfg *config.RedditSource) (core.Sour
 	if err != nil {
 		return nil, err
 	}
-	return snapshot.WrapSource(processor, cfg.Snapshot), nil
+	return f.wrapSource(processor, cfg.Enrich, cfg.ImageFetch, cfg.Snapshot), nil
 }
 
 func (f *Factory) NewRSSSource(cfg *config.RSSSource) (core.SourceProcessor, error) {
-	processor, err := rss.NewRSSProcessor(cfg, f.RSSFetcher, f.SeenStore)
+	processor, err := rss.NewRSSProcessor(cfg, f.RSSFetcher, f.WebReader, f.SeenStore, f.FeedHealthStore)
 	if err != nil {
 		return nil, err
 	}
-	return snapshot.WrapSource(processor, cfg.Snapshot), nil
+	return f.wrapSource(processor, cfg.Enrich, cfg.ImageFetch, cfg.Snapshot), nil
 }
 
 func (f *Factory) NewArxivSource(cfg *config.ArxivSource) (core.SourceProcessor, error) {
-	processor, err := arxiv.NewArxivProcessor(cfg, f.ArxivFetcher, f.ArxivReader, f.SeenStore, f.Logger)
+	processor, err := arxiv.NewArxivProcessor(cfg, f.ArxivFetcher, f.ArxivReader, f.SeenStore, f.StateStore, f.Logger)
+	if err != nil {
+		return nil, err
+	}
+	withCitations := scholar.WrapSource(processor, cfg.Citations, f.ScholarClient, f.Logger)
+	return f.wrapSource(withCitations, cfg.Enrich, cfg.ImageFetch, cfg.Snapshot), nil
+}
+
+// NewBiorxivSource reads preprint PDFs through the arXiv (Docling) reader.
+func (f *Factory) NewBiorxivSource(cfg *config.BiorxivSource) (core.SourceProcessor, error) {
+	processor, err := biorxiv.NewBiorxivProcessor(cfg, f.BiorxivFetcher, f.ArxivReader, f.SeenStore, f.Logger)
+	if err != nil {
+		return nil, err
+	}
+	return f.wrapSource(processor, cfg.Enrich, cfg.ImageFetch, cfg.Snapshot), nil
+}
+
+func (f *Factory) NewHuggingFaceSource(cfg *config.HuggingFaceSource) (core.SourceProcessor, error) {
+	processor, err := huggingface.NewHuggingFaceProcessor(cfg, f.HuggingFaceClient, f.WebReader, f.SeenStore, f.Logger)
+	if err != nil {
+		return nil, err
+	}
+	return f.wrapSource(processor, cfg.Enrich, cfg.ImageFetch, cfg.Snapshot), nil
+}
+
+func (f *Factory) NewMastodonSource(cfg *config.MastodonSource) (core.SourceProcessor, error) {
+	processor, err := mastodon.NewMastodonProcessor(cfg, f.MastodonClient, f.MastodonToken, f.SeenStore, f.Logger)
+	if err != nil {
+		return nil, err
+	}
+	return f.wrapSource(processor, cfg.Enrich, cfg.ImageFetch, cfg.Snapshot), nil
+}
+
+func (f *Factory) NewBlueskySource(cfg *config.BlueskySource) (core.SourceProcessor, error) {
+	processor, err := bluesky.NewBlueskyProcessor(cfg, f.BlueskyClient, f.SeenStore, f.Logger)
+	if err != nil {
+		return nil, err
+	}
+	return f.wrapSource(processor, cfg.Enrich, cfg.ImageFetch, cfg.Snapshot), nil
+}
+
+func (f *Factory) NewForumSource(cfg *config.ForumSource) (core.SourceProcessor, error) {
+	client := f.DiscourseClient
+	if forum.Flavour(cfg.Flavour) == forum.FlavourLemmy {
+		client = f.LemmyClient
+	}
+	processor, err := forum.NewForumProcessor(cfg, client, f.SeenStore, f.Logger)
+	if err != nil {
+		return nil, err
+	}
+	return f.wrapSource(processor, cfg.Enrich, cfg.ImageFetch, cfg.Snapshot), nil
+}
+
+func (f *Factory) NewMediaSource(cfg *config.MediaSource) (core.SourceProcessor, error) {
+	processor, err := media.NewMediaProcessor(cfg, f.MediaFeeds, f.MediaExtractor, f.Transcriber, f.SeenStore)
 	if err != nil {
 		return nil, err
 	}
-	return snapshot.WrapSource(processor, cfg.Snapshot), nil
+	return f.wrapSource(processor, cfg.Enrich, cfg.ImageFetch, cfg.Snapshot), nil
+}
+
+func (f *Factory) NewWatchSource(cfg *config.WatchSource) (core.SourceProcessor, error) {
+	processor, err := watch.NewWatchProcessor(cfg, f.ScrapeFetcher, f.WebReader, f.StateStore, f.Logger)
+	if err != nil {
+		return nil, err
+	}
+	return f.wrapSource(processor, cfg.Enrich, cfg.ImageFetch, cfg.Snapshot), nil
+}
+
+func (f *Factory) NewURLsSource(cfg *config.URLsSource) (core.SourceProcessor, error) {
+	processor, err := urls.NewURLsProcessor(cfg, f.URLFetcher, f.WebReader, f.ArxivReader, f.SeenStore, f.Logger)
+	if err != nil {
+		return nil, err
+	}
+	return f.wrapSource(processor, cfg.Enrich, cfg.ImageFetch, cfg.Snapshot), nil
 }
 
 func (f *Factory) NewScrapeSource(cfg *config.ScrapeSource) (core.SourceProcessor, error) {
@@ -148,7 +275,7 @@ func (f *Factory) NewScrapeSource(cfg *config.ScrapeSource) (core.SourceProcesso
 	if err != nil {
 		return nil, err
 	}
-	return snapshot.WrapSource(processor, cfg.Snapshot), nil
+	return f.wrapSource(processor, cfg.Enrich, cfg.ImageFetch, cfg.Snapshot), nil
 }
 
 func (f *Factory) NewTestFileSource(cfg *config.TestFileSource) (core.SourceProcessor, error) {
@@ -156,7 +283,15 @@ func (f *Factory) NewTestFileSource(cfg *config.TestFileSource) (core.SourceProc
 	if err != nil {
 		return nil, err
 	}
-	return snapshot.WrapSource(processor, cfg.Snapshot), nil
+	return f.wrapSource(processor, cfg.Enrich, cfg.ImageFetch, cfg.Snapshot), nil
+}
+
+// wrapSource applies the shared source decorators: link enrichment first, then
+// image fetching (so enriched image links are downloaded too), then snapshots.
+func (f *Factory) wrapSource(processor core.SourceProcessor, enrichCfg *config.EnrichConfig, imageCfg *config.ImageFetchConfig, snapshotCfg *core.SnapshotConfig) core.SourceProcessor {
+	wrapped := enrich.WrapSource(processor, enrichCfg, f.WebReader, f.Logger)
+	wrapped = images.WrapSource(wrapped, imageCfg, f.ImageFetcher, f.Logger)
+	return snapshot.WrapSource(wrapped, snapshotCfg)
 }
 
 func (f *Factory) NewQualityRule(cfg *config.QualityRule) (core.QualityProcessor, error) {
@@ -175,6 +310,14 @@ func (f *Factory) NewLLMQuality(cfg *config.LLMQuality) (core.QualityProcessor,
 	return snapshot.WrapQuality(processor, cfg.Snapshot), nil
 }
 
+func (f *Factory) NewDedupeQuality(cfg *config.DedupeQuality) (core.QualityProcessor, error) {
+	processor, err := quality.NewDedupeProcessor(cfg, f.StateStore, f.Logger)
+	if err != nil {
+		return nil, err
+	}
+	return snapshot.WrapQuality(processor, cfg.Snapshot), nil
+}
+
 func (f *Factory) NewLLMSummary(cfg *config.LLMSummary) (core.SummaryProcessor, error) {
 	processor, err := summary.NewPostLLMProcessorWithLogger(cfg, f.LLMClient, f.DefaultModel, f.Logger, f.DefaultTemperature)
 	if err != nil {
@@ -286,3 +429,131 @@ func (f *Factory) ConfigureDedupeStore(cfg *config.DedupeStoreConfig) error {
 		return fmt.Errorf("unsupported dedupe store driver %q", driver)
 	}
 }
+
+func (f *Factory) ConfigureFeedHealth(cfg *config.FeedHealthConfig) error {
+	if f.FeedHealthStore != nil {
+		_ = f.FeedHealthStore.Close()
+		f.FeedHealthStore = nil
+	}
+
+	if cfg == nil {
+		return nil
+	}
+
+	store, err := OpenFeedHealthStore(cfg)
+	if err != nil {
+		return err
+	}
+	f.FeedHealthStore = store
+	return nil
+}
+
+func (f *Factory) ConfigureStateStore(cfg *config.StateStoreConfig) error {
+	if f.StateStore != nil {
+		_ = f.StateStore.Close()
+		f.StateStore = nil
+	}
+
+	if cfg == nil {
+		return nil
+	}
+
+	driver := strings.ToLower(strings.TrimSpace(cfg.Driver))
+	if driver == "" {
+		driver = "sqlite"
+	}
+
+	switch driver {
+	case "sqlite":
+		dsn := strings.TrimSpace(cfg.DSN)
+		if dsn == "" {
+			dsn = "curator-state.db"
+		}
+		store, err := state.NewSQLiteStore(dsn, strings.TrimSpace(cfg.Table))
+		if err != nil {
+			return err
+		}
+		f.StateStore = store
+		return nil
+	default:
+		return fmt.Errorf("unsupported state store driver %q", driver)
+	}
+}
+
+// OpenFeedHealthStore opens the store described by cfg. It is shared with the
+// `curator feeds` commands so reports read the same database runs write to.
+func OpenFeedHealthStore(cfg *config.FeedHealthConfig) (health.Store, error) {
+	if cfg == nil {
+		cfg = &config.FeedHealthConfig{}
+	}
+	driver := strings.ToLower(strings.TrimSpace(cfg.Driver))
+	if driver == "" {
+		driver = "sqlite"
+	}
+
+	switch driver {
+	case "sqlite":
+		dsn := strings.TrimSpace(cfg.DSN)
+		if dsn == "" {
+			dsn = "curator-feed-health.db"
+		}
+		return health.NewSQLiteStore(dsn, strings.TrimSpace(cfg.Table))
+	default:
+		return nil, fmt.Errorf("unsupported feed health driver %q", driver)
+	}
+}
+
+func (f *Factory) ConfigureReaderCache(cfg *config.ReaderCacheConfig) error {
+	if f.readerCache != nil {
+		_ = f.readerCache.Close()
+		f.readerCache = nil
+	}
+	if f.baseWebReader != nil {
+		f.WebReader = f.baseWebReader
+	}
+	if f.baseArxivReader != nil {
+		f.ArxivReader = f.baseArxivReader
+	}
+
+	if cfg == nil || (cfg.Crawl4AI == nil && cfg.Docling == nil) {
+		return nil
+	}
+
+	driver := strings.ToLower(strings.TrimSpace(cfg.Driver))
+	if driver == "" {
+		driver = "sqlite"
+	}
+
+	switch driver {
+	case "sqlite":
+		dsn := strings.TrimSpace(cfg.DSN)
+		if dsn == "" {
+			dsn = "curator-reader-cache.db"
+		}
+		store, err := readercache.NewSQLiteStore(dsn, strings.TrimSpace(cfg.Table))
+		if err != nil {
+			return err
+		}
+		f.readerCache = store
+	default:
+		return fmt.Errorf("unsupported reader cache driver %q", driver)
+	}
+
+	f.baseWebReader = f.WebReader
+	f.baseArxivReader = f.ArxivReader
+	if cfg.Crawl4AI != nil {
+		f.WebReader = readercache.NewReader(f.WebReader, f.readerCache, readercache.Options{
+			Name:       "crawl4ai",
+			TTL:        cfg.Crawl4AI.TTL,
+			ServeStale: cfg.Crawl4AI.ServeStaleOrDefault(),
+		})
+	}
+	if cfg.Docling != nil {
+		f.ArxivReader = readercache.NewReader(f.ArxivReader, f.readerCache, readercache.Options{
+			Name:       "docling",
+			TTL:        cfg.Docling.TTL,
+			ServeStale: cfg.Docling.ServeStaleOrDefault(),
+		})
+	}
+	return nil
+}
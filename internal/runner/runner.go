@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"maps"
 	"time"
 
 	"github.com/bakkerme/curator-ai/internal/core"
@@ -105,6 +106,128 @@ func findOutputRestoreIndex(processors []core.OutputProcessor) (int, *core.Snaps
 	return -1, nil
 }
 
+// splitQualityBypass separates blocks flagged with core.MetadataQualityBypass,
+// which skip quality processors entirely.
+func splitQualityBypass(blocks []*core.PostBlock) ([]*core.PostBlock, map[*core.PostBlock]bool) {
+	var bypassed map[*core.PostBlock]bool
+	for _, block := range blocks {
+		if block == nil || block.Metadata[core.MetadataQualityBypass] != "true" {
+			continue
+		}
+		if bypassed == nil {
+			bypassed = make(map[*core.PostBlock]bool)
+		}
+		bypassed[block] = true
+	}
+	if bypassed == nil {
+		return blocks, nil
+	}
+	evaluated := make([]*core.PostBlock, 0, len(blocks)-len(bypassed))
+	for _, block := range blocks {
+		if !bypassed[block] {
+			evaluated = append(evaluated, block)
+		}
+	}
+	return evaluated, bypassed
+}
+
+// mergeQualityBypass reinserts bypassed blocks into a processor's output,
+// keeping the original block order. Blocks the processor created are appended.
+func mergeQualityBypass(original []*core.PostBlock, kept []*core.PostBlock, bypassed map[*core.PostBlock]bool) []*core.PostBlock {
+	if len(bypassed) == 0 {
+		return kept
+	}
+	keptSet := make(map[*core.PostBlock]bool, len(kept))
+	for _, block := range kept {
+		keptSet[block] = true
+	}
+	merged := make([]*core.PostBlock, 0, len(kept)+len(bypassed))
+	for _, block := range original {
+		if bypassed[block] || keptSet[block] {
+			merged = append(merged, block)
+			delete(keptSet, block)
+		}
+	}
+	for _, block := range kept {
+		if keptSet[block] {
+			merged = append(merged, block)
+		}
+	}
+	return merged
+}
+
+// qualityAssessment is a block's quality state before a processor runs. The
+// verdict is copied, so a processor that updates *block.Quality in place is
+// still seen as having assessed the block.
+type qualityAssessment struct {
+	result *core.QualityResult
+	value  core.QualityResult
+	errors int
+}
+
+func snapshotQuality(blocks []*core.PostBlock) map[*core.PostBlock]qualityAssessment {
+	assessments := make(map[*core.PostBlock]qualityAssessment, len(blocks))
+	for _, block := range blocks {
+		if block == nil {
+			continue
+		}
+		assessment := qualityAssessment{result: block.Quality, errors: len(block.Errors)}
+		if block.Quality != nil {
+			assessment.value = *block.Quality
+			assessment.value.Metadata = maps.Clone(block.Quality.Metadata)
+		}
+		assessments[block] = assessment
+	}
+	return assessments
+}
+
+// assessed reports whether block received a new verdict since a.
+func (a qualityAssessment) assessed(block *core.PostBlock) bool {
+	current := block.Quality
+	if current == nil {
+		return false
+	}
+	if current != a.result {
+		return true
+	}
+	return current.ProcessorName != a.value.ProcessorName || current.Result != a.value.Result ||
+		current.Score != a.value.Score || current.Reason != a.value.Reason ||
+		!current.ProcessedAt.Equal(a.value.ProcessedAt) || !maps.Equal(current.Metadata, a.value.Metadata)
+}
+
+// recordQualityOutcome appends the result each block received from a quality
+// processor to its QualityHistory and records the blocks the processor did
+// not keep as dropped. The reason is the processor's own, falling back to an
+// error it recorded on the block.
+func recordQualityOutcome(name string, evaluated []*core.PostBlock, kept []*core.PostBlock, before map[*core.PostBlock]qualityAssessment, dropped *core.DroppedBlocks) {
+	keptSet := make(map[*core.PostBlock]bool, len(kept))
+	for _, block := range kept {
+		keptSet[block] = true
+	}
+	now := time.Now().UTC()
+	for _, block := range evaluated {
+		if block == nil {
+			continue
+		}
+		previous := before[block]
+		assessed := previous.assessed(block)
+		if assessed {
+			block.QualityHistory = append(block.QualityHistory, *block.Quality)
+		}
+		if keptSet[block] {
+			continue
+		}
+		reason := ""
+		if assessed {
+			reason = block.Quality.Reason
+		}
+		if reason == "" && len(block.Errors) > previous.errors {
+			reason = block.Errors[len(block.Errors)-1].Error
+		}
+		dropped.Add(core.DroppedBlock{Block: block, Processor: name, Reason: reason, DroppedAt: now})
+	}
+}
+
 func processorName(processor interface{}, fallback string) string {
 	if processor == nil {
 		return fallback
@@ -165,6 +288,22 @@ func (r *Runner) RunOnce(ctx context.Context, flow *core.Flow) (*core.Run, error
 	ctx = core.WithLogger(ctx, logger)
 	ctx = core.WithFlowID(ctx, flow.ID)
 	ctx = core.WithRunID(ctx, run.ID)
+	runErrors := &core.RunErrors{}
+	ctx = core.WithRunErrors(ctx, runErrors)
+	defer func() { run.Errors = append(run.Errors, runErrors.Errors()...) }()
+	dropped := &core.DroppedBlocks{}
+	ctx = core.WithDroppedBlocks(ctx, dropped)
+	defer func() { run.Dropped = dropped.Blocks() }()
+	successHooks := &core.SuccessHooks{}
+	ctx = core.WithSuccessHooks(ctx, successHooks)
+	// complete runs the work processors deferred until the run succeeds.
+	complete := func() {
+		for _, hookErr := range successHooks.Run(ctx) {
+			logger.Warn("run success hook failed", "processor", hookErr.ProcessorName, "error", hookErr.Error)
+			runErrors.Add(hookErr)
+		}
+		run.Status = core.RunStatusCompleted
+	}
 
 	tracer := otel.Tracer("curator-ai/runner")
 	ctx, span := tracer.Start(
@@ -185,7 +324,7 @@ func (r *Runner) RunOnce(ctx context.Context, flow *core.Flow) (*core.Run, error
 
 	sourceStart := 0
 	if restoreIndex, cfg := findSourceRestoreIndex(flow.Sources); cfg != nil {
-		restoredBlocks, restoredSummary, err := snapshot.Load(cfg.Path)
+		restoredBlocks, restoredSummary, restoredDropped, err := snapshot.Load(cfg.Path)
 		if err != nil {
 			run.Status = core.RunStatusFailed
 			logger.Error("snapshot restore failed", "stage", "source", "processor", processorName(flow.Sources[restoreIndex], "source"), "path", cfg.Path, "error", err)
@@ -194,6 +333,7 @@ func (r *Runner) RunOnce(ctx context.Context, flow *core.Flow) (*core.Run, error
 			return run, err
 		}
 		blocks = restoredBlocks
+		dropped.Replace(restoredDropped)
 		if restoredSummary != nil {
 			runSummary = restoredSummary
 		}
@@ -206,7 +346,7 @@ func (r *Runner) RunOnce(ctx context.Context, flow *core.Flow) (*core.Run, error
 			continue
 		}
 		if cfg := snapshotConfig(source); cfg != nil && cfg.Restore {
-			restoredBlocks, restoredSummary, err := snapshot.Load(cfg.Path)
+			restoredBlocks, restoredSummary, restoredDropped, err := snapshot.Load(cfg.Path)
 			if err != nil {
 				run.Status = core.RunStatusFailed
 				logger.Error("snapshot restore failed", "stage", "source", "processor", source.Name(), "path", cfg.Path, "error", err)
@@ -215,6 +355,7 @@ func (r *Runner) RunOnce(ctx context.Context, flow *core.Flow) (*core.Run, error
 				return run, err
 			}
 			blocks = restoredBlocks
+			dropped.Replace(restoredDropped)
 			if restoredSummary != nil {
 				runSummary = restoredSummary
 			}
@@ -248,11 +389,22 @@ func (r *Runner) RunOnce(ctx context.Context, flow *core.Flow) (*core.Run, error
 			span.SetStatus(codes.Error, err.Error())
 			return run, err
 		}
+		for _, block := range fetched {
+			if block == nil {
+				continue
+			}
+			if block.Metadata == nil {
+				block.Metadata = map[string]string{}
+			}
+			if block.Metadata[core.MetadataSource] == "" {
+				block.Metadata[core.MetadataSource] = sourceName
+			}
+		}
 		before := len(blocks)
 		blocks = append(blocks, fetched...)
 		logStage(logger, "source", sourceName, fmt.Sprintf("%T", source), before, len(blocks), time.Since(start))
 		if cfg := snapshotConfig(source); cfg != nil && cfg.Snapshot {
-			if err := snapshot.Save(cfg.Path, blocks, runSummary); err != nil {
+			if err := snapshot.Save(cfg.Path, blocks, runSummary, dropped.Blocks()); err != nil {
 				run.Status = core.RunStatusFailed
 				logger.Error("snapshot save failed", "stage", "source", "processor", sourceName, "path", cfg.Path, "error", err)
 				span.RecordError(err)
@@ -266,13 +418,13 @@ func (r *Runner) RunOnce(ctx context.Context, flow *core.Flow) (*core.Run, error
 
 	if len(blocks) == 0 {
 		logger.Info("source returned no blocks, skipping processing and outputs")
-		run.Status = core.RunStatusCompleted
+		complete()
 		return run, nil
 	}
 
 	qualityStart := 0
 	if restoreIndex, cfg := findQualityRestoreIndex(flow.Quality); cfg != nil {
-		restoredBlocks, restoredSummary, err := snapshot.Load(cfg.Path)
+		restoredBlocks, restoredSummary, restoredDropped, err := snapshot.Load(cfg.Path)
 		if err != nil {
 			run.Status = core.RunStatusFailed
 			logger.Error("snapshot restore failed", "stage", "quality", "processor", processorName(flow.Quality[restoreIndex], "quality"), "path", cfg.Path, "error", err)
@@ -281,6 +433,7 @@ func (r *Runner) RunOnce(ctx context.Context, flow *core.Flow) (*core.Run, error
 			return run, err
 		}
 		blocks = restoredBlocks
+		dropped.Replace(restoredDropped)
 		if restoredSummary != nil {
 			runSummary = restoredSummary
 		}
@@ -293,7 +446,7 @@ func (r *Runner) RunOnce(ctx context.Context, flow *core.Flow) (*core.Run, error
 			continue
 		}
 		if cfg := snapshotConfig(processor); cfg != nil && cfg.Restore {
-			restoredBlocks, restoredSummary, err := snapshot.Load(cfg.Path)
+			restoredBlocks, restoredSummary, restoredDropped, err := snapshot.Load(cfg.Path)
 			if err != nil {
 				run.Status = core.RunStatusFailed
 				logger.Error("snapshot restore failed", "stage", "quality", "processor", processor.Name(), "path", cfg.Path, "error", err)
@@ -302,6 +455,7 @@ func (r *Runner) RunOnce(ctx context.Context, flow *core.Flow) (*core.Run, error
 				return run, err
 			}
 			blocks = restoredBlocks
+			dropped.Replace(restoredDropped)
 			if restoredSummary != nil {
 				runSummary = restoredSummary
 			}
@@ -311,7 +465,9 @@ func (r *Runner) RunOnce(ctx context.Context, flow *core.Flow) (*core.Run, error
 		before := len(blocks)
 		start := time.Now()
 		logger.Info("stage started", "stage", "quality", "processor", processor.Name(), "processor_type", fmt.Sprintf("%T", processor), "blocks_before", before)
-		next, err := processor.Evaluate(ctx, blocks)
+		evaluated, bypassed := splitQualityBypass(blocks)
+		assessments := snapshotQuality(evaluated)
+		next, err := processor.Evaluate(ctx, evaluated)
 		if err != nil {
 			run.Status = core.RunStatusFailed
 			logger.Error(
@@ -326,10 +482,11 @@ func (r *Runner) RunOnce(ctx context.Context, flow *core.Flow) (*core.Run, error
 			span.SetStatus(codes.Error, err.Error())
 			return run, err
 		}
-		blocks = next
+		recordQualityOutcome(processor.Name(), evaluated, next, assessments, dropped)
+		blocks = mergeQualityBypass(blocks, next, bypassed)
 		logStage(logger, "quality", processor.Name(), fmt.Sprintf("%T", processor), before, len(blocks), time.Since(start))
 		if cfg := snapshotConfig(processor); cfg != nil && cfg.Snapshot {
-			if err := snapshot.Save(cfg.Path, blocks, runSummary); err != nil {
+			if err := snapshot.Save(cfg.Path, blocks, runSummary, dropped.Blocks()); err != nil {
 				run.Status = core.RunStatusFailed
 				logger.Error("snapshot save failed", "stage", "quality", "processor", processor.Name(), "path", cfg.Path, "error", err)
 				span.RecordError(err)
@@ -340,15 +497,22 @@ func (r *Runner) RunOnce(ctx context.Context, flow *core.Flow) (*core.Run, error
 		}
 	}
 
+	summarize := true
 	if len(blocks) == 0 {
-		logger.Info("no blocks left after quality processing, skipping summary and outputs")
-		run.Status = core.RunStatusCompleted
-		return run, nil
+		if len(dropped.Blocks()) == 0 {
+			logger.Info("no blocks left after quality processing, skipping summary and outputs")
+			complete()
+			return run, nil
+		}
+		// Outputs still run, with no blocks, so a template can explain why
+		// every post was dropped.
+		logger.Info("every block was dropped by quality processing, skipping summary")
+		summarize = false
 	}
 
 	postSummaryStart := 0
-	if restoreIndex, cfg := findSummaryRestoreIndex(flow.PostSummary); cfg != nil {
-		restoredBlocks, restoredSummary, err := snapshot.Load(cfg.Path)
+	if restoreIndex, cfg := findSummaryRestoreIndex(flow.PostSummary); summarize && cfg != nil {
+		restoredBlocks, restoredSummary, restoredDropped, err := snapshot.Load(cfg.Path)
 		if err != nil {
 			run.Status = core.RunStatusFailed
 			logger.Error("snapshot restore failed", "stage", "post_summary", "processor", processorName(flow.PostSummary[restoreIndex], "post_summary"), "path", cfg.Path, "error", err)
@@ -357,19 +521,20 @@ func (r *Runner) RunOnce(ctx context.Context, flow *core.Flow) (*core.Run, error
 			return run, err
 		}
 		blocks = restoredBlocks
+		dropped.Replace(restoredDropped)
 		if restoredSummary != nil {
 			runSummary = restoredSummary
 		}
 		postSummaryStart = restoreIndex + 1
 		logger.Info("snapshot restored", "stage", "post_summary", "processor", processorName(flow.PostSummary[restoreIndex], "post_summary"), "path", cfg.Path, "blocks", len(blocks))
 	}
-	for i := postSummaryStart; i < len(flow.PostSummary); i++ {
+	for i := postSummaryStart; summarize && i < len(flow.PostSummary); i++ {
 		processor := flow.PostSummary[i]
 		if processor == nil {
 			continue
 		}
 		if cfg := snapshotConfig(processor); cfg != nil && cfg.Restore {
-			restoredBlocks, restoredSummary, err := snapshot.Load(cfg.Path)
+			restoredBlocks, restoredSummary, restoredDropped, err := snapshot.Load(cfg.Path)
 			if err != nil {
 				run.Status = core.RunStatusFailed
 				logger.Error("snapshot restore failed", "stage", "post_summary", "processor", processor.Name(), "path", cfg.Path, "error", err)
@@ -378,6 +543,7 @@ func (r *Runner) RunOnce(ctx context.Context, flow *core.Flow) (*core.Run, error
 				return run, err
 			}
 			blocks = restoredBlocks
+			dropped.Replace(restoredDropped)
 			if restoredSummary != nil {
 				runSummary = restoredSummary
 			}
@@ -405,7 +571,7 @@ func (r *Runner) RunOnce(ctx context.Context, flow *core.Flow) (*core.Run, error
 		blocks = next
 		logStage(logger, "post_summary", processor.Name(), fmt.Sprintf("%T", processor), before, len(blocks), time.Since(start))
 		if cfg := snapshotConfig(processor); cfg != nil && cfg.Snapshot {
-			if err := snapshot.Save(cfg.Path, blocks, runSummary); err != nil {
+			if err := snapshot.Save(cfg.Path, blocks, runSummary, dropped.Blocks()); err != nil {
 				run.Status = core.RunStatusFailed
 				logger.Error("snapshot save failed", "stage", "post_summary", "processor", processor.Name(), "path", cfg.Path, "error", err)
 				span.RecordError(err)
@@ -417,8 +583,8 @@ func (r *Runner) RunOnce(ctx context.Context, flow *core.Flow) (*core.Run, error
 	}
 
 	runSummaryStart := 0
-	if restoreIndex, cfg := findRunSummaryRestoreIndex(flow.RunSummary); cfg != nil {
-		restoredBlocks, restoredSummary, err := snapshot.Load(cfg.Path)
+	if restoreIndex, cfg := findRunSummaryRestoreIndex(flow.RunSummary); summarize && cfg != nil {
+		restoredBlocks, restoredSummary, restoredDropped, err := snapshot.Load(cfg.Path)
 		if err != nil {
 			run.Status = core.RunStatusFailed
 			logger.Error("snapshot restore failed", "stage", "run_summary", "processor", processorName(flow.RunSummary[restoreIndex], "run_summary"), "path", cfg.Path, "error", err)
@@ -428,18 +594,19 @@ func (r *Runner) RunOnce(ctx context.Context, flow *core.Flow) (*core.Run, error
 		}
 		if restoredBlocks != nil {
 			blocks = restoredBlocks
+			dropped.Replace(restoredDropped)
 		}
 		runSummary = restoredSummary
 		runSummaryStart = restoreIndex + 1
 		logger.Info("snapshot restored", "stage", "run_summary", "processor", processorName(flow.RunSummary[restoreIndex], "run_summary"), "path", cfg.Path, "blocks", len(blocks), "has_summary", runSummary != nil)
 	}
-	for i := runSummaryStart; i < len(flow.RunSummary); i++ {
+	for i := runSummaryStart; summarize && i < len(flow.RunSummary); i++ {
 		processor := flow.RunSummary[i]
 		if processor == nil {
 			continue
 		}
 		if cfg := snapshotConfig(processor); cfg != nil && cfg.Restore {
-			restoredBlocks, restoredSummary, err := snapshot.Load(cfg.Path)
+			restoredBlocks, restoredSummary, restoredDropped, err := snapshot.Load(cfg.Path)
 			if err != nil {
 				run.Status = core.RunStatusFailed
 				logger.Error("snapshot restore failed", "stage", "run_summary", "processor", processor.Name(), "path", cfg.Path, "error", err)
@@ -449,6 +616,7 @@ func (r *Runner) RunOnce(ctx context.Context, flow *core.Flow) (*core.Run, error
 			}
 			if restoredBlocks != nil {
 				blocks = restoredBlocks
+				dropped.Replace(restoredDropped)
 			}
 			runSummary = restoredSummary
 			logger.Info("snapshot restored", "stage", "run_summary", "processor", processor.Name(), "path", cfg.Path, "blocks", len(blocks), "has_summary", runSummary != nil)
@@ -482,7 +650,7 @@ func (r *Runner) RunOnce(ctx context.Context, flow *core.Flow) (*core.Run, error
 			"duration", time.Since(start),
 		)
 		if cfg := snapshotConfig(processor); cfg != nil && cfg.Snapshot {
-			if err := snapshot.Save(cfg.Path, blocks, runSummary); err != nil {
+			if err := snapshot.Save(cfg.Path, blocks, runSummary, dropped.Blocks()); err != nil {
 				run.Status = core.RunStatusFailed
 				logger.Error("snapshot save failed", "stage", "run_summary", "processor", processor.Name(), "path", cfg.Path, "error", err)
 				span.RecordError(err)
@@ -493,15 +661,15 @@ func (r *Runner) RunOnce(ctx context.Context, flow *core.Flow) (*core.Run, error
 		}
 	}
 
-	if len(blocks) == 0 {
+	if len(blocks) == 0 && len(dropped.Blocks()) == 0 {
 		logger.Info("no blocks to deliver, skipping outputs")
-		run.Status = core.RunStatusCompleted
+		complete()
 		return run, nil
 	}
 
 	outputStart := 0
 	if restoreIndex, cfg := findOutputRestoreIndex(flow.Outputs); cfg != nil {
-		restoredBlocks, restoredSummary, err := snapshot.Load(cfg.Path)
+		restoredBlocks, restoredSummary, restoredDropped, err := snapshot.Load(cfg.Path)
 		if err != nil {
 			run.Status = core.RunStatusFailed
 			logger.Error("snapshot restore failed", "stage", "output", "processor", processorName(flow.Outputs[restoreIndex], "output"), "path", cfg.Path, "error", err)
@@ -511,6 +679,7 @@ func (r *Runner) RunOnce(ctx context.Context, flow *core.Flow) (*core.Run, error
 		}
 		if restoredBlocks != nil {
 			blocks = restoredBlocks
+			dropped.Replace(restoredDropped)
 		}
 		if restoredSummary != nil {
 			runSummary = restoredSummary
@@ -524,7 +693,7 @@ func (r *Runner) RunOnce(ctx context.Context, flow *core.Flow) (*core.Run, error
 			continue
 		}
 		if cfg := snapshotConfig(output); cfg != nil && cfg.Restore {
-			restoredBlocks, restoredSummary, err := snapshot.Load(cfg.Path)
+			restoredBlocks, restoredSummary, restoredDropped, err := snapshot.Load(cfg.Path)
 			if err != nil {
 				run.Status = core.RunStatusFailed
 				logger.Error("snapshot restore failed", "stage", "output", "processor", output.Name(), "path", cfg.Path, "error", err)
@@ -534,6 +703,7 @@ func (r *Runner) RunOnce(ctx context.Context, flow *core.Flow) (*core.Run, error
 			}
 			if restoredBlocks != nil {
 				blocks = restoredBlocks
+				dropped.Replace(restoredDropped)
 			}
 			if restoredSummary != nil {
 				runSummary = restoredSummary
@@ -566,7 +736,7 @@ func (r *Runner) RunOnce(ctx context.Context, flow *core.Flow) (*core.Run, error
 			"duration", time.Since(start),
 		)
 		if cfg := snapshotConfig(output); cfg != nil && cfg.Snapshot {
-			if err := snapshot.Save(cfg.Path, blocks, runSummary); err != nil {
+			if err := snapshot.Save(cfg.Path, blocks, runSummary, dropped.Blocks()); err != nil {
 				run.Status = core.RunStatusFailed
 				logger.Error("snapshot save failed", "stage", "output", "processor", output.Name(), "path", cfg.Path, "error", err)
 				span.RecordError(err)
@@ -579,7 +749,7 @@ func (r *Runner) RunOnce(ctx context.Context, flow *core.Flow) (*core.Run, error
 
 	completedAt := time.Now().UTC()
 	run.CompletedAt = &completedAt
-	run.Status = core.RunStatusCompleted
+	complete()
 	run.Blocks = blocks
 	run.RunSummary = runSummary
 	span.SetStatus(codes.Ok, "")
@@ -0,0 +1,267 @@
+package runner
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/bakkerme/curator-ai/internal/core"
+)
+
+func TestQualityBypass_SkipsProcessorAndKeepsOrder(t *testing.T) {
+	t.Parallel()
+
+	bypass := map[string]string{core.MetadataQualityBypass: "true"}
+	blocks := []*core.PostBlock{
+		{ID: "a"},
+		{ID: "watched", Metadata: bypass},
+		{ID: "b"},
+		{ID: "c"},
+	}
+	quality := &testQuality{name: "drop-all-but-c", evaluateFn: func(in []*core.PostBlock) ([]*core.PostBlock, error) {
+		for _, block := range in {
+			if block.ID == "watched" {
+				t.Fatalf("bypassed block reached quality processor")
+			}
+		}
+		return []*core.PostBlock{in[2], {ID: "new"}}, nil
+	}}
+
+	evaluated, bypassed := splitQualityBypass(blocks)
+	if len(evaluated) != 3 {
+		t.Fatalf("expected 3 evaluated blocks, got %d", len(evaluated))
+	}
+	kept, err := quality.evaluateFn(evaluated)
+	if err != nil {
+		t.Fatalf("evaluate: %v", err)
+	}
+	merged := mergeQualityBypass(blocks, kept, bypassed)
+
+	var ids []string
+	for _, block := range merged {
+		ids = append(ids, block.ID)
+	}
+	if got := len(ids); got != 3 || ids[0] != "watched" || ids[1] != "c" || ids[2] != "new" {
+		t.Fatalf("unexpected merged order %v", ids)
+	}
+}
+
+func TestQualityBypass_NoFlaggedBlocksPassThrough(t *testing.T) {
+	t.Parallel()
+
+	blocks := []*core.PostBlock{{ID: "a"}, {ID: "b"}}
+	evaluated, bypassed := splitQualityBypass(blocks)
+	if bypassed != nil || len(evaluated) != 2 {
+		t.Fatalf("expected blocks unchanged, got %d evaluated and %v bypassed", len(evaluated), bypassed)
+	}
+	kept := blocks[:1]
+	if merged := mergeQualityBypass(blocks, kept, bypassed); len(merged) != 1 || merged[0].ID != "a" {
+		t.Fatalf("expected processor output unchanged, got %v", merged)
+	}
+}
+
+func TestRunner_TagsBlocksWithSource(t *testing.T) {
+	t.Parallel()
+
+	var seen []string
+	flow := &core.Flow{
+		ID:      "flow-1",
+		Sources: []core.SourceProcessor{&testSource{name: "reddit"}},
+		Quality: []core.QualityProcessor{&testQuality{name: "inspect", evaluateFn: func(blocks []*core.PostBlock) ([]*core.PostBlock, error) {
+			for _, block := range blocks {
+				seen = append(seen, block.Metadata[core.MetadataSource])
+			}
+			return blocks, nil
+		}}},
+	}
+
+	if _, err := New(slog.New(slog.NewTextHandler(io.Discard, nil))).RunOnce(context.Background(), flow); err != nil {
+		t.Fatalf("RunOnce error: %v", err)
+	}
+	if len(seen) != 1 || seen[0] != "reddit" {
+		t.Fatalf("expected blocks tagged with their source, got %v", seen)
+	}
+}
+
+func TestRunner_RecordsQualityHistoryAndDroppedBlocks(t *testing.T) {
+	t.Parallel()
+
+	a, b, c := &core.PostBlock{ID: "a"}, &core.PostBlock{ID: "b"}, &core.PostBlock{ID: "c"}
+	verdict := func(name, result, reason string) *core.QualityResult {
+		return &core.QualityResult{ProcessorName: name, Result: result, Reason: reason}
+	}
+	flow := &core.Flow{
+		ID:      "flow-1",
+		Sources: []core.SourceProcessor{&testSource{name: "reddit", blocks: []*core.PostBlock{a, b, c}}},
+		Quality: []core.QualityProcessor{
+			&testQuality{name: "min_comments", evaluateFn: func(blocks []*core.PostBlock) ([]*core.PostBlock, error) {
+				a.Quality = verdict("min_comments", "pass", "")
+				b.Quality = verdict("min_comments", "pass", "")
+				c.Quality = verdict("min_comments", "drop", "matched rule: comment_count < 5")
+				return []*core.PostBlock{a, b}, nil
+			}},
+			&testQuality{name: "is_relevant", evaluateFn: func(blocks []*core.PostBlock) ([]*core.PostBlock, error) {
+				a.Quality = verdict("is_relevant", "pass", "about LLMs")
+				// b fails without a new verdict, like a block_error_policy drop.
+				b.Errors = append(b.Errors, core.ProcessError{ProcessorName: "is_relevant", Error: "timeout"})
+				return []*core.PostBlock{a}, nil
+			}},
+		},
+	}
+
+	run, err := New(slog.New(slog.NewTextHandler(io.Discard, nil))).RunOnce(context.Background(), flow)
+	if err != nil {
+		t.Fatalf("RunOnce error: %v", err)
+	}
+	if len(a.QualityHistory) != 2 || a.QualityHistory[0].ProcessorName != "min_comments" || a.QualityHistory[1].ProcessorName != "is_relevant" {
+		t.Fatalf("expected a verdict from each processor, got %+v", a.QualityHistory)
+	}
+	if len(b.QualityHistory) != 1 || len(c.QualityHistory) != 1 {
+		t.Fatalf("expected one verdict for b and c, got %d and %d", len(b.QualityHistory), len(c.QualityHistory))
+	}
+	if len(run.Dropped) != 2 {
+		t.Fatalf("expected two dropped blocks, got %+v", run.Dropped)
+	}
+	if got := run.Dropped[0]; got.Block != c || got.Processor != "min_comments" || got.Reason != "matched rule: comment_count < 5" {
+		t.Fatalf("unexpected first dropped entry %+v", got)
+	}
+	if got := run.Dropped[1]; got.Block != b || got.Processor != "is_relevant" || got.Reason != "timeout" {
+		t.Fatalf("unexpected second dropped entry %+v", got)
+	}
+}
+
+type testOutput struct {
+	err     error
+	calls   int
+	blocks  []*core.PostBlock
+	dropped []core.DroppedBlock
+}
+
+func (o *testOutput) Name() string                           { return "output" }
+func (o *testOutput) Configure(map[string]interface{}) error { return nil }
+func (o *testOutput) Validate() error                        { return nil }
+func (o *testOutput) Deliver(ctx context.Context, blocks []*core.PostBlock, _ *core.RunSummary) error {
+	o.calls++
+	o.blocks = blocks
+	o.dropped = core.DroppedBlocksFromContext(ctx)
+	return o.err
+}
+
+type testSummary struct {
+	name        string
+	summarizeFn func([]*core.PostBlock) ([]*core.PostBlock, error)
+}
+
+func (s *testSummary) Name() string                           { return s.name }
+func (s *testSummary) Configure(map[string]interface{}) error { return nil }
+func (s *testSummary) Validate() error                        { return nil }
+func (s *testSummary) Summarize(_ context.Context, blocks []*core.PostBlock) ([]*core.PostBlock, error) {
+	return s.summarizeFn(blocks)
+}
+
+func TestRunner_DeliversDroppedBlocksWhenEveryBlockIsDropped(t *testing.T) {
+	t.Parallel()
+
+	a := &core.PostBlock{ID: "a"}
+	summarized := false
+	output := &testOutput{}
+	flow := &core.Flow{
+		ID:      "flow-1",
+		Sources: []core.SourceProcessor{&testSource{name: "reddit", blocks: []*core.PostBlock{a}}},
+		Quality: []core.QualityProcessor{&testQuality{name: "is_relevant", evaluateFn: func(blocks []*core.PostBlock) ([]*core.PostBlock, error) {
+			a.Quality = &core.QualityResult{ProcessorName: "is_relevant", Result: "drop", Reason: "off topic"}
+			return nil, nil
+		}}},
+		PostSummary: []core.SummaryProcessor{&testSummary{name: "summary", summarizeFn: func(blocks []*core.PostBlock) ([]*core.PostBlock, error) {
+			summarized = true
+			return blocks, nil
+		}}},
+		Outputs: []core.OutputProcessor{output},
+	}
+
+	if _, err := New(slog.New(slog.NewTextHandler(io.Discard, nil))).RunOnce(context.Background(), flow); err != nil {
+		t.Fatalf("RunOnce error: %v", err)
+	}
+	if summarized {
+		t.Fatalf("expected summaries to be skipped when every block was dropped")
+	}
+	if output.calls != 1 || len(output.blocks) != 0 {
+		t.Fatalf("expected outputs to run once with no blocks, got %d calls with %d blocks", output.calls, len(output.blocks))
+	}
+	if len(output.dropped) != 1 || output.dropped[0].Block != a || output.dropped[0].Reason != "off topic" {
+		t.Fatalf("expected the output to see why the block was dropped, got %+v", output.dropped)
+	}
+}
+
+func TestRunner_RecordsVerdictsUpdatedInPlace(t *testing.T) {
+	t.Parallel()
+
+	a := &core.PostBlock{ID: "a", Quality: &core.QualityResult{ProcessorName: "min_comments", Result: "pass"}}
+	flow := &core.Flow{
+		ID:      "flow-1",
+		Sources: []core.SourceProcessor{&testSource{name: "reddit", blocks: []*core.PostBlock{a}}},
+		Quality: []core.QualityProcessor{&testQuality{name: "is_relevant", evaluateFn: func(blocks []*core.PostBlock) ([]*core.PostBlock, error) {
+			a.Quality.ProcessorName, a.Quality.Reason = "is_relevant", "about LLMs"
+			return blocks, nil
+		}}},
+	}
+
+	if _, err := New(slog.New(slog.NewTextHandler(io.Discard, nil))).RunOnce(context.Background(), flow); err != nil {
+		t.Fatalf("RunOnce error: %v", err)
+	}
+	if len(a.QualityHistory) != 1 || a.QualityHistory[0].ProcessorName != "is_relevant" {
+		t.Fatalf("expected the in-place verdict recorded, got %+v", a.QualityHistory)
+	}
+}
+
+func TestRunner_RunsSuccessHooksOnlyAfterOutputsSucceed(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name      string
+		outputErr error
+		want      int
+	}{
+		{name: "delivered", want: 1},
+		{name: "delivery failed", outputErr: errors.New("smtp down"), want: 0},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			calls := 0
+			flow := &core.Flow{
+				ID:      "flow-1",
+				Sources: []core.SourceProcessor{&testSource{name: "src"}},
+				Quality: []core.QualityProcessor{&hookQuality{name: "remember", hook: func(context.Context) error {
+					calls++
+					return nil
+				}}},
+				Outputs: []core.OutputProcessor{&testOutput{err: tc.outputErr}},
+			}
+
+			_, err := New(slog.New(slog.NewTextHandler(io.Discard, nil))).RunOnce(context.Background(), flow)
+			if (err != nil) != (tc.outputErr != nil) {
+				t.Fatalf("RunOnce error = %v", err)
+			}
+			if calls != tc.want {
+				t.Fatalf("expected %d hook calls, got %d", tc.want, calls)
+			}
+		})
+	}
+}
+
+// hookQuality keeps every block and registers hook as a success hook.
+type hookQuality struct {
+	name string
+	hook func(context.Context) error
+}
+
+func (q *hookQuality) Name() string                           { return q.name }
+func (q *hookQuality) Configure(map[string]interface{}) error { return nil }
+func (q *hookQuality) Validate() error                        { return nil }
+func (q *hookQuality) Evaluate(ctx context.Context, blocks []*core.PostBlock) ([]*core.PostBlock, error) {
+	if err := core.OnRunSuccess(ctx, q.name, q.hook); err != nil {
+		return nil, err
+	}
+	return blocks, nil
+}
@@ -12,13 +12,17 @@ import (
 )
 
 type testSource struct {
-	name string
+	name   string
+	blocks []*core.PostBlock
 }
 
 func (s *testSource) Name() string                           { return s.name }
 func (s *testSource) Configure(map[string]interface{}) error { return nil }
 func (s *testSource) Validate() error                        { return nil }
 func (s *testSource) Fetch(context.Context) ([]*core.PostBlock, error) {
+	if s.blocks != nil {
+		return s.blocks, nil
+	}
 	return []*core.PostBlock{{ID: "p1"}}, nil
 }
 
@@ -44,10 +48,10 @@ func TestRunner_QualityRestore_HonorsLaterRestoreWithoutRunningProcessor(t *test
 	ruleBlocks := []*core.PostBlock{{ID: "rule-1"}}
 	llmBlocks := []*core.PostBlock{{ID: "llm-1"}, {ID: "llm-2"}}
 
-	if err := snapshot.Save(rulePath, ruleBlocks, nil); err != nil {
+	if err := snapshot.Save(rulePath, ruleBlocks, nil, nil); err != nil {
 		t.Fatalf("save rule snapshot: %v", err)
 	}
-	if err := snapshot.Save(llmPath, llmBlocks, nil); err != nil {
+	if err := snapshot.Save(llmPath, llmBlocks, nil, nil); err != nil {
 		t.Fatalf("save llm snapshot: %v", err)
 	}
 
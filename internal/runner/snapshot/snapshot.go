@@ -1,20 +1,25 @@
 package snapshot
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/bakkerme/curator-ai/internal/core"
+	"github.com/gabriel-vasile/mimetype"
 )
 
 type Payload struct {
-	Blocks     []*core.PostBlock `json:"blocks"`
-	RunSummary *core.RunSummary  `json:"run_summary,omitempty"`
+	Blocks     []*core.PostBlock   `json:"blocks"`
+	RunSummary *core.RunSummary    `json:"run_summary,omitempty"`
+	Dropped    []core.DroppedBlock `json:"dropped,omitempty"`
 }
 
-func Save(path string, blocks []*core.PostBlock, runSummary *core.RunSummary) error {
+func Save(path string, blocks []*core.PostBlock, runSummary *core.RunSummary, dropped []core.DroppedBlock) error {
 	if path == "" {
 		return fmt.Errorf("snapshot path is required")
 	}
@@ -24,9 +29,18 @@ func Save(path string, blocks []*core.PostBlock, runSummary *core.RunSummary) er
 			return fmt.Errorf("create snapshot directory: %w", err)
 		}
 	}
+	blocks, err := externalizeImages(path, blocks)
+	if err != nil {
+		return err
+	}
+	dropped, err = externalizeDroppedImages(path, dropped)
+	if err != nil {
+		return err
+	}
 	payload := Payload{
 		Blocks:     blocks,
 		RunSummary: runSummary,
+		Dropped:    dropped,
 	}
 	data, err := json.MarshalIndent(payload, "", "  ")
 	if err != nil {
@@ -38,17 +52,196 @@ func Save(path string, blocks []*core.PostBlock, runSummary *core.RunSummary) er
 	return nil
 }
 
-func Load(path string) ([]*core.PostBlock, *core.RunSummary, error) {
+func Load(path string) ([]*core.PostBlock, *core.RunSummary, []core.DroppedBlock, error) {
 	if path == "" {
-		return nil, nil, fmt.Errorf("snapshot path is required")
+		return nil, nil, nil, fmt.Errorf("snapshot path is required")
 	}
 	data, err := os.ReadFile(path)
 	if err != nil {
-		return nil, nil, fmt.Errorf("read snapshot: %w", err)
+		return nil, nil, nil, fmt.Errorf("read snapshot: %w", err)
 	}
 	var payload Payload
 	if err := json.Unmarshal(data, &payload); err != nil {
-		return nil, nil, fmt.Errorf("unmarshal snapshot: %w", err)
+		return nil, nil, nil, fmt.Errorf("unmarshal snapshot: %w", err)
+	}
+	if err := internalizeImages(path, payload.Blocks); err != nil {
+		return nil, nil, nil, err
+	}
+	droppedBlocks := make([]*core.PostBlock, 0, len(payload.Dropped))
+	for _, dropped := range payload.Dropped {
+		droppedBlocks = append(droppedBlocks, dropped.Block)
+	}
+	if err := internalizeImages(path, droppedBlocks); err != nil {
+		return nil, nil, nil, err
+	}
+	return payload.Blocks, payload.RunSummary, payload.Dropped, nil
+}
+
+// imagesDir returns the directory holding image bytes for the snapshot at path,
+// e.g. "snapshots/source.json" -> "snapshots/source.images".
+func imagesDir(path string) string {
+	return strings.TrimSuffix(path, filepath.Ext(path)) + ".images"
+}
+
+// externalizeImages writes image bytes to files next to the snapshot and returns
+// copies of blocks whose images reference those files. The input blocks are not
+// modified because the runner keeps using them after the snapshot is saved.
+func externalizeImages(path string, blocks []*core.PostBlock) ([]*core.PostBlock, error) {
+	dir := imagesDir(path)
+	rel, err := filepath.Rel(filepath.Dir(path), dir)
+	if err != nil {
+		rel = filepath.Base(dir)
+	}
+
+	out := make([]*core.PostBlock, len(blocks))
+	for i, block := range blocks {
+		if block == nil || !blockHasImageData(block) {
+			out[i] = block
+			continue
+		}
+		clone := *block
+		clone.ImageBlocks, err = externalizeImageSlice(dir, rel, block.ImageBlocks)
+		if err != nil {
+			return nil, err
+		}
+		clone.Comments, err = externalizeCommentImages(dir, rel, block.Comments)
+		if err != nil {
+			return nil, err
+		}
+		out[i] = &clone
+	}
+	return out, nil
+}
+
+// externalizeDroppedImages is externalizeImages for the blocks of dropped
+// entries; the entries are copied so the run's collection is left as is.
+func externalizeDroppedImages(path string, dropped []core.DroppedBlock) ([]core.DroppedBlock, error) {
+	if len(dropped) == 0 {
+		return dropped, nil
 	}
-	return payload.Blocks, payload.RunSummary, nil
+	blocks := make([]*core.PostBlock, len(dropped))
+	for i, entry := range dropped {
+		blocks[i] = entry.Block
+	}
+	blocks, err := externalizeImages(path, blocks)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]core.DroppedBlock, len(dropped))
+	for i, entry := range dropped {
+		out[i] = entry
+		out[i].Block = blocks[i]
+	}
+	return out, nil
+}
+
+// externalizeCommentImages copies comments and their nested replies with
+// image bytes moved to files.
+func externalizeCommentImages(dir, rel string, comments []core.CommentBlock) ([]core.CommentBlock, error) {
+	if len(comments) == 0 {
+		return comments, nil
+	}
+	out := make([]core.CommentBlock, len(comments))
+	for i, comment := range comments {
+		var err error
+		out[i] = comment
+		if out[i].Images, err = externalizeImageSlice(dir, rel, comment.Images); err != nil {
+			return nil, err
+		}
+		if out[i].Replies, err = externalizeCommentImages(dir, rel, comment.Replies); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+func externalizeImageSlice(dir, rel string, images []core.ImageBlock) ([]core.ImageBlock, error) {
+	if len(images) == 0 {
+		return images, nil
+	}
+	out := make([]core.ImageBlock, len(images))
+	for i, image := range images {
+		out[i] = image
+		if len(image.ImageData) == 0 {
+			continue
+		}
+		// Content-addressed names dedupe identical images across blocks and runs.
+		sum := sha256.Sum256(image.ImageData)
+		name := hex.EncodeToString(sum[:]) + mimetype.Detect(image.ImageData).Extension()
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return nil, fmt.Errorf("create snapshot image directory: %w", err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, name), image.ImageData, 0o644); err != nil {
+			return nil, fmt.Errorf("write snapshot image: %w", err)
+		}
+		out[i].ImageData = nil
+		out[i].ImageFile = filepath.ToSlash(filepath.Join(rel, name))
+	}
+	return out, nil
+}
+
+func blockHasImageData(block *core.PostBlock) bool {
+	for _, image := range block.ImageBlocks {
+		if len(image.ImageData) > 0 {
+			return true
+		}
+	}
+	return commentsHaveImageData(block.Comments)
+}
+
+func commentsHaveImageData(comments []core.CommentBlock) bool {
+	for _, comment := range comments {
+		for _, image := range comment.Images {
+			if len(image.ImageData) > 0 {
+				return true
+			}
+		}
+		if commentsHaveImageData(comment.Replies) {
+			return true
+		}
+	}
+	return false
+}
+
+// internalizeImages loads image bytes referenced by ImageFile back into ImageData.
+func internalizeImages(path string, blocks []*core.PostBlock) error {
+	base := filepath.Dir(path)
+	load := func(images []core.ImageBlock) error {
+		for i := range images {
+			if images[i].ImageFile == "" {
+				continue
+			}
+			data, err := os.ReadFile(filepath.Join(base, filepath.FromSlash(images[i].ImageFile)))
+			if err != nil {
+				return fmt.Errorf("read snapshot image: %w", err)
+			}
+			images[i].ImageData = data
+			images[i].ImageFile = ""
+		}
+		return nil
+	}
+	var loadComments func(comments []core.CommentBlock) error
+	loadComments = func(comments []core.CommentBlock) error {
+		for ci := range comments {
+			if err := load(comments[ci].Images); err != nil {
+				return err
+			}
+			if err := loadComments(comments[ci].Replies); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	for _, block := range blocks {
+		if block == nil {
+			continue
+		}
+		if err := load(block.ImageBlocks); err != nil {
+			return err
+		}
+		if err := loadComments(block.Comments); err != nil {
+			return err
+		}
+	}
+	return nil
 }
@@ -0,0 +1,112 @@
+package snapshot
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/bakkerme/curator-ai/internal/core"
+)
+
+func TestSaveWritesImageBytesAsExternalFiles(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "snapshots", "source.json")
+	data := []byte{0x89, 0x50, 0x4E, 0x47, 0x0D, 0x0A, 0x1A, 0x0A, 0x00, 0x00, 0x00, 0x0D}
+	blocks := []*core.PostBlock{{
+		ID:          "post-1",
+		ImageBlocks: []core.ImageBlock{{URL: "https://example.com/a.png", ImageData: data, WasFetched: true}},
+		Comments: []core.CommentBlock{{
+			ID:     "c1",
+			Images: []core.ImageBlock{{URL: "https://example.com/b.png", ImageData: data}},
+			Replies: []core.CommentBlock{{
+				ID:     "c2",
+				Images: []core.ImageBlock{{URL: "https://example.com/c.png", ImageData: data}},
+			}},
+		}},
+	}}
+
+	if err := Save(path, blocks, nil, nil); err != nil {
+		t.Fatalf("save failed: %v", err)
+	}
+	if len(blocks[0].ImageBlocks[0].ImageData) == 0 || blocks[0].ImageBlocks[0].ImageFile != "" ||
+		len(blocks[0].Comments[0].Replies[0].Images[0].ImageData) == 0 {
+		t.Fatalf("expected live blocks to be left untouched")
+	}
+
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read snapshot: %v", err)
+	}
+	if strings.Contains(string(raw), "image_data") {
+		t.Fatalf("expected no inline image data in snapshot JSON")
+	}
+	if !strings.Contains(string(raw), `"image_file": "source.images/`) {
+		t.Fatalf("expected image_file reference in snapshot JSON, got %s", raw)
+	}
+	entries, err := os.ReadDir(filepath.Join(dir, "snapshots", "source.images"))
+	if err != nil {
+		t.Fatalf("read images dir: %v", err)
+	}
+	if len(entries) != 1 || filepath.Ext(entries[0].Name()) != ".png" {
+		t.Fatalf("expected one deduplicated png file, got %v", entries)
+	}
+
+	restored, _, _, err := Load(path)
+	if err != nil {
+		t.Fatalf("load failed: %v", err)
+	}
+	comment := restored[0].Comments[0]
+	for _, image := range []core.ImageBlock{restored[0].ImageBlocks[0], comment.Images[0], comment.Replies[0].Images[0]} {
+		if !bytes.Equal(image.ImageData, data) {
+			t.Fatalf("expected restored image data, got %v", image.ImageData)
+		}
+		if image.ImageFile != "" {
+			t.Fatalf("expected image_file to be cleared after load")
+		}
+	}
+}
+
+func TestSaveRoundTripsDroppedBlocks(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "quality.json")
+	data := []byte{0x89, 0x50, 0x4E, 0x47, 0x0D, 0x0A, 0x1A, 0x0A, 0x00, 0x00, 0x00, 0x0D}
+	dropped := []core.DroppedBlock{{
+		Block: &core.PostBlock{
+			ID:             "post-2",
+			ImageBlocks:    []core.ImageBlock{{URL: "https://example.com/c.png", ImageData: data}},
+			QualityHistory: []core.QualityResult{{ProcessorName: "min_comments", Result: "drop", Reason: "too quiet"}},
+		},
+		Processor: "min_comments",
+		Reason:    "too quiet",
+	}}
+
+	if err := Save(path, []*core.PostBlock{{ID: "post-1"}}, nil, dropped); err != nil {
+		t.Fatalf("save failed: %v", err)
+	}
+	if len(dropped[0].Block.ImageBlocks[0].ImageData) == 0 {
+		t.Fatalf("expected the dropped block to be left untouched")
+	}
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read snapshot: %v", err)
+	}
+	if strings.Contains(string(raw), "image_data") {
+		t.Fatalf("expected no inline image data for dropped blocks")
+	}
+
+	blocks, _, restored, err := Load(path)
+	if err != nil {
+		t.Fatalf("load failed: %v", err)
+	}
+	if len(blocks) != 1 || len(restored) != 1 {
+		t.Fatalf("expected one block and one dropped entry, got %d and %d", len(blocks), len(restored))
+	}
+	entry := restored[0]
+	if entry.Processor != "min_comments" || entry.Reason != "too quiet" || len(entry.Block.QualityHistory) != 1 {
+		t.Fatalf("unexpected dropped entry %+v", entry)
+	}
+	if !bytes.Equal(entry.Block.ImageBlocks[0].ImageData, data) {
+		t.Fatalf("expected restored image data for the dropped block")
+	}
+}
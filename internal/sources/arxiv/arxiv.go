@@ -2,6 +2,7 @@ package arxiv
 
 import (
 	"context"
+	"errors"
 	"time"
 )
 
@@ -14,13 +15,24 @@ type SearchOptions struct {
 	SortOrder  string
 	DateFrom   string
 	DateTo     string
+	// From and To bound the window precisely and take precedence over the
+	// day-granular DateFrom/DateTo strings.
+	From time.Time
+	To   time.Time
+	// DateField selects the date the window applies to: "submittedDate"
+	// (default) or "lastUpdatedDate".
+	DateField string
+	// Start is the zero-based result offset used for pagination.
+	Start int
 }
 
 // Paper represents a normalized arXiv API response entry.
 type Paper struct {
-	ID          string
-	Title       string
-	Abstract    string
+	ID       string
+	Title    string
+	Abstract string
+	// Comment is the author-supplied arXiv comment (page counts, venues, code links).
+	Comment     string
 	Authors     []string
 	Categories  []string
 	PublishedAt time.Time
@@ -28,9 +40,22 @@ type Paper struct {
 	AbsURL      string
 	PDFURL      string
 	HTMLURL     string
+	// Version is the revision number from the arXiv identifier (v1, v2, ...).
+	Version int
+	// AuthorAffiliations maps an author name to the affiliations listed in the feed.
+	AuthorAffiliations map[string][]string
 }
 
 // Fetcher retrieves papers from the arXiv API.
 type Fetcher interface {
 	Search(ctx context.Context, options SearchOptions) ([]Paper, error)
 }
+
+// HTMLFetcher retrieves a paper's arXiv HTML rendering. Fetchers that
+// implement it enable the HTML full-text path.
+type HTMLFetcher interface {
+	FetchHTML(ctx context.Context, url string) (string, error)
+}
+
+// ErrHTMLUnavailable reports that arXiv has no HTML rendering for a paper.
+var ErrHTMLUnavailable = errors.New("arxiv html rendering unavailable")
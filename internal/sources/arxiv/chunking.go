@@ -202,3 +202,9 @@ func buildChunkText(sectionTitle string, content string) string {
 	builder.WriteString(strings.TrimSpace(content))
 	return strings.TrimSpace(builder.String())
 }
+
+// ChunkPaper applies the section-aware paper chunking to content from other
+// paper sources (bioRxiv, medRxiv) so they chunk exactly like arXiv papers.
+func ChunkPaper(content string, abstract string, includeAbstractInChunks bool, cfg *config.ArxivChunkingConfig) []core.ContentChunk {
+	return chunkArxivContent(content, abstract, includeAbstractInChunks, defaultArxivChunkingConfig(cfg))
+}
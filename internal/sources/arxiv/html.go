@@ -0,0 +1,188 @@
+package arxiv
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	"golang.org/x/net/html"
+)
+
+var whitespacePattern = regexp.MustCompile(`\s+`)
+
+// skippedHTMLClasses mark LaTeXML elements that either duplicate paper
+// metadata we already have (title, authors, abstract) or are page chrome.
+var skippedHTMLClasses = []string{
+	"ltx_title_document",
+	"ltx_authors",
+	"ltx_dates",
+	"ltx_abstract",
+	"ltx_page_header",
+	"ltx_page_footer",
+	"ltx_TOC",
+	"ltx_note",
+}
+
+// htmlToMarkdown converts an arXiv HTML rendering (LaTeXML output) into
+// markdown whose headings splitSections recognises. Figure and table captions
+// are kept as paragraphs, math is kept as its LaTeX source and the
+// bibliography becomes a list. Pages that are not LaTeXML renderings (e.g. the
+// abstract page arXiv redirects to when no HTML exists) return
+// ErrHTMLUnavailable.
+func htmlToMarkdown(raw string) (string, error) {
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(raw))
+	if err != nil {
+		return "", fmt.Errorf("parse arxiv html: %w", err)
+	}
+	root := doc.Find("article.ltx_document").First()
+	if root.Length() == 0 {
+		return "", ErrHTMLUnavailable
+	}
+	var blocks []string
+	renderHTMLBlocks(root.Nodes[0], &blocks)
+	content := strings.TrimSpace(strings.Join(blocks, "\n\n"))
+	if content == "" {
+		return "", ErrHTMLUnavailable
+	}
+	return content, nil
+}
+
+func renderHTMLBlocks(n *html.Node, blocks *[]string) {
+	emit := func(text string) {
+		if text = strings.TrimSpace(text); text != "" {
+			*blocks = append(*blocks, text)
+		}
+	}
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		if c.Type != html.ElementNode || skipHTMLNode(c) {
+			continue
+		}
+		switch c.Data {
+		case "h1", "h2", "h3", "h4", "h5", "h6":
+			if text := inlineHTMLText(c); text != "" {
+				level := int(c.Data[1] - '0')
+				emit(strings.Repeat("#", level) + " " + text)
+			}
+		case "p":
+			emit(inlineHTMLText(c))
+		case "figure":
+			if caption := findHTMLChild(c, "figcaption"); caption != nil {
+				emit(inlineHTMLText(caption))
+			}
+		case "table":
+			if hasHTMLClass(c, "ltx_equation") || hasHTMLClass(c, "ltx_equationgroup") {
+				for _, math := range findHTMLAll(c, "math") {
+					if tex := htmlAttr(math, "alttext"); tex != "" {
+						emit("$$" + tex + "$$")
+					}
+				}
+			}
+			// Tabular data outside figures carries little meaning as text.
+		case "ul", "ol":
+			var items []string
+			for li := c.FirstChild; li != nil; li = li.NextSibling {
+				if li.Type == html.ElementNode && li.Data == "li" {
+					if text := inlineHTMLText(li); text != "" {
+						items = append(items, "- "+text)
+					}
+				}
+			}
+			emit(strings.Join(items, "\n"))
+		default:
+			renderHTMLBlocks(c, blocks)
+		}
+	}
+}
+
+func inlineHTMLText(n *html.Node) string {
+	var b strings.Builder
+	var walk func(*html.Node)
+	walk = func(n *html.Node) {
+		switch n.Type {
+		case html.TextNode:
+			b.WriteString(n.Data)
+			return
+		case html.ElementNode:
+			if skipHTMLNode(n) {
+				return
+			}
+			switch n.Data {
+			case "math":
+				if tex := htmlAttr(n, "alttext"); tex != "" {
+					b.WriteString(" $" + tex + "$ ")
+				}
+				return
+			case "br":
+				b.WriteString(" ")
+				return
+			}
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	walk(n)
+	text := whitespacePattern.ReplaceAllString(b.String(), " ")
+	// Math padding leaves stray spaces before punctuation.
+	for _, punct := range []string{",", ".", ";", ":", ")"} {
+		text = strings.ReplaceAll(text, "$ "+punct, "$"+punct)
+	}
+	return strings.TrimSpace(text)
+}
+
+func skipHTMLNode(n *html.Node) bool {
+	switch n.Data {
+	case "script", "style", "nav", "header", "footer", "button", "annotation", "annotation-xml":
+		return true
+	}
+	for _, class := range skippedHTMLClasses {
+		if hasHTMLClass(n, class) {
+			return true
+		}
+	}
+	return false
+}
+
+func hasHTMLClass(n *html.Node, class string) bool {
+	for _, candidate := range strings.Fields(htmlAttr(n, "class")) {
+		if candidate == class {
+			return true
+		}
+	}
+	return false
+}
+
+func htmlAttr(n *html.Node, key string) string {
+	for _, attr := range n.Attr {
+		if attr.Key == key {
+			return strings.TrimSpace(attr.Val)
+		}
+	}
+	return ""
+}
+
+func findHTMLChild(n *html.Node, tag string) *html.Node {
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		if c.Type == html.ElementNode && c.Data == tag {
+			return c
+		}
+	}
+	return nil
+}
+
+func findHTMLAll(n *html.Node, tag string) []*html.Node {
+	var found []*html.Node
+	var walk func(*html.Node)
+	walk = func(n *html.Node) {
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			if c.Type == html.ElementNode && c.Data == tag {
+				found = append(found, c)
+				continue
+			}
+			walk(c)
+		}
+	}
+	walk(n)
+	return found
+}
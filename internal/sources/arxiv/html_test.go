@@ -0,0 +1,72 @@
+package arxiv
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+const latexmlSample = `<!DOCTYPE html><html><head><title>Paper</title></head><body>
+<nav class="ltx_page_navbar">Contents</nav>
+<div class="ltx_page_main"><div class="ltx_page_content">
+<article class="ltx_document ltx_authors_1line">
+<h1 class="ltx_title ltx_title_document">A Study of Things</h1>
+<div class="ltx_authors"><span class="ltx_creator ltx_role_author">Jane Doe</span></div>
+<div class="ltx_abstract"><h6 class="ltx_title ltx_title_abstract">Abstract</h6><p class="ltx_p">Abstract text.</p></div>
+<section class="ltx_section" id="S1">
+<h2 class="ltx_title ltx_title_section"><span class="ltx_tag ltx_tag_section">1 </span>Introduction</h2>
+<div class="ltx_para"><p class="ltx_p">We study <math alttext="x^{2}" display="inline"><semantics><msup><mi>x</mi><mn>2</mn></msup><annotation encoding="application/x-tex">x^{2}</annotation></semantics></math>, carefully<span class="ltx_note ltx_role_footnote"><sup>1</sup>A footnote.</span>.</p></div>
+<table class="ltx_equation ltx_eqn_table"><tr><td><math alttext="E=mc^{2}" display="block"></math></td></tr></table>
+<figure class="ltx_figure"><img src="x1.png"/><figcaption class="ltx_caption"><span class="ltx_tag ltx_tag_figure">Figure 1: </span>Overview of the method.</figcaption></figure>
+<section class="ltx_subsection" id="S1.SS1">
+<h3 class="ltx_title ltx_title_subsection"><span class="ltx_tag ltx_tag_subsection">1.1 </span>Setup</h3>
+<div class="ltx_para"><p class="ltx_p">Details here.</p></div>
+</section>
+</section>
+<section class="ltx_bibliography" id="bib">
+<h2 class="ltx_title ltx_title_bibliography">References</h2>
+<ul class="ltx_biblist">
+<li class="ltx_bibitem" id="bib.bib1"><span class="ltx_bibblock">A. Author. Prior work. 2020.</span></li>
+</ul>
+</section>
+</article>
+</div></div>
+<footer class="ltx_page_footer">Generated by LaTeXML</footer>
+</body></html>`
+
+func TestHTMLToMarkdown_ExtractsSectionsCaptionsAndReferences(t *testing.T) {
+	content, err := htmlToMarkdown(latexmlSample)
+	if err != nil {
+		t.Fatalf("htmlToMarkdown: %v", err)
+	}
+	for _, want := range []string{
+		"## 1 Introduction",
+		"We study $x^{2}$, carefully.",
+		"$$E=mc^{2}$$",
+		"Figure 1: Overview of the method.",
+		"### 1.1 Setup",
+		"## References",
+		"- A. Author. Prior work. 2020.",
+	} {
+		if !strings.Contains(content, want) {
+			t.Fatalf("expected %q in markdown:\n%s", want, content)
+		}
+	}
+	for _, unwanted := range []string{"A Study of Things", "Jane Doe", "Abstract text.", "A footnote", "LaTeXML", "Contents"} {
+		if strings.Contains(content, unwanted) {
+			t.Fatalf("expected %q to be dropped:\n%s", unwanted, content)
+		}
+	}
+
+	sections, headingsFound := splitSections(content)
+	if !headingsFound || len(sections) != 3 || sections[0].title != "1 Introduction" {
+		t.Fatalf("expected markdown to split into sections, got %+v", sections)
+	}
+}
+
+func TestHTMLToMarkdown_NonLaTeXMLPageIsUnavailable(t *testing.T) {
+	_, err := htmlToMarkdown(`<html><body><div class="abs">Abstract page</div></body></html>`)
+	if !errors.Is(err, ErrHTMLUnavailable) {
+		t.Fatalf("expected ErrHTMLUnavailable, got %v", err)
+	}
+}
@@ -7,7 +7,9 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/bakkerme/curator-ai/internal/retry"
@@ -21,10 +23,17 @@ type Fetcher struct {
 	client    *http.Client
 	baseURL   string
 	userAgent string
+
+	// requestDelay is the minimum gap between API and HTML requests; arXiv
+	// asks clients to wait three seconds between calls.
+	requestDelay time.Duration
+	mu           sync.Mutex
+	lastRequest  time.Time
 }
 
 // NewFetcher constructs an arXiv API client with timeout and user agent controls.
-func NewFetcher(timeout time.Duration, userAgent string, baseURL string) *Fetcher {
+// requestDelay spaces out consecutive requests; values <= 0 disable the delay.
+func NewFetcher(timeout time.Duration, userAgent string, baseURL string, requestDelay time.Duration) *Fetcher {
 	if strings.TrimSpace(baseURL) == "" {
 		baseURL = defaultBaseURL
 	}
@@ -32,12 +41,35 @@ func NewFetcher(timeout time.Duration, userAgent string, baseURL string) *Fetche
 		userAgent = "curator-ai/0.1"
 	}
 	return &Fetcher{
-		client:    &http.Client{Timeout: timeout},
-		baseURL:   baseURL,
-		userAgent: userAgent,
+		client:       &http.Client{Timeout: timeout},
+		baseURL:      baseURL,
+		userAgent:    userAgent,
+		requestDelay: requestDelay,
 	}
 }
 
+// wait blocks until requestDelay has passed since the previous request.
+func (f *Fetcher) wait(ctx context.Context) error {
+	if f.requestDelay <= 0 {
+		return nil
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if !f.lastRequest.IsZero() {
+		if remaining := f.requestDelay - time.Since(f.lastRequest); remaining > 0 {
+			timer := time.NewTimer(remaining)
+			defer timer.Stop()
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-timer.C:
+			}
+		}
+	}
+	f.lastRequest = time.Now()
+	return nil
+}
+
 // Search queries arXiv and returns normalized papers based on the provided options.
 func (f *Fetcher) Search(ctx context.Context, options arxiv.SearchOptions) ([]arxiv.Paper, error) {
 	query, err := buildSearchQuery(options)
@@ -50,6 +82,9 @@ func (f *Fetcher) Search(ctx context.Context, options arxiv.SearchOptions) ([]ar
 	}
 	values := u.Query()
 	values.Set("search_query", query)
+	if options.Start > 0 {
+		values.Set("start", strconv.Itoa(options.Start))
+	}
 	if options.MaxResults > 0 {
 		values.Set("max_results", fmt.Sprintf("%d", options.MaxResults))
 	}
@@ -63,6 +98,9 @@ func (f *Fetcher) Search(ctx context.Context, options arxiv.SearchOptions) ([]ar
 
 	var payload []byte
 	err = retry.Do(ctx, retry.Config{Attempts: 3, BaseDelay: 200 * time.Millisecond}, func() error {
+		if err := f.wait(ctx); err != nil {
+			return retry.Permanent(err)
+		}
 		req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
 		if err != nil {
 			return err
@@ -105,6 +143,48 @@ func (f *Fetcher) Search(ctx context.Context, options arxiv.SearchOptions) ([]ar
 	return papers, nil
 }
 
+// FetchHTML downloads the arXiv HTML rendering at url. A 404 means the paper
+// has no HTML version and is reported as arxiv.ErrHTMLUnavailable.
+func (f *Fetcher) FetchHTML(ctx context.Context, url string) (string, error) {
+	var payload []byte
+	err := retry.Do(ctx, retry.Config{Attempts: 3, BaseDelay: 200 * time.Millisecond}, func() error {
+		if err := f.wait(ctx); err != nil {
+			return retry.Permanent(err)
+		}
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return retry.Permanent(err)
+		}
+		req.Header.Set("User-Agent", f.userAgent)
+		req.Header.Set("Accept", "text/html")
+		resp, err := f.client.Do(req)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = resp.Body.Close() }()
+		if resp.StatusCode == http.StatusNotFound {
+			return retry.Permanent(arxiv.ErrHTMLUnavailable)
+		}
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			statusErr := fmt.Errorf("arxiv html status %d", resp.StatusCode)
+			if shouldRetryStatus(resp.StatusCode) {
+				return statusErr
+			}
+			return retry.Permanent(statusErr)
+		}
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return err
+		}
+		payload = body
+		return nil
+	})
+	if err != nil {
+		return "", fmt.Errorf("arxiv html request failed: %w", err)
+	}
+	return string(payload), nil
+}
+
 func buildSearchQuery(options arxiv.SearchOptions) (string, error) {
 	var clauses []string
 	if strings.TrimSpace(options.Query) != "" {
@@ -123,7 +203,7 @@ func buildSearchQuery(options arxiv.SearchOptions) (string, error) {
 			clauses = append(clauses, "("+strings.Join(parts, " OR ")+")")
 		}
 	}
-	if dateClause := buildDateClause(options.DateFrom, options.DateTo); dateClause != "" {
+	if dateClause := buildDateClause(options); dateClause != "" {
 		clauses = append(clauses, dateClause)
 	}
 	if len(clauses) == 0 {
@@ -132,9 +212,15 @@ func buildSearchQuery(options arxiv.SearchOptions) (string, error) {
 	return strings.Join(clauses, " AND "), nil
 }
 
-func buildDateClause(dateFrom string, dateTo string) string {
-	from, fromOK := formatDateRange(dateFrom, false)
-	to, toOK := formatDateRange(dateTo, true)
+func buildDateClause(options arxiv.SearchOptions) string {
+	from, fromOK := formatDateRange(options.DateFrom, false)
+	to, toOK := formatDateRange(options.DateTo, true)
+	if !options.From.IsZero() {
+		from, fromOK = options.From.UTC().Format(arxivDateLayout), true
+	}
+	if !options.To.IsZero() {
+		to, toOK = options.To.UTC().Format(arxivDateLayout), true
+	}
 	if !fromOK && !toOK {
 		return ""
 	}
@@ -144,14 +230,24 @@ func buildDateClause(dateFrom string, dateTo string) string {
 	if !toOK {
 		to = "*"
 	}
-	return fmt.Sprintf("submittedDate:[%s TO %s]", from, to)
+	field := strings.TrimSpace(options.DateField)
+	if field == "" {
+		field = "submittedDate"
+	}
+	return fmt.Sprintf("%s:[%s TO %s]", field, from, to)
 }
 
+const arxivDateLayout = "200601021504"
+
 func formatDateRange(input string, endOfDay bool) (string, bool) {
 	input = strings.TrimSpace(input)
 	if input == "" {
 		return "", false
 	}
+	if parsed, err := time.Parse(time.RFC3339, input); err == nil {
+		// Full timestamps are already precise; no end-of-day adjustment.
+		return parsed.UTC().Format(arxivDateLayout), true
+	}
 	layouts := []string{"2006-01-02", "20060102"}
 	var parsed time.Time
 	var err error
@@ -167,7 +263,7 @@ func formatDateRange(input string, endOfDay bool) (string, bool) {
 	if endOfDay {
 		parsed = parsed.Add(23*time.Hour + 59*time.Minute)
 	}
-	return parsed.UTC().Format("200601021504"), true
+	return parsed.UTC().Format(arxivDateLayout), true
 }
 
 type feed struct {
@@ -178,6 +274,7 @@ type entry struct {
 	ID         string     `xml:"id"`
 	Title      string     `xml:"title"`
 	Summary    string     `xml:"summary"`
+	Comment    string     `xml:"http://arxiv.org/schemas/atom comment"`
 	Updated    string     `xml:"updated"`
 	Published  string     `xml:"published"`
 	Authors    []author   `xml:"author"`
@@ -186,7 +283,8 @@ type entry struct {
 }
 
 type author struct {
-	Name string `xml:"name"`
+	Name         string   `xml:"name"`
+	Affiliations []string `xml:"http://arxiv.org/schemas/atom affiliation"`
 }
 
 type category struct {
@@ -213,15 +311,26 @@ func (e entry) toPaper() arxiv.Paper {
 	rawID := strings.TrimSpace(e.ID)
 	absURL := normalizeAbsURL(rawID)
 	id := normalizeArxivID(rawID)
+	version := parseArxivVersion(rawID)
 
 	publishedAt := parseTime(e.Published)
 	updatedAt := parseTime(e.Updated)
 
 	authors := make([]string, 0, len(e.Authors))
+	var affiliations map[string][]string
 	for _, a := range e.Authors {
 		name := strings.TrimSpace(a.Name)
-		if name != "" {
-			authors = append(authors, name)
+		if name == "" {
+			continue
+		}
+		authors = append(authors, name)
+		for _, affiliation := range a.Affiliations {
+			if affiliation = strings.TrimSpace(affiliation); affiliation != "" {
+				if affiliations == nil {
+					affiliations = map[string][]string{}
+				}
+				affiliations[name] = append(affiliations[name], affiliation)
+			}
 		}
 	}
 	categories := make([]string, 0, len(e.Categories))
@@ -243,16 +352,19 @@ func (e entry) toPaper() arxiv.Paper {
 	}
 
 	return arxiv.Paper{
-		ID:          id,
-		Title:       title,
-		Abstract:    abstract,
-		Authors:     authors,
-		Categories:  categories,
-		PublishedAt: publishedAt,
-		UpdatedAt:   updatedAt,
-		AbsURL:      absURL,
-		PDFURL:      pdfURL,
-		HTMLURL:     htmlURL,
+		ID:                 id,
+		Version:            version,
+		Title:              title,
+		Abstract:           abstract,
+		Comment:            strings.TrimSpace(e.Comment),
+		Authors:            authors,
+		Categories:         categories,
+		PublishedAt:        publishedAt,
+		UpdatedAt:          updatedAt,
+		AbsURL:             absURL,
+		PDFURL:             pdfURL,
+		HTMLURL:            htmlURL,
+		AuthorAffiliations: affiliations,
 	}
 }
 
@@ -335,6 +447,21 @@ func extractArxivIdentifier(raw string) (string, bool) {
 	return id, hadCanonicalPrefix
 }
 
+// parseArxivVersion returns the revision number of a raw arXiv identifier,
+// defaulting to 1 when the identifier carries no version suffix.
+func parseArxivVersion(raw string) int {
+	id, _ := extractArxivIdentifier(strings.TrimSpace(raw))
+	stripped := stripArxivVersionSuffix(id)
+	if stripped == id {
+		return 1
+	}
+	version, err := strconv.Atoi(id[len(stripped)+1:])
+	if err != nil || version <= 0 {
+		return 1
+	}
+	return version
+}
+
 func stripArxivVersionSuffix(id string) string {
 	versionIndex := strings.LastIndexAny(id, "vV")
 	if versionIndex <= 0 || versionIndex == len(id)-1 {
@@ -2,6 +2,7 @@ package impl
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -30,14 +31,16 @@ func TestBuildSearchQuery(t *testing.T) {
 
 func TestParseFeed(t *testing.T) {
 	payload := []byte(`<?xml version="1.0" encoding="UTF-8"?>
-<feed xmlns="http://www.w3.org/2005/Atom">
+<feed xmlns="http://www.w3.org/2005/Atom" xmlns:arxiv="http://arxiv.org/schemas/atom">
   <entry>
     <id>http://arxiv.org/abs/1234.5678v2</id>
     <title>Sample Paper</title>
     <summary>Abstract text.</summary>
+    <arxiv:comment> 12 pages, code at https://github.com/example/repo </arxiv:comment>
     <published>2024-01-10T00:00:00Z</published>
     <updated>2024-01-12T00:00:00Z</updated>
-    <author><name>Jane Doe</name></author>
+    <author><name>Jane Doe</name><arxiv:affiliation>Example University</arxiv:affiliation></author>
+    <author><name>John Roe</name></author>
     <category term="cs.CL"/>
     <link href="http://arxiv.org/abs/1234.5678v2" rel="alternate" type="text/html"/>
     <link href="http://arxiv.org/pdf/1234.5678v2" rel="related" type="application/pdf"/>
@@ -61,6 +64,15 @@ func TestParseFeed(t *testing.T) {
 	if paper.HTMLURL == "" {
 		t.Fatalf("expected html url")
 	}
+	if paper.Comment != "12 pages, code at https://github.com/example/repo" {
+		t.Fatalf("expected trimmed comment, got %q", paper.Comment)
+	}
+	if got := paper.AuthorAffiliations["Jane Doe"]; len(got) != 1 || got[0] != "Example University" {
+		t.Fatalf("expected affiliation for Jane Doe, got %v", paper.AuthorAffiliations)
+	}
+	if _, ok := paper.AuthorAffiliations["John Roe"]; ok {
+		t.Fatalf("expected no affiliation for John Roe")
+	}
 }
 
 func TestParseFeedLegacyID(t *testing.T) {
@@ -222,7 +234,7 @@ func TestSearch_DoesNotRetryPermanent4xx(t *testing.T) {
 	}))
 	defer server.Close()
 
-	fetcher := NewFetcher(2*time.Second, "test-agent", server.URL)
+	fetcher := NewFetcher(2*time.Second, "test-agent", server.URL, 0)
 	_, err := fetcher.Search(context.Background(), searchOptions("bad", nil, "", ""))
 	if err == nil {
 		t.Fatalf("expected search error")
@@ -269,7 +281,7 @@ func TestSearch_RetriesTransientStatuses(t *testing.T) {
 	}))
 	defer server.Close()
 
-	fetcher := NewFetcher(2*time.Second, "test-agent", server.URL)
+	fetcher := NewFetcher(2*time.Second, "test-agent", server.URL, 0)
 	papers, err := fetcher.Search(context.Background(), searchOptions("test", nil, "", ""))
 	if err != nil {
 		t.Fatalf("expected search to succeed after retries, got %v", err)
@@ -290,3 +302,112 @@ func searchOptions(query string, categories []string, dateFrom string, dateTo st
 		DateTo:     dateTo,
 	}
 }
+
+func TestBuildSearchQuery_PreciseWindowOnUpdatedDate(t *testing.T) {
+	options := searchOptions("", []string{"cs.CL"}, "2024-01-01", "")
+	options.From = time.Date(2024, 3, 1, 6, 30, 0, 0, time.UTC)
+	options.To = time.Date(2024, 3, 2, 6, 30, 0, 0, time.UTC)
+	options.DateField = "lastUpdatedDate"
+	query, err := buildSearchQuery(options)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(query, "lastUpdatedDate:[202403010630 TO 202403020630]") {
+		t.Fatalf("expected precise window clause, got %q", query)
+	}
+}
+
+func TestParseArxivVersion(t *testing.T) {
+	cases := map[string]int{
+		"http://arxiv.org/abs/1234.5678v2":      2,
+		"http://arxiv.org/abs/1234.5678v12":     12,
+		"http://arxiv.org/abs/1234.5678":        1,
+		"http://arxiv.org/abs/hep-th/9901001v3": 3,
+	}
+	for raw, want := range cases {
+		if got := parseArxivVersion(raw); got != want {
+			t.Fatalf("parseArxivVersion(%q) = %d, want %d", raw, got, want)
+		}
+	}
+}
+
+func TestSearch_SendsStartAndSpacesRequests(t *testing.T) {
+	var starts []string
+	var times []time.Time
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		starts = append(starts, r.URL.Query().Get("start"))
+		times = append(times, time.Now())
+		_, _ = w.Write([]byte(`<feed xmlns="http://www.w3.org/2005/Atom"></feed>`))
+	}))
+	defer server.Close()
+
+	delay := 100 * time.Millisecond
+	fetcher := NewFetcher(2*time.Second, "test-agent", server.URL, delay)
+	for _, start := range []int{0, 50} {
+		options := searchOptions("test", nil, "", "")
+		options.Start = start
+		options.MaxResults = 50
+		if _, err := fetcher.Search(context.Background(), options); err != nil {
+			t.Fatalf("search failed: %v", err)
+		}
+	}
+	if len(starts) != 2 || starts[0] != "" || starts[1] != "50" {
+		t.Fatalf("unexpected start params: %v", starts)
+	}
+	if gap := times[1].Sub(times[0]); gap < delay {
+		t.Fatalf("expected requests to be spaced by at least %s, got %s", delay, gap)
+	}
+}
+
+func TestFetchHTML_SpacedAfterSearch(t *testing.T) {
+	var times []time.Time
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		times = append(times, time.Now())
+		if strings.HasPrefix(r.URL.Path, "/html/") {
+			_, _ = w.Write([]byte(`<article class="ltx_document"></article>`))
+			return
+		}
+		_, _ = w.Write([]byte(`<feed xmlns="http://www.w3.org/2005/Atom"></feed>`))
+	}))
+	defer server.Close()
+
+	delay := 100 * time.Millisecond
+	fetcher := NewFetcher(2*time.Second, "test-agent", server.URL, delay)
+	if _, err := fetcher.Search(context.Background(), searchOptions("test", nil, "", "")); err != nil {
+		t.Fatalf("search failed: %v", err)
+	}
+	if _, err := fetcher.FetchHTML(context.Background(), server.URL+"/html/1234.5678"); err != nil {
+		t.Fatalf("fetch html failed: %v", err)
+	}
+	if len(times) != 2 {
+		t.Fatalf("expected 2 requests, got %d", len(times))
+	}
+	if gap := times[1].Sub(times[0]); gap < delay {
+		t.Fatalf("expected html request to wait at least %s after search, got %s", delay, gap)
+	}
+}
+
+func TestFetchHTML_NotFoundIsUnavailable(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		if r.URL.Path == "/html/1234.5678" {
+			_, _ = w.Write([]byte(`<article class="ltx_document"></article>`))
+			return
+		}
+		http.NotFound(w, r)
+	}))
+	defer server.Close()
+
+	fetcher := NewFetcher(2*time.Second, "test-agent", server.URL, 0)
+	if _, err := fetcher.FetchHTML(context.Background(), server.URL+"/html/missing"); !errors.Is(err, arxiv.ErrHTMLUnavailable) {
+		t.Fatalf("expected ErrHTMLUnavailable, got %v", err)
+	}
+	if got := atomic.LoadInt32(&calls); got != 1 {
+		t.Fatalf("expected 404 not to be retried, got %d calls", got)
+	}
+	page, err := fetcher.FetchHTML(context.Background(), server.URL+"/html/1234.5678")
+	if err != nil || !strings.Contains(page, "ltx_document") {
+		t.Fatalf("expected html page, got %q err=%v", page, err)
+	}
+}
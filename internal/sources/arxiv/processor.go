@@ -2,8 +2,12 @@ package arxiv
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"net/url"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 
@@ -11,22 +15,30 @@ import (
 	"github.com/bakkerme/curator-ai/internal/core"
 	"github.com/bakkerme/curator-ai/internal/dedupe"
 	"github.com/bakkerme/curator-ai/internal/sources"
+	"github.com/bakkerme/curator-ai/internal/sources/enrich"
 	"github.com/bakkerme/curator-ai/internal/sources/reader"
+	"github.com/bakkerme/curator-ai/internal/sources/watchlist"
+	"github.com/bakkerme/curator-ai/internal/state"
 )
 
 // ArxivProcessor fetches papers from arXiv and emits PostBlocks with chunked content.
-// Full text is loaded from the paper PDF through the configured reader.
+// Full text is loaded from the arXiv HTML rendering when the fetcher supports it,
+// otherwise from the paper PDF through the configured reader.
 type ArxivProcessor struct {
 	name    string
 	config  config.ArxivSource
 	fetcher Fetcher
 	reader  reader.Reader
 	store   dedupe.SeenStore
-	logger  *slog.Logger
+	// stateStore persists the end of the last window for since_last_run.
+	stateStore state.Store
+	watchlist  *watchlist.Matcher
+	logger     *slog.Logger
+	now        func() time.Time
 }
 
 // NewArxivProcessor wires a new arXiv source processor.
-func NewArxivProcessor(cfg *config.ArxivSource, fetcher Fetcher, r reader.Reader, store dedupe.SeenStore, logger *slog.Logger) (*ArxivProcessor, error) {
+func NewArxivProcessor(cfg *config.ArxivSource, fetcher Fetcher, r reader.Reader, store dedupe.SeenStore, stateStore state.Store, logger *slog.Logger) (*ArxivProcessor, error) {
 	if cfg == nil {
 		return nil, fmt.Errorf("arxiv config is required")
 	}
@@ -34,12 +46,15 @@ func NewArxivProcessor(cfg *config.ArxivSource, fetcher Fetcher, r reader.Reader
 		logger = slog.Default()
 	}
 	return &ArxivProcessor{
-		name:    "arxiv",
-		config:  *cfg,
-		fetcher: fetcher,
-		reader:  r,
-		store:   store,
-		logger:  logger,
+		name:       "arxiv",
+		config:     *cfg,
+		fetcher:    fetcher,
+		reader:     r,
+		store:      store,
+		stateStore: stateStore,
+		watchlist:  watchlist.New(cfg.Watchlist),
+		logger:     logger,
+		now:        time.Now,
 	}, nil
 }
 
@@ -61,6 +76,14 @@ func (p *ArxivProcessor) Validate() error {
 	if p.reader == nil {
 		return fmt.Errorf("arxiv reader is required")
 	}
+	if p.config.FullText == "html_only" {
+		if _, ok := p.fetcher.(HTMLFetcher); !ok {
+			return fmt.Errorf("arxiv full_text html_only requires a fetcher that supports HTML")
+		}
+	}
+	if p.config.SinceLastRun && p.stateStore == nil {
+		return fmt.Errorf("arxiv since_last_run requires a state store")
+	}
 	return nil
 }
 
@@ -79,8 +102,33 @@ func (p *ArxivProcessor) Fetch(ctx context.Context) ([]*core.PostBlock, error) {
 		DateFrom:   p.config.DateFrom,
 		DateTo:     p.config.DateTo,
 	}
+	if p.config.ResurfaceRevisions {
+		options.DateField = "lastUpdatedDate"
+	}
+	var window searchWindow
+	if pinned, ok := pinnedWindow(ctx); ok {
+		options.From, options.To = pinned.from, pinned.to
+		options.DateFrom, options.DateTo = "", ""
+		logger.Info("Using pinned arXiv window", "from", pinned.from, "to", pinned.to)
+	} else if p.relativeWindow() {
+		resolved, err := p.resolveWindow(ctx)
+		if err != nil {
+			return nil, err
+		}
+		window = resolved
+		options.From, options.To = window.from, window.to
+		options.DateFrom, options.DateTo = "", ""
+		if window.key != "" && options.SortBy == "" {
+			// Oldest first lets a run capped by max_results resume where it stopped.
+			options.SortBy, options.SortOrder = "submittedDate", "ascending"
+			if options.DateField != "" {
+				options.SortBy = options.DateField
+			}
+		}
+		logger.Info("Using arXiv window", "from", window.from, "to", window.to)
+	}
 	logger.Info("Fetching papers from arXiv", "query", options.Query, "categories", options.Categories)
-	papers, err := p.fetcher.Search(ctx, options)
+	papers, err := p.search(ctx, options)
 	if err != nil {
 		return nil, err
 	}
@@ -93,23 +141,30 @@ func (p *ArxivProcessor) Fetch(ctx context.Context) ([]*core.PostBlock, error) {
 	chunking := defaultArxivChunkingConfig(p.config.Chunking)
 
 	blocks := make([]*core.PostBlock, 0, len(papers))
+	emitted := make(map[string]struct{}, len(papers))
 	for _, paper := range papers {
 		if paper.ID == "" {
 			logger.Warn("Skipping arXiv paper without ID", "title", paper.Title)
 			continue
 		}
+		key := p.dedupeKey(paper)
+		// Pages can overlap when new papers shift offsets between requests.
+		if _, ok := emitted[key]; ok {
+			continue
+		}
+		emitted[key] = struct{}{}
 		if p.store != nil {
-			seen, err := p.store.HasSeen(ctx, paper.ID)
+			seen, err := p.store.HasSeen(ctx, key)
 			if err != nil {
-				logger.Warn("Failed to check dedupe store", "paper_id", paper.ID, "error", err)
+				logger.Warn("Failed to check dedupe store", "paper_id", key, "error", err)
 			} else if seen {
-				logger.Info("Skipping already seen paper", "paper_id", paper.ID)
+				logger.Info("Skipping already seen paper", "paper_id", key)
 				continue
 			}
 		}
 
 		var chunks []core.ContentChunk
-		var content string
+		var content, fullTextSource string
 		var errors []core.ProcessError
 		if abstractOnly {
 			// Abstract-only mode ensures downstream processors receive only abstract text.
@@ -117,7 +172,7 @@ func (p *ArxivProcessor) Fetch(ctx context.Context) ([]*core.PostBlock, error) {
 			chunks = chunkArxivContent(content, paper.Abstract, false, chunking)
 			logger.Info("Using abstract-only mode for arXiv paper", "paper_id", paper.ID)
 		} else {
-			content, errors = p.fetchPaperContent(ctx, logger, paper)
+			content, fullTextSource, errors = p.fetchPaperContent(ctx, logger, paper)
 			if strings.TrimSpace(content) == "" {
 				content = paper.Abstract
 				chunks = chunkArxivContent(content, paper.Abstract, false, chunking)
@@ -133,27 +188,110 @@ func (p *ArxivProcessor) Fetch(ctx context.Context) ([]*core.PostBlock, error) {
 			Title:       paper.Title,
 			Content:     content,
 			Author:      strings.Join(paper.Authors, ", "),
+			Authors:     paperAuthors(paper),
 			CreatedAt:   paper.PublishedAt,
 			SummaryPlan: sources.SummaryPlanFromConfig(p.config.SummaryPlan),
 			Chunks:      chunks,
 			ProcessedAt: time.Now().UTC(),
 		}
+		metadata := map[string]string{"arxiv_id": paper.ID, "has_code": "false"}
+		if codeURLs := paperCodeURLs(paper); len(codeURLs) > 0 {
+			metadata["code_urls"] = strings.Join(codeURLs, " ")
+			metadata["has_code"] = "true"
+		}
+		if paper.Version > 0 {
+			metadata["arxiv_version"] = strconv.Itoa(paper.Version)
+		}
+		if fullTextSource != "" {
+			metadata["full_text_source"] = fullTextSource
+		}
+		block.Metadata = metadata
+		if p.watchlist.Apply(block) {
+			logger.Info("arXiv paper matched watchlist", "paper_id", paper.ID, "watchlist", metadata[watchlist.MetadataKey])
+		}
 		if len(errors) > 0 {
 			block.Errors = append(block.Errors, errors...)
 		}
 		blocks = append(blocks, block)
 
 		if p.store != nil {
-			if err := p.store.MarkSeen(ctx, paper.ID); err != nil {
-				logger.Warn("Failed to mark paper as seen", "paper_id", paper.ID, "error", err)
+			if err := p.store.MarkSeen(ctx, key); err != nil {
+				logger.Warn("Failed to mark paper as seen", "paper_id", key, "error", err)
 			}
 		}
 	}
 
+	if window.key != "" {
+		end, ok := windowEnd(window, options, papers)
+		if !ok {
+			logger.Warn("arXiv window hit max_results; keeping the stored window so the next run rereads it", "max_results", options.MaxResults)
+		} else if err := core.OnRunSuccess(ctx, p.name, func(ctx context.Context) error {
+			return p.saveWindow(ctx, window.key, end)
+		}); err != nil {
+			logger.Warn("Failed to save arXiv window", "error", err)
+		}
+	}
 	return blocks, nil
 }
 
-func (p *ArxivProcessor) fetchPaperContent(ctx context.Context, logger *slog.Logger, paper Paper) (string, []core.ProcessError) {
+func paperAuthors(paper Paper) []core.Author {
+	if len(paper.Authors) == 0 {
+		return nil
+	}
+	authors := make([]core.Author, 0, len(paper.Authors))
+	for _, name := range paper.Authors {
+		authors = append(authors, core.Author{Name: name, Affiliations: paper.AuthorAffiliations[name]})
+	}
+	return authors
+}
+
+// fetchPaperContent returns the paper's full text and whether it came from
+// "html" or "pdf", following the configured full_text mode.
+func (p *ArxivProcessor) fetchPaperContent(ctx context.Context, logger *slog.Logger, paper Paper) (string, string, []core.ProcessError) {
+	mode := p.config.FullText
+	if mode == "" {
+		mode = "html_first"
+	}
+	if mode != "pdf_only" {
+		content, err := p.fetchHTMLContent(ctx, paper)
+		if err == nil {
+			logger.Info("Using arXiv HTML full text", "paper_id", paper.ID, "url", paper.HTMLURL)
+			return content, "html", nil
+		}
+		if mode == "html_only" {
+			return "", "", []core.ProcessError{{
+				ProcessorName: p.name,
+				Stage:         "source",
+				Error:         fmt.Sprintf("arxiv html fetch failed: %v", err),
+				OccurredAt:    time.Now().UTC(),
+			}}
+		}
+		if errors.Is(err, ErrHTMLUnavailable) {
+			logger.Info("No arXiv HTML rendering; falling back to PDF", "paper_id", paper.ID)
+		} else {
+			logger.Warn("arXiv HTML fetch failed; falling back to PDF", "paper_id", paper.ID, "error", err)
+		}
+	}
+	content, errors := p.fetchPDFContent(ctx, logger, paper)
+	if content == "" {
+		return "", "", errors
+	}
+	return content, "pdf", errors
+}
+
+func (p *ArxivProcessor) fetchHTMLContent(ctx context.Context, paper Paper) (string, error) {
+	htmlFetcher, ok := p.fetcher.(HTMLFetcher)
+	if !ok || strings.TrimSpace(paper.HTMLURL) == "" {
+		return "", ErrHTMLUnavailable
+	}
+	raw, err := htmlFetcher.FetchHTML(ctx, paper.HTMLURL)
+	if err != nil {
+		return "", err
+	}
+	return htmlToMarkdown(raw)
+}
+
+func (p *ArxivProcessor) fetchPDFContent(ctx context.Context, logger *slog.Logger, paper Paper) (string, []core.ProcessError) {
 	var errors []core.ProcessError
 	if paper.PDFURL == "" {
 		errors = append(errors, core.ProcessError{
@@ -182,3 +320,23 @@ func (p *ArxivProcessor) fetchPaperContent(ctx context.Context, logger *slog.Log
 	}
 	return content, nil
 }
+
+var codeHosts = []string{"github.com", "gitlab.com", "bitbucket.org", "codeberg.org"}
+
+// paperCodeURLs returns repository links from the abstract and arXiv comment,
+// where authors conventionally announce their code.
+func paperCodeURLs(paper Paper) []string {
+	links, _ := enrich.ExtractLinks(paper.Abstract + "\n" + paper.Comment)
+	var urls []string
+	for _, link := range links {
+		parsed, err := url.Parse(link)
+		if err != nil {
+			continue
+		}
+		host := strings.TrimPrefix(strings.ToLower(parsed.Host), "www.")
+		if slices.Contains(codeHosts, host) && strings.Trim(parsed.Path, "/") != "" {
+			urls = append(urls, link)
+		}
+	}
+	return urls
+}
@@ -2,6 +2,7 @@ package arxiv
 
 import (
 	"context"
+	"strings"
 	"testing"
 	"time"
 
@@ -55,7 +56,7 @@ func TestArxivProcessor_AbstractOnlyUsesAbstractContent(t *testing.T) {
 		},
 	}
 
-	processor, err := NewArxivProcessor(cfg, fetcher, reader, nil, nil)
+	processor, err := NewArxivProcessor(cfg, fetcher, reader, nil, nil, nil)
 	if err != nil {
 		t.Fatalf("failed to create processor: %v", err)
 	}
@@ -97,7 +98,7 @@ func TestArxivProcessor_DefaultModeFetchesFullText(t *testing.T) {
 		},
 	}
 
-	processor, err := NewArxivProcessor(cfg, fetcher, reader, nil, nil)
+	processor, err := NewArxivProcessor(cfg, fetcher, reader, nil, nil, nil)
 	if err != nil {
 		t.Fatalf("failed to create processor: %v", err)
 	}
@@ -116,3 +117,321 @@ func TestArxivProcessor_DefaultModeFetchesFullText(t *testing.T) {
 		t.Fatalf("expected 1 PDF fetch in default mode, got %d calls", len(reader.calls))
 	}
 }
+
+type pagingFetcherMock struct {
+	papers []Paper
+	calls  []SearchOptions
+}
+
+func (m *pagingFetcherMock) Search(ctx context.Context, options SearchOptions) ([]Paper, error) {
+	m.calls = append(m.calls, options)
+	end := min(options.Start+options.MaxResults, len(m.papers))
+	if options.Start >= end {
+		return nil, nil
+	}
+	return m.papers[options.Start:end], nil
+}
+
+type stateStoreMock struct {
+	values map[string]string
+}
+
+func (m *stateStoreMock) Get(ctx context.Context, namespace, key string) (string, bool, error) {
+	value, ok := m.values[namespace+"|"+key]
+	return value, ok, nil
+}
+
+func (m *stateStoreMock) Set(ctx context.Context, namespace, key, value string) error {
+	m.values[namespace+"|"+key] = value
+	return nil
+}
+
+func (m *stateStoreMock) Close() error { return nil }
+
+type seenStoreMock struct {
+	seen map[string]bool
+}
+
+func (m *seenStoreMock) HasSeen(ctx context.Context, id string) (bool, error) { return m.seen[id], nil }
+func (m *seenStoreMock) MarkSeen(ctx context.Context, id string) error        { m.seen[id] = true; return nil }
+func (m *seenStoreMock) MarkSeenBatch(ctx context.Context, ids []string) error {
+	for _, id := range ids {
+		m.seen[id] = true
+	}
+	return nil
+}
+func (m *seenStoreMock) Close() error { return nil }
+
+func TestArxivProcessor_SinceLastRunAdvancesWindowAndPaginates(t *testing.T) {
+	abstractOnly := true
+	cfg := &config.ArxivSource{
+		Categories:   []string{"cs.CL"},
+		AbstractOnly: &abstractOnly,
+		SinceLastRun: true,
+		Lookback:     "1d",
+		MaxResults:   5,
+		PageSize:     2,
+	}
+	papers := []Paper{{ID: "1"}, {ID: "2"}, {ID: "3"}}
+	fetcher := &pagingFetcherMock{papers: papers}
+	store := &stateStoreMock{values: map[string]string{}}
+	processor, err := NewArxivProcessor(cfg, fetcher, &readerMock{}, nil, store, nil)
+	if err != nil {
+		t.Fatalf("failed to create processor: %v", err)
+	}
+	first := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	processor.now = func() time.Time { return first }
+	ctx := core.WithFlowID(context.Background(), "flow-1")
+
+	blocks, err := processor.Fetch(ctx)
+	if err != nil {
+		t.Fatalf("fetch failed: %v", err)
+	}
+	if len(blocks) != 3 {
+		t.Fatalf("expected all 3 papers across pages, got %d", len(blocks))
+	}
+	if len(fetcher.calls) != 2 || fetcher.calls[1].Start != 2 || fetcher.calls[1].MaxResults != 2 {
+		t.Fatalf("expected two pages of 2, got %+v", fetcher.calls)
+	}
+	if !fetcher.calls[0].From.Equal(first.Add(-24*time.Hour)) || !fetcher.calls[0].To.Equal(first) {
+		t.Fatalf("expected first run to use the lookback window, got %s..%s", fetcher.calls[0].From, fetcher.calls[0].To)
+	}
+
+	// A run three days later must start where the previous window ended.
+	fetcher.calls = nil
+	processor.now = func() time.Time { return first.Add(72 * time.Hour) }
+	if _, err := processor.Fetch(ctx); err != nil {
+		t.Fatalf("second fetch failed: %v", err)
+	}
+	if !fetcher.calls[0].From.Equal(first) {
+		t.Fatalf("expected window to resume at %s, got %s", first, fetcher.calls[0].From)
+	}
+}
+
+func TestArxivProcessor_SinceLastRunResumesCappedWindowAfterRunSucceeds(t *testing.T) {
+	abstractOnly := true
+	cfg := &config.ArxivSource{
+		Categories:   []string{"cs.CL"},
+		AbstractOnly: &abstractOnly,
+		SinceLastRun: true,
+		Lookback:     "2d",
+		MaxResults:   2,
+	}
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	fetcher := &pagingFetcherMock{papers: []Paper{
+		{ID: "1", PublishedAt: now.Add(-40 * time.Hour)},
+		{ID: "2", PublishedAt: now.Add(-30 * time.Hour)},
+		{ID: "3", PublishedAt: now.Add(-20 * time.Hour)},
+	}}
+	store := &stateStoreMock{values: map[string]string{}}
+	processor, err := NewArxivProcessor(cfg, fetcher, &readerMock{}, nil, store, nil)
+	if err != nil {
+		t.Fatalf("failed to create processor: %v", err)
+	}
+	processor.now = func() time.Time { return now }
+	hooks := &core.SuccessHooks{}
+	ctx := core.WithSuccessHooks(core.WithFlowID(context.Background(), "flow-1"), hooks)
+
+	if _, err := processor.Fetch(ctx); err != nil {
+		t.Fatalf("fetch failed: %v", err)
+	}
+	if got := fetcher.calls[0]; got.SortBy != "submittedDate" || got.SortOrder != "ascending" {
+		t.Fatalf("expected since_last_run to read oldest first, got %q %q", got.SortBy, got.SortOrder)
+	}
+	if len(store.values) != 0 {
+		t.Fatalf("expected the window to be saved only once the run succeeds, got %v", store.values)
+	}
+	if errs := hooks.Run(ctx); len(errs) != 0 {
+		t.Fatalf("success hooks failed: %v", errs)
+	}
+	want := now.Add(-30 * time.Hour).Format(time.RFC3339)
+	for _, value := range store.values {
+		if value != want {
+			t.Fatalf("expected a capped window to resume at the newest paper read (%s), got %s", want, value)
+		}
+	}
+	if len(store.values) != 1 {
+		t.Fatalf("expected one stored window, got %v", store.values)
+	}
+}
+
+func TestArxivProcessor_PinnedWindowOverridesConfigAndIsNotStored(t *testing.T) {
+	abstractOnly := true
+	cfg := &config.ArxivSource{
+		Categories:   []string{"cs.CL"},
+		AbstractOnly: &abstractOnly,
+		SinceLastRun: true,
+		DateFrom:     "2020-01-01",
+	}
+	fetcher := &pagingFetcherMock{papers: []Paper{{ID: "1"}}}
+	store := &stateStoreMock{values: map[string]string{}}
+	processor, err := NewArxivProcessor(cfg, fetcher, &readerMock{}, nil, store, nil)
+	if err != nil {
+		t.Fatalf("failed to create processor: %v", err)
+	}
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(7 * 24 * time.Hour)
+	ctx := WithWindow(core.WithFlowID(context.Background(), "flow-1"), from, to)
+
+	if _, err := processor.Fetch(ctx); err != nil {
+		t.Fatalf("fetch failed: %v", err)
+	}
+	if got := fetcher.calls[0]; !got.From.Equal(from) || !got.To.Equal(to) || got.DateFrom != "" {
+		t.Fatalf("expected the pinned window to replace the configured dates, got %+v", got)
+	}
+	if len(store.values) != 0 {
+		t.Fatalf("expected a pinned window not to be stored, got %v", store.values)
+	}
+}
+
+func TestArxivProcessor_ResurfaceRevisionsUsesVersionedKeys(t *testing.T) {
+	abstractOnly := true
+	cfg := &config.ArxivSource{
+		Query:              "agents",
+		AbstractOnly:       &abstractOnly,
+		ResurfaceRevisions: true,
+	}
+	fetcher := &fetcherMock{papers: []Paper{{ID: "2401.00001", Version: 2}}}
+	seen := &seenStoreMock{seen: map[string]bool{}}
+	if err := seen.MarkSeen(context.Background(), "2401.00001"); err != nil {
+		t.Fatalf("mark seen: %v", err)
+	}
+	processor, err := NewArxivProcessor(cfg, fetcher, &readerMock{}, seen, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to create processor: %v", err)
+	}
+	blocks, err := processor.Fetch(context.Background())
+	if err != nil {
+		t.Fatalf("fetch failed: %v", err)
+	}
+	if len(blocks) != 1 || blocks[0].Metadata["arxiv_version"] != "2" {
+		t.Fatalf("expected revised paper to resurface with version metadata, got %+v", blocks)
+	}
+
+	processor.config.ResurfaceRevisions = false
+	blocks, err = processor.Fetch(context.Background())
+	if err != nil {
+		t.Fatalf("fetch failed: %v", err)
+	}
+	if len(blocks) != 0 {
+		t.Fatalf("expected revision to be deduped without resurface_revisions, got %d blocks", len(blocks))
+	}
+}
+
+type htmlFetcherMock struct {
+	fetcherMock
+	pages map[string]string
+	calls []string
+}
+
+func (m *htmlFetcherMock) FetchHTML(ctx context.Context, url string) (string, error) {
+	m.calls = append(m.calls, url)
+	page, ok := m.pages[url]
+	if !ok {
+		return "", ErrHTMLUnavailable
+	}
+	return page, nil
+}
+
+func TestArxivProcessor_FullTextModes(t *testing.T) {
+	withHTML := Paper{ID: "1", Abstract: "a", HTMLURL: "https://arxiv.org/html/1", PDFURL: "https://arxiv.org/pdf/1"}
+	withoutHTML := Paper{ID: "2", Abstract: "b", HTMLURL: "https://arxiv.org/html/2", PDFURL: "https://arxiv.org/pdf/2"}
+	pdfs := map[string]string{
+		"https://arxiv.org/pdf/1": "# Introduction\npdf body one",
+		"https://arxiv.org/pdf/2": "# Introduction\npdf body two",
+	}
+
+	cases := []struct {
+		mode        string
+		wantSources []string
+		wantPDFs    int
+	}{
+		{mode: "", wantSources: []string{"html", "pdf"}, wantPDFs: 1},
+		{mode: "pdf_only", wantSources: []string{"pdf", "pdf"}, wantPDFs: 2},
+		{mode: "html_only", wantSources: []string{"html", ""}, wantPDFs: 0},
+	}
+	for _, tc := range cases {
+		t.Run(tc.mode, func(t *testing.T) {
+			fetcher := &htmlFetcherMock{
+				fetcherMock: fetcherMock{papers: []Paper{withHTML, withoutHTML}},
+				pages:       map[string]string{withHTML.HTMLURL: latexmlSample},
+			}
+			reader := &readerMock{pages: pdfs}
+			processor, err := NewArxivProcessor(&config.ArxivSource{Query: "q", FullText: tc.mode}, fetcher, reader, nil, nil, nil)
+			if err != nil {
+				t.Fatalf("failed to create processor: %v", err)
+			}
+			blocks, err := processor.Fetch(context.Background())
+			if err != nil {
+				t.Fatalf("fetch failed: %v", err)
+			}
+			for i, want := range tc.wantSources {
+				if got := blocks[i].Metadata["full_text_source"]; got != want {
+					t.Fatalf("paper %d: expected full_text_source %q, got %q", i, want, got)
+				}
+			}
+			if len(reader.calls) != tc.wantPDFs {
+				t.Fatalf("expected %d pdf reads, got %v", tc.wantPDFs, reader.calls)
+			}
+			if tc.mode == "" && !strings.Contains(blocks[0].Content, "## 1 Introduction") {
+				t.Fatalf("expected html markdown content, got %q", blocks[0].Content)
+			}
+			if tc.mode == "html_only" && (len(blocks[1].Errors) != 1 || blocks[1].Content != "b") {
+				t.Fatalf("expected html_only miss to fall back to the abstract with an error, got %+v", blocks[1])
+			}
+		})
+	}
+}
+
+func TestPaperCodeURLs_FindsRepositoryLinks(t *testing.T) {
+	paper := Paper{
+		Abstract: "We release code at https://github.com/org/repo.",
+		Comment:  "10 pages. Data: https://huggingface.co/datasets/x; mirror https://gitlab.com/org/repo",
+	}
+	urls := paperCodeURLs(paper)
+	if len(urls) != 2 || urls[0] != "https://github.com/org/repo" || urls[1] != "https://gitlab.com/org/repo" {
+		t.Fatalf("unexpected code urls: %v", urls)
+	}
+	if got := paperCodeURLs(Paper{Abstract: "See https://github.com for details"}); len(got) != 0 {
+		t.Fatalf("expected bare host links to be ignored, got %v", got)
+	}
+}
+
+func TestArxivProcessor_WatchlistFlagsMatchingPapers(t *testing.T) {
+	abstractOnly := true
+	cfg := &config.ArxivSource{
+		Query:        "q",
+		AbstractOnly: &abstractOnly,
+		Watchlist:    &config.WatchlistConfig{Affiliations: []string{"Example Lab"}, BypassQuality: true},
+	}
+	fetcher := &fetcherMock{papers: []Paper{
+		{
+			ID:                 "1",
+			Abstract:           "a",
+			Authors:            []string{"Jane Doe", "John Roe"},
+			AuthorAffiliations: map[string][]string{"John Roe": {"Example Lab, Berlin"}},
+		},
+		{ID: "2", Abstract: "b", Authors: []string{"Someone Else"}},
+	}}
+	processor, err := NewArxivProcessor(cfg, fetcher, &readerMock{}, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to create processor: %v", err)
+	}
+
+	blocks, err := processor.Fetch(context.Background())
+	if err != nil {
+		t.Fatalf("fetch failed: %v", err)
+	}
+	if len(blocks) != 2 {
+		t.Fatalf("expected 2 blocks, got %d", len(blocks))
+	}
+	if len(blocks[0].Authors) != 2 || blocks[0].Authors[1].Affiliations[0] != "Example Lab, Berlin" {
+		t.Fatalf("expected structured authors, got %+v", blocks[0].Authors)
+	}
+	if blocks[0].Metadata["watchlist"] != "Example Lab" || blocks[0].Metadata[core.MetadataQualityBypass] != "true" {
+		t.Fatalf("expected watchlist metadata, got %v", blocks[0].Metadata)
+	}
+	if _, ok := blocks[1].Metadata["watchlist"]; ok {
+		t.Fatalf("expected no watchlist match, got %v", blocks[1].Metadata)
+	}
+}
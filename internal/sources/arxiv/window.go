@@ -0,0 +1,182 @@
+package arxiv
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bakkerme/curator-ai/internal/config"
+	"github.com/bakkerme/curator-ai/internal/core"
+)
+
+const (
+	defaultLookback      = 24 * time.Hour
+	defaultPageSize      = 100
+	windowStateNamespace = "arxiv_window"
+	// arxivDefaultMaxResults is what the API returns when max_results is unset.
+	arxivDefaultMaxResults = 10
+)
+
+// searchWindow is the date range a single run asks arXiv for. key identifies
+// the flow/source pair in the state store and is empty when the window is not
+// persisted.
+type searchWindow struct {
+	from time.Time
+	to   time.Time
+	key  string
+}
+
+type pinnedWindowKey struct{}
+
+// WithWindow pins every arXiv search run under ctx to [from, to), in place of
+// the configured dates and relative window. Pinned windows are never stored,
+// so a backfill can replay history without moving since_last_run state.
+func WithWindow(ctx context.Context, from, to time.Time) context.Context {
+	return context.WithValue(ctx, pinnedWindowKey{}, searchWindow{from: from.UTC(), to: to.UTC()})
+}
+
+func pinnedWindow(ctx context.Context) (searchWindow, bool) {
+	if ctx == nil {
+		return searchWindow{}, false
+	}
+	window, ok := ctx.Value(pinnedWindowKey{}).(searchWindow)
+	return window, ok
+}
+
+func (p *ArxivProcessor) relativeWindow() bool {
+	return p.config.SinceLastRun || strings.TrimSpace(p.config.Lookback) != ""
+}
+
+// resolveWindow computes the window ending now. The lookback is the minimum
+// window (arXiv announces papers a day or more after submission, so a window
+// that only starts at the previous run would miss late arrivals); with
+// since_last_run the window stretches back further when runs were skipped.
+func (p *ArxivProcessor) resolveWindow(ctx context.Context) (searchWindow, error) {
+	lookback := defaultLookback
+	if raw := strings.TrimSpace(p.config.Lookback); raw != "" {
+		parsed, err := config.ParseDurationExtended(raw)
+		if err != nil {
+			return searchWindow{}, fmt.Errorf("arxiv lookback: %w", err)
+		}
+		lookback = parsed
+	}
+	now := p.now().UTC()
+	window := searchWindow{from: now.Add(-lookback), to: now}
+	if !p.config.SinceLastRun {
+		return window, nil
+	}
+	if p.stateStore == nil {
+		return searchWindow{}, fmt.Errorf("arxiv since_last_run requires a state store")
+	}
+
+	window.key = p.windowKey(ctx)
+	raw, ok, err := p.stateStore.Get(ctx, windowStateNamespace, window.key)
+	if err != nil {
+		return searchWindow{}, fmt.Errorf("load arxiv window: %w", err)
+	}
+	if ok {
+		lastEnd, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			return searchWindow{}, fmt.Errorf("parse stored arxiv window %q: %w", raw, err)
+		}
+		if lastEnd.Before(window.from) {
+			window.from = lastEnd.UTC()
+		}
+	}
+	return window, nil
+}
+
+// windowEnd returns how far a search read into window: its end when every
+// paper in it was returned, otherwise the newest paper date reached. The
+// newest date is only a safe place to resume when results came oldest first;
+// for other orders it returns false and the stored window stays put.
+func windowEnd(window searchWindow, options SearchOptions, papers []Paper) (time.Time, bool) {
+	limit := options.MaxResults
+	if limit <= 0 {
+		limit = arxivDefaultMaxResults
+	}
+	if len(papers) < limit {
+		return window.to, true
+	}
+	field := options.DateField
+	if field == "" {
+		field = "submittedDate"
+	}
+	if options.SortBy != field || options.SortOrder != "ascending" {
+		return time.Time{}, false
+	}
+	var newest time.Time
+	for _, paper := range papers {
+		date := paper.PublishedAt
+		if field == "lastUpdatedDate" {
+			date = paper.UpdatedAt
+		}
+		if date.After(newest) {
+			newest = date
+		}
+	}
+	if !newest.After(window.from) {
+		return time.Time{}, false
+	}
+	return newest.UTC(), true
+}
+
+func (p *ArxivProcessor) saveWindow(ctx context.Context, key string, end time.Time) error {
+	if key == "" || p.stateStore == nil {
+		return nil
+	}
+	return p.stateStore.Set(ctx, windowStateNamespace, key, end.Format(time.RFC3339))
+}
+
+// windowKey scopes stored windows to the flow and to the search itself, so
+// editing the query or categories starts a fresh window.
+func (p *ArxivProcessor) windowKey(ctx context.Context) string {
+	flowID := core.FlowIDFromContext(ctx)
+	if flowID == "" {
+		flowID = "default"
+	}
+	parts := []string{strings.TrimSpace(p.config.Query), strings.Join(p.config.Categories, ","), fmt.Sprintf("%t", p.config.ResurfaceRevisions)}
+	sum := sha256.Sum256([]byte(strings.Join(parts, "\n")))
+	return flowID + "/" + hex.EncodeToString(sum[:8])
+}
+
+// search runs options against the fetcher, paging with start offsets when
+// MaxResults is larger than a single page.
+func (p *ArxivProcessor) search(ctx context.Context, options SearchOptions) ([]Paper, error) {
+	pageSize := p.config.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	if options.MaxResults <= pageSize {
+		return p.fetcher.Search(ctx, options)
+	}
+
+	var papers []Paper
+	for start := 0; start < options.MaxResults; start += pageSize {
+		page := options
+		page.Start = start
+		page.MaxResults = min(pageSize, options.MaxResults-start)
+		results, err := p.fetcher.Search(ctx, page)
+		if err != nil {
+			return nil, fmt.Errorf("arxiv page at offset %d: %w", start, err)
+		}
+		papers = append(papers, results...)
+		if len(results) < page.MaxResults {
+			break
+		}
+	}
+	return papers, nil
+}
+
+// dedupeKey identifies a paper in the seen store. With resurface_revisions,
+// revisions after v1 get their own key so they are emitted again; v1 keeps the
+// bare ID so existing seen stores stay valid.
+func (p *ArxivProcessor) dedupeKey(paper Paper) string {
+	if p.config.ResurfaceRevisions && paper.Version > 1 {
+		return fmt.Sprintf("%sv%d", paper.ID, paper.Version)
+	}
+	return paper.ID
+}
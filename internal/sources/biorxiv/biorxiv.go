@@ -0,0 +1,49 @@
+package biorxiv
+
+import (
+	"context"
+	"time"
+)
+
+// SearchOptions selects one page of the bioRxiv/medRxiv details API.
+type SearchOptions struct {
+	// Server is "biorxiv" or "medrxiv".
+	Server string
+	From   time.Time
+	To     time.Time
+	// Category narrows results server-side (e.g. "bioinformatics").
+	Category string
+	// Cursor is the zero-based offset of the page.
+	Cursor int
+}
+
+// Page is one page of details API results.
+type Page struct {
+	Preprints []Preprint
+	// Total is the number of results across all pages.
+	Total int
+}
+
+// Preprint is a normalized details API entry.
+type Preprint struct {
+	DOI      string
+	Title    string
+	Abstract string
+	Authors  []string
+	// CorrespondingAuthor and Institution come from the corresponding author fields.
+	CorrespondingAuthor string
+	Institution         string
+	Category            string
+	Server              string
+	Version             int
+	PostedAt            time.Time
+	// PublishedDOI is the journal DOI once the preprint has been published.
+	PublishedDOI string
+	URL          string
+	PDFURL       string
+}
+
+// Fetcher retrieves preprints from the details API.
+type Fetcher interface {
+	Search(ctx context.Context, options SearchOptions) (Page, error)
+}
@@ -0,0 +1,202 @@
+package impl
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bakkerme/curator-ai/internal/retry"
+	"github.com/bakkerme/curator-ai/internal/sources/biorxiv"
+)
+
+const (
+	defaultBaseURL = "https://api.biorxiv.org"
+	dateLayout     = "2006-01-02"
+)
+
+// Fetcher implements the bioRxiv/medRxiv details API client.
+type Fetcher struct {
+	client    *http.Client
+	baseURL   string
+	userAgent string
+}
+
+// NewFetcher constructs a details API client. baseURL defaults to the public
+// API, which serves both bioRxiv and medRxiv.
+func NewFetcher(timeout time.Duration, userAgent string, baseURL string) *Fetcher {
+	if strings.TrimSpace(baseURL) == "" {
+		baseURL = defaultBaseURL
+	}
+	if strings.TrimSpace(userAgent) == "" {
+		userAgent = "curator-ai/0.1"
+	}
+	return &Fetcher{
+		client:    &http.Client{Timeout: timeout},
+		baseURL:   strings.TrimRight(baseURL, "/"),
+		userAgent: userAgent,
+	}
+}
+
+type detailsResponse struct {
+	Messages   []detailsMessage `json:"messages"`
+	Collection []detailsEntry   `json:"collection"`
+}
+
+type detailsMessage struct {
+	Status string  `json:"status"`
+	Total  flexInt `json:"total"`
+}
+
+type detailsEntry struct {
+	DOI                            string `json:"doi"`
+	Title                          string `json:"title"`
+	Authors                        string `json:"authors"`
+	AuthorCorresponding            string `json:"author_corresponding"`
+	AuthorCorrespondingInstitution string `json:"author_corresponding_institution"`
+	Date                           string `json:"date"`
+	Version                        string `json:"version"`
+	Category                       string `json:"category"`
+	Abstract                       string `json:"abstract"`
+	Published                      string `json:"published"`
+	Server                         string `json:"server"`
+}
+
+// flexInt accepts counts encoded as either JSON numbers or strings.
+type flexInt int
+
+func (n *flexInt) UnmarshalJSON(data []byte) error {
+	raw := strings.Trim(strings.TrimSpace(string(data)), `"`)
+	if raw == "" || raw == "null" {
+		*n = 0
+		return nil
+	}
+	value, err := strconv.Atoi(raw)
+	if err != nil {
+		return fmt.Errorf("parse count %q: %w", raw, err)
+	}
+	*n = flexInt(value)
+	return nil
+}
+
+// Search returns one page of preprints posted between options.From and options.To.
+func (f *Fetcher) Search(ctx context.Context, options biorxiv.SearchOptions) (biorxiv.Page, error) {
+	server := strings.ToLower(strings.TrimSpace(options.Server))
+	if server == "" {
+		server = "biorxiv"
+	}
+	if options.From.IsZero() || options.To.IsZero() {
+		return biorxiv.Page{}, fmt.Errorf("biorxiv search requires a date range")
+	}
+	endpoint := fmt.Sprintf("%s/details/%s/%s/%s/%d/json", f.baseURL, url.PathEscape(server),
+		options.From.UTC().Format(dateLayout), options.To.UTC().Format(dateLayout), options.Cursor)
+	if category := strings.TrimSpace(options.Category); category != "" {
+		endpoint += "?category=" + url.QueryEscape(category)
+	}
+
+	var payload []byte
+	err := retry.Do(ctx, retry.Config{Attempts: 3, BaseDelay: 200 * time.Millisecond}, func() error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+		if err != nil {
+			return err
+		}
+		req.Header.Set("User-Agent", f.userAgent)
+		resp, err := f.client.Do(req)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = resp.Body.Close() }()
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			statusErr := fmt.Errorf("biorxiv api status %d: %s", resp.StatusCode, readBodySnippet(resp.Body, 2048))
+			if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500 {
+				return statusErr
+			}
+			return retry.Permanent(statusErr)
+		}
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return err
+		}
+		payload = body
+		return nil
+	})
+	if err != nil {
+		return biorxiv.Page{}, fmt.Errorf("biorxiv api request failed: %w", err)
+	}
+
+	var parsed detailsResponse
+	if err := json.Unmarshal(payload, &parsed); err != nil {
+		return biorxiv.Page{}, fmt.Errorf("parse biorxiv response: %w", err)
+	}
+	page := biorxiv.Page{Preprints: make([]biorxiv.Preprint, 0, len(parsed.Collection))}
+	if len(parsed.Messages) > 0 {
+		page.Total = int(parsed.Messages[0].Total)
+	}
+	for _, entry := range parsed.Collection {
+		if preprint := entry.toPreprint(server); preprint.DOI != "" {
+			page.Preprints = append(page.Preprints, preprint)
+		}
+	}
+	return page, nil
+}
+
+func (e detailsEntry) toPreprint(server string) biorxiv.Preprint {
+	doi := strings.TrimSpace(e.DOI)
+	version, _ := strconv.Atoi(strings.TrimSpace(e.Version))
+	if entryServer := strings.ToLower(strings.TrimSpace(e.Server)); entryServer != "" {
+		server = entryServer
+	}
+	preprint := biorxiv.Preprint{
+		DOI:                 doi,
+		Title:               strings.TrimSpace(e.Title),
+		Abstract:            strings.TrimSpace(e.Abstract),
+		Authors:             splitAuthors(e.Authors),
+		CorrespondingAuthor: strings.TrimSpace(e.AuthorCorresponding),
+		Institution:         strings.TrimSpace(e.AuthorCorrespondingInstitution),
+		Category:            strings.TrimSpace(e.Category),
+		Server:              server,
+		Version:             version,
+	}
+	if posted, err := time.Parse(dateLayout, strings.TrimSpace(e.Date)); err == nil {
+		preprint.PostedAt = posted
+	}
+	if published := strings.TrimSpace(e.Published); published != "" && !strings.EqualFold(published, "NA") {
+		preprint.PublishedDOI = published
+	}
+	if doi != "" {
+		preprint.URL = fmt.Sprintf("https://www.%s.org/content/%s", server, doi)
+		if version > 0 {
+			preprint.URL += "v" + strconv.Itoa(version)
+		}
+		preprint.PDFURL = preprint.URL + ".full.pdf"
+	}
+	return preprint
+}
+
+// splitAuthors splits the API's "Family, G.; Family, G." author list.
+func splitAuthors(raw string) []string {
+	var authors []string
+	for _, name := range strings.Split(raw, ";") {
+		if name = strings.TrimSpace(name); name != "" {
+			authors = append(authors, name)
+		}
+	}
+	return authors
+}
+
+func readBodySnippet(body io.Reader, limit int64) string {
+	payload, err := io.ReadAll(io.LimitReader(body, limit))
+	if err != nil {
+		return "failed to read response body"
+	}
+	snippet := strings.TrimSpace(string(payload))
+	if snippet == "" {
+		return "empty response body"
+	}
+	return snippet
+}
@@ -0,0 +1,81 @@
+package impl
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/bakkerme/curator-ai/internal/sources/biorxiv"
+)
+
+func TestSearch_MapsDetailsResponse(t *testing.T) {
+	var gotPath, gotCategory string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotCategory = r.URL.Query().Get("category")
+		_, _ = w.Write([]byte(`{
+			"messages": [{"status": "ok", "cursor": 100, "count": 1, "total": "101"}],
+			"collection": [{
+				"doi": "10.1101/2024.01.02.573999",
+				"title": " Protein folding at scale ",
+				"authors": "Doe, J.; Roe, R. A.;",
+				"author_corresponding": "Jane Doe",
+				"author_corresponding_institution": "Example Institute",
+				"date": "2024-01-03",
+				"version": "2",
+				"category": "bioinformatics",
+				"abstract": "We fold proteins.",
+				"published": "NA",
+				"server": "bioRxiv"
+			}]
+		}`))
+	}))
+	defer server.Close()
+
+	fetcher := NewFetcher(5*time.Second, "", server.URL)
+	page, err := fetcher.Search(context.Background(), biorxiv.SearchOptions{
+		From:     time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		To:       time.Date(2024, 1, 3, 12, 0, 0, 0, time.UTC),
+		Category: "bioinformatics",
+		Cursor:   100,
+	})
+	if err != nil {
+		t.Fatalf("search failed: %v", err)
+	}
+	if gotPath != "/details/biorxiv/2024-01-01/2024-01-03/100/json" || gotCategory != "bioinformatics" {
+		t.Fatalf("unexpected request path %q category %q", gotPath, gotCategory)
+	}
+	if page.Total != 101 || len(page.Preprints) != 1 {
+		t.Fatalf("unexpected page: %+v", page)
+	}
+	preprint := page.Preprints[0]
+	if preprint.Title != "Protein folding at scale" || preprint.Version != 2 || len(preprint.Authors) != 2 {
+		t.Fatalf("unexpected preprint: %+v", preprint)
+	}
+	if preprint.PDFURL != "https://www.biorxiv.org/content/10.1101/2024.01.02.573999v2.full.pdf" {
+		t.Fatalf("unexpected pdf url %q", preprint.PDFURL)
+	}
+	if preprint.PublishedDOI != "" || !preprint.PostedAt.Equal(time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("unexpected published doi or date: %+v", preprint)
+	}
+}
+
+func TestSearch_DoesNotRetryPermanent4xx(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	fetcher := NewFetcher(5*time.Second, "", server.URL)
+	now := time.Now()
+	if _, err := fetcher.Search(context.Background(), biorxiv.SearchOptions{Server: "medrxiv", From: now, To: now}); err == nil {
+		t.Fatalf("expected error")
+	}
+	if calls != 1 {
+		t.Fatalf("expected 1 call, got %d", calls)
+	}
+}
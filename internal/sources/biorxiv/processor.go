@@ -0,0 +1,313 @@
+package biorxiv
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bakkerme/curator-ai/internal/config"
+	"github.com/bakkerme/curator-ai/internal/core"
+	"github.com/bakkerme/curator-ai/internal/dedupe"
+	"github.com/bakkerme/curator-ai/internal/sources"
+	"github.com/bakkerme/curator-ai/internal/sources/arxiv"
+	"github.com/bakkerme/curator-ai/internal/sources/reader"
+)
+
+const (
+	defaultLookback = 24 * time.Hour
+	// maxPages bounds pagination when the API keeps reporting more results.
+	maxPages = 50
+)
+
+// BiorxivProcessor fetches bioRxiv or medRxiv preprints and emits PostBlocks
+// chunked the same way as arXiv papers. Full text is read from the preprint
+// PDF through the configured reader.
+type BiorxivProcessor struct {
+	name    string
+	config  config.BiorxivSource
+	fetcher Fetcher
+	reader  reader.Reader
+	store   dedupe.SeenStore
+	logger  *slog.Logger
+	now     func() time.Time
+}
+
+// NewBiorxivProcessor wires a new bioRxiv/medRxiv source processor.
+func NewBiorxivProcessor(cfg *config.BiorxivSource, fetcher Fetcher, r reader.Reader, store dedupe.SeenStore, logger *slog.Logger) (*BiorxivProcessor, error) {
+	if cfg == nil {
+		return nil, fmt.Errorf("biorxiv config is required")
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &BiorxivProcessor{
+		name:    "biorxiv",
+		config:  *cfg,
+		fetcher: fetcher,
+		reader:  r,
+		store:   store,
+		logger:  logger,
+		now:     time.Now,
+	}, nil
+}
+
+func (p *BiorxivProcessor) Name() string {
+	return p.name
+}
+
+func (p *BiorxivProcessor) Configure(config map[string]interface{}) error {
+	return nil
+}
+
+func (p *BiorxivProcessor) Validate() error {
+	if p.fetcher == nil {
+		return fmt.Errorf("biorxiv fetcher is required")
+	}
+	if p.reader == nil && !p.abstractOnly() {
+		return fmt.Errorf("biorxiv reader is required")
+	}
+	return nil
+}
+
+func (p *BiorxivProcessor) Fetch(ctx context.Context) ([]*core.PostBlock, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	logger := core.LoggerFromContext(ctx).With("stage", "source", "processor", p.name)
+
+	from, to, err := p.window()
+	if err != nil {
+		return nil, err
+	}
+	logger.Info("Fetching preprints", "server", p.server(), "from", from, "to", to, "categories", p.config.Categories)
+	preprints, err := p.search(ctx, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	includeAbstractInChunks := true
+	if p.config.IncludeAbstractInChunks != nil {
+		includeAbstractInChunks = *p.config.IncludeAbstractInChunks
+	}
+
+	blocks := make([]*core.PostBlock, 0, len(preprints))
+	for _, preprint := range preprints {
+		if sources.Seen(ctx, p.store, logger, "preprint", preprint.DOI) {
+			continue
+		}
+
+		var chunks []core.ContentChunk
+		var content string
+		var errors []core.ProcessError
+		if p.abstractOnly() {
+			content = preprint.Abstract
+			chunks = arxiv.ChunkPaper(content, preprint.Abstract, false, p.config.Chunking)
+		} else {
+			content, errors = p.fetchFullText(ctx, logger, preprint)
+			if strings.TrimSpace(content) == "" {
+				content = preprint.Abstract
+				chunks = arxiv.ChunkPaper(content, preprint.Abstract, false, p.config.Chunking)
+			} else {
+				chunks = arxiv.ChunkPaper(content, preprint.Abstract, includeAbstractInChunks, p.config.Chunking)
+			}
+		}
+
+		block := &core.PostBlock{
+			ID:          preprint.DOI,
+			URL:         preprint.URL,
+			Title:       preprint.Title,
+			Content:     content,
+			Author:      strings.Join(preprint.Authors, "; "),
+			Authors:     preprintAuthors(preprint),
+			CreatedAt:   preprint.PostedAt,
+			SummaryPlan: sources.SummaryPlanFromConfig(p.config.SummaryPlan),
+			Chunks:      chunks,
+			ProcessedAt: time.Now().UTC(),
+			Metadata: map[string]string{
+				"doi":      preprint.DOI,
+				"server":   preprint.Server,
+				"category": preprint.Category,
+			},
+		}
+		if preprint.Version > 0 {
+			block.Metadata["preprint_version"] = strconv.Itoa(preprint.Version)
+		}
+		if preprint.PublishedDOI != "" {
+			block.Metadata["published_doi"] = preprint.PublishedDOI
+		}
+		if len(errors) > 0 {
+			block.Errors = append(block.Errors, errors...)
+		}
+		blocks = append(blocks, block)
+
+		if p.store != nil {
+			if err := p.store.MarkSeen(ctx, preprint.DOI); err != nil {
+				logger.Warn("Failed to mark preprint as seen", "doi", preprint.DOI, "error", err)
+			}
+		}
+	}
+	return blocks, nil
+}
+
+// search pages through the details API, keeps the newest version of each DOI
+// and applies the category filter. Paging stops once max_results preprints
+// are kept, so a newer version of a kept DOI on a later page is not seen.
+func (p *BiorxivProcessor) search(ctx context.Context, from, to time.Time) ([]Preprint, error) {
+	options := SearchOptions{Server: p.server(), From: from, To: to}
+	if len(p.config.Categories) == 1 {
+		options.Category = p.config.Categories[0]
+	}
+
+	var preprints []Preprint
+	index := make(map[string]int)
+	for page := 0; page < maxPages; page++ {
+		result, err := p.fetcher.Search(ctx, options)
+		if err != nil {
+			return nil, err
+		}
+		for _, preprint := range result.Preprints {
+			if !p.matchesCategory(preprint.Category) {
+				continue
+			}
+			if i, ok := index[preprint.DOI]; ok {
+				if preprint.Version > preprints[i].Version {
+					preprints[i] = preprint
+				}
+				continue
+			}
+			if p.maxResultsReached(len(preprints)) {
+				continue
+			}
+			index[preprint.DOI] = len(preprints)
+			preprints = append(preprints, preprint)
+		}
+		options.Cursor += len(result.Preprints)
+		if len(result.Preprints) == 0 || options.Cursor >= result.Total || p.maxResultsReached(len(preprints)) {
+			break
+		}
+	}
+	return preprints, nil
+}
+
+func (p *BiorxivProcessor) maxResultsReached(kept int) bool {
+	return p.config.MaxResults > 0 && kept >= p.config.MaxResults
+}
+
+func (p *BiorxivProcessor) window() (time.Time, time.Time, error) {
+	now := p.now().UTC()
+	if p.config.DateFrom != "" || p.config.DateTo != "" {
+		from, to := now.Add(-defaultLookback), now
+		if p.config.DateFrom != "" {
+			parsed, err := time.Parse("2006-01-02", p.config.DateFrom)
+			if err != nil {
+				return time.Time{}, time.Time{}, fmt.Errorf("parse biorxiv date_from: %w", err)
+			}
+			from = parsed
+		}
+		if p.config.DateTo != "" {
+			parsed, err := time.Parse("2006-01-02", p.config.DateTo)
+			if err != nil {
+				return time.Time{}, time.Time{}, fmt.Errorf("parse biorxiv date_to: %w", err)
+			}
+			to = parsed
+		}
+		return from, to, nil
+	}
+	lookback := defaultLookback
+	if p.config.Lookback != "" {
+		parsed, err := config.ParseDurationExtended(p.config.Lookback)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("parse biorxiv lookback: %w", err)
+		}
+		lookback = parsed
+	}
+	return now.Add(-lookback), now, nil
+}
+
+func (p *BiorxivProcessor) fetchFullText(ctx context.Context, logger *slog.Logger, preprint Preprint) (string, []core.ProcessError) {
+	if preprint.PDFURL == "" {
+		return "", []core.ProcessError{{
+			ProcessorName: p.name,
+			Stage:         "source",
+			Error:         "biorxiv pdf url missing; unable to fetch full text",
+			OccurredAt:    time.Now().UTC(),
+		}}
+	}
+	logger.Info("Fetching preprint PDF via reader", "doi", preprint.DOI, "url", preprint.PDFURL)
+	content, err := p.reader.Read(ctx, preprint.PDFURL)
+	if err != nil || strings.TrimSpace(content) == "" {
+		msg := "biorxiv pdf fetch failed"
+		if err != nil {
+			msg = fmt.Sprintf("biorxiv pdf fetch failed: %v", err)
+		}
+		logger.Error("Failed to fetch full text content; using abstract only", "doi", preprint.DOI)
+		return "", []core.ProcessError{{
+			ProcessorName: p.name,
+			Stage:         "source",
+			Error:         msg,
+			OccurredAt:    time.Now().UTC(),
+		}}
+	}
+	return content, nil
+}
+
+func (p *BiorxivProcessor) server() string {
+	if server := strings.ToLower(strings.TrimSpace(p.config.Server)); server != "" {
+		return server
+	}
+	return "biorxiv"
+}
+
+func (p *BiorxivProcessor) abstractOnly() bool {
+	return p.config.AbstractOnly != nil && *p.config.AbstractOnly
+}
+
+// matchesCategory compares categories loosely: the API reports
+// "cell biology" while users often write "cell_biology".
+func (p *BiorxivProcessor) matchesCategory(category string) bool {
+	if len(p.config.Categories) == 0 {
+		return true
+	}
+	normalized := normalizeCategory(category)
+	for _, want := range p.config.Categories {
+		if normalizeCategory(want) == normalized {
+			return true
+		}
+	}
+	return false
+}
+
+func normalizeCategory(category string) string {
+	return strings.Join(strings.Fields(strings.ReplaceAll(strings.ToLower(category), "_", " ")), " ")
+}
+
+// preprintAuthors attaches the corresponding author's institution, the only
+// affiliation the API reports. Authors are listed as "Family, G." while the
+// corresponding author is "Given Family", so they are matched by family name.
+func preprintAuthors(preprint Preprint) []core.Author {
+	corresponding := familyName(preprint.CorrespondingAuthor)
+	authors := make([]core.Author, 0, len(preprint.Authors))
+	for _, name := range preprint.Authors {
+		author := core.Author{Name: name}
+		if preprint.Institution != "" && corresponding != "" && familyName(name) == corresponding {
+			author.Affiliations = []string{preprint.Institution}
+			corresponding = ""
+		}
+		authors = append(authors, author)
+	}
+	return authors
+}
+
+func familyName(name string) string {
+	if family, _, ok := strings.Cut(name, ","); ok {
+		return strings.ToLower(strings.TrimSpace(family))
+	}
+	fields := strings.Fields(name)
+	if len(fields) == 0 {
+		return ""
+	}
+	return strings.ToLower(fields[len(fields)-1])
+}
@@ -0,0 +1,148 @@
+package biorxiv
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bakkerme/curator-ai/internal/config"
+	"github.com/bakkerme/curator-ai/internal/core"
+)
+
+type fetcherMock struct {
+	pages   [][]Preprint
+	total   int
+	options []SearchOptions
+}
+
+func (m *fetcherMock) Search(ctx context.Context, options SearchOptions) (Page, error) {
+	_ = ctx
+	m.options = append(m.options, options)
+	index := len(m.options) - 1
+	if index >= len(m.pages) {
+		return Page{Total: m.total}, nil
+	}
+	return Page{Preprints: m.pages[index], Total: m.total}, nil
+}
+
+type readerMock struct {
+	pages map[string]string
+	calls []string
+}
+
+func (m *readerMock) Read(ctx context.Context, url string) (string, error) {
+	_ = ctx
+	m.calls = append(m.calls, url)
+	return m.pages[url], nil
+}
+
+func TestBiorxivProcessor_PaginatesFiltersAndChunks(t *testing.T) {
+	fetcher := &fetcherMock{
+		total: 3,
+		pages: [][]Preprint{
+			{
+				{DOI: "10.1101/a", Version: 1, Title: "A", Abstract: "Abstract A.", Category: "cell biology", Authors: []string{"Doe, J.", "Roe, R."}, CorrespondingAuthor: "Jane Doe", Institution: "Example Institute", PDFURL: "https://pdf/a"},
+				{DOI: "10.1101/b", Version: 1, Title: "B", Abstract: "Abstract B.", Category: "neuroscience"},
+			},
+			{
+				{DOI: "10.1101/a", Version: 2, Title: "A v2", Abstract: "Abstract A.", Category: "cell biology", PDFURL: "https://pdf/a2"},
+			},
+		},
+	}
+	reader := &readerMock{pages: map[string]string{
+		"https://pdf/a2": "1 Introduction\n" + strings.Repeat("Cells divide. ", 40) + "\n2 Results\n" + strings.Repeat("They grew. ", 40),
+	}}
+	cfg := &config.BiorxivSource{
+		Categories:  []string{"cell_biology"},
+		Lookback:    "2d",
+		SummaryPlan: &config.SummaryPlanConfig{Mode: core.SummaryModeMapReduce},
+	}
+	processor, err := NewBiorxivProcessor(cfg, fetcher, reader, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to create processor: %v", err)
+	}
+	now := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+	processor.now = func() time.Time { return now }
+
+	blocks, err := processor.Fetch(context.Background())
+	if err != nil {
+		t.Fatalf("fetch failed: %v", err)
+	}
+	if len(fetcher.options) != 2 || fetcher.options[1].Cursor != 2 {
+		t.Fatalf("expected two paged requests, got %+v", fetcher.options)
+	}
+	if fetcher.options[0].Category != "cell_biology" || fetcher.options[0].Server != "biorxiv" {
+		t.Fatalf("unexpected search options %+v", fetcher.options[0])
+	}
+	if !fetcher.options[0].From.Equal(now.Add(-48 * time.Hour)) {
+		t.Fatalf("unexpected window start %v", fetcher.options[0].From)
+	}
+	if len(blocks) != 1 {
+		t.Fatalf("expected 1 block after category filter and version dedupe, got %d", len(blocks))
+	}
+	block := blocks[0]
+	if block.Title != "A v2" || block.Metadata["preprint_version"] != "2" || block.Metadata["doi"] != "10.1101/a" {
+		t.Fatalf("expected latest version, got %+v", block)
+	}
+	if len(block.Chunks) != 3 || !strings.HasPrefix(block.Chunks[0].Content, "Abstract") {
+		t.Fatalf("expected abstract plus two section chunks, got %d", len(block.Chunks))
+	}
+	if block.SummaryPlan == nil || block.SummaryPlan.Mode != core.SummaryModeMapReduce {
+		t.Fatalf("expected map-reduce summary plan, got %+v", block.SummaryPlan)
+	}
+}
+
+func TestBiorxivProcessor_StopsPagingAtMaxResults(t *testing.T) {
+	fetcher := &fetcherMock{
+		total: 300,
+		pages: [][]Preprint{
+			{{DOI: "10.1101/a", Version: 1, Title: "A"}, {DOI: "10.1101/b", Version: 1, Title: "B"}},
+			{{DOI: "10.1101/c", Version: 1, Title: "C"}},
+		},
+	}
+	abstractOnly := true
+	processor, err := NewBiorxivProcessor(&config.BiorxivSource{MaxResults: 2, AbstractOnly: &abstractOnly}, fetcher, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to create processor: %v", err)
+	}
+
+	blocks, err := processor.Fetch(context.Background())
+	if err != nil {
+		t.Fatalf("fetch failed: %v", err)
+	}
+	if len(blocks) != 2 || len(fetcher.options) != 1 {
+		t.Fatalf("expected 2 blocks from a single request, got %d blocks from %d requests", len(blocks), len(fetcher.options))
+	}
+}
+
+func TestBiorxivProcessor_AbstractOnlySkipsReader(t *testing.T) {
+	abstractOnly := true
+	fetcher := &fetcherMock{total: 1, pages: [][]Preprint{{{DOI: "10.1101/a", Abstract: "Only the abstract.", PDFURL: "https://pdf/a"}}}}
+	processor, err := NewBiorxivProcessor(&config.BiorxivSource{Server: "medrxiv", AbstractOnly: &abstractOnly, DateFrom: "2024-01-01", DateTo: "2024-01-02"}, fetcher, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to create processor: %v", err)
+	}
+
+	blocks, err := processor.Fetch(context.Background())
+	if err != nil {
+		t.Fatalf("fetch failed: %v", err)
+	}
+	if len(blocks) != 1 || blocks[0].Content != "Only the abstract." {
+		t.Fatalf("expected abstract-only block, got %+v", blocks)
+	}
+	if fetcher.options[0].Server != "medrxiv" || fetcher.options[0].From.Format("2006-01-02") != "2024-01-01" {
+		t.Fatalf("unexpected search options %+v", fetcher.options[0])
+	}
+}
+
+func TestPreprintAuthors_AttachesCorrespondingInstitution(t *testing.T) {
+	authors := preprintAuthors(Preprint{
+		Authors:             []string{"Roe, R.", "Doe, J."},
+		CorrespondingAuthor: "Jane Doe",
+		Institution:         "Example Institute",
+	})
+	if len(authors[0].Affiliations) != 0 || len(authors[1].Affiliations) != 1 {
+		t.Fatalf("unexpected affiliations %+v", authors)
+	}
+}
@@ -0,0 +1,83 @@
+package bluesky
+
+import (
+	"context"
+	"time"
+)
+
+// FeedKind selects which feed to read.
+type FeedKind string
+
+const (
+	FeedAuthor    FeedKind = "author"
+	FeedGenerator FeedKind = "feed"
+	FeedSearch    FeedKind = "search"
+)
+
+// FeedRequest reads one author feed, feed generator or post search.
+type FeedRequest struct {
+	Kind FeedKind
+	// Value is the actor handle or DID, the feed generator at:// URI, or the
+	// search query.
+	Value string
+	Limit int
+	// Sort applies to searches: "latest" or "top".
+	Sort string
+}
+
+// Author is a post author.
+type Author struct {
+	DID         string
+	Handle      string
+	DisplayName string
+}
+
+// Image is an embedded image.
+type Image struct {
+	URL string
+	Alt string
+}
+
+// External is an embedded link card.
+type External struct {
+	URL         string
+	Title       string
+	Description string
+}
+
+// Post is a normalized Bluesky post view.
+type Post struct {
+	URI       string
+	URL       string
+	CreatedAt time.Time
+	Text      string
+	Langs     []string
+	Author    Author
+	// ParentURI and RootURI are set when the post is a reply.
+	ParentURI   string
+	RootURI     string
+	ReplyCount  int
+	RepostCount int
+	LikeCount   int
+	QuoteCount  int
+	Images      []Image
+	External    *External
+	// Links are link facet targets in the post text.
+	Links []string
+	// RepostedBy is the reposting account's handle when the post reached a
+	// feed through a repost.
+	RepostedBy string
+}
+
+// Thread holds the posts around a post: ancestors oldest first, and every
+// descendant with ParentURI set.
+type Thread struct {
+	Ancestors   []Post
+	Descendants []Post
+}
+
+// Client reads the public AppView XRPC API.
+type Client interface {
+	Feed(ctx context.Context, request FeedRequest) ([]Post, error)
+	Thread(ctx context.Context, uri string) (Thread, error)
+}
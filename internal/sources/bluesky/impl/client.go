@@ -0,0 +1,301 @@
+package impl
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bakkerme/curator-ai/internal/retry"
+	"github.com/bakkerme/curator-ai/internal/sources/bluesky"
+)
+
+const (
+	defaultBaseURL = "https://public.api.bsky.app"
+	// maxLimit is the largest page size the feed endpoints accept.
+	maxLimit          = 100
+	threadDepth       = 10
+	threadParentDepth = 80
+)
+
+// Client calls the public Bluesky AppView XRPC API.
+type Client struct {
+	client    *http.Client
+	baseURL   string
+	userAgent string
+}
+
+// NewClient builds an AppView client for baseURL (default: https://public.api.bsky.app).
+func NewClient(timeout time.Duration, baseURL string, userAgent string) *Client {
+	if timeout <= 0 {
+		timeout = 15 * time.Second
+	}
+	if strings.TrimSpace(baseURL) == "" {
+		baseURL = defaultBaseURL
+	}
+	if strings.TrimSpace(userAgent) == "" {
+		userAgent = "curator-ai/0.1"
+	}
+	return &Client{
+		client:    &http.Client{Timeout: timeout},
+		baseURL:   strings.TrimRight(baseURL, "/"),
+		userAgent: userAgent,
+	}
+}
+
+type apiProfile struct {
+	DID         string `json:"did"`
+	Handle      string `json:"handle"`
+	DisplayName string `json:"displayName"`
+}
+
+type apiEmbed struct {
+	Type   string `json:"$type"`
+	Images []struct {
+		Fullsize string `json:"fullsize"`
+		Alt      string `json:"alt"`
+	} `json:"images"`
+	External *struct {
+		URI         string `json:"uri"`
+		Title       string `json:"title"`
+		Description string `json:"description"`
+	} `json:"external"`
+	// Media is set on recordWithMedia embeds (a quote post with media).
+	Media *apiEmbed `json:"media"`
+}
+
+type apiPost struct {
+	URI    string     `json:"uri"`
+	Author apiProfile `json:"author"`
+	Record struct {
+		Text      string   `json:"text"`
+		CreatedAt string   `json:"createdAt"`
+		Langs     []string `json:"langs"`
+		Reply     *struct {
+			Root struct {
+				URI string `json:"uri"`
+			} `json:"root"`
+			Parent struct {
+				URI string `json:"uri"`
+			} `json:"parent"`
+		} `json:"reply"`
+		Facets []struct {
+			Features []struct {
+				Type string `json:"$type"`
+				URI  string `json:"uri"`
+			} `json:"features"`
+		} `json:"facets"`
+	} `json:"record"`
+	Embed       *apiEmbed `json:"embed"`
+	ReplyCount  int       `json:"replyCount"`
+	RepostCount int       `json:"repostCount"`
+	LikeCount   int       `json:"likeCount"`
+	QuoteCount  int       `json:"quoteCount"`
+	IndexedAt   string    `json:"indexedAt"`
+}
+
+type feedResponse struct {
+	Feed []struct {
+		Post   apiPost `json:"post"`
+		Reason *struct {
+			Type string     `json:"$type"`
+			By   apiProfile `json:"by"`
+		} `json:"reason"`
+	} `json:"feed"`
+}
+
+type searchResponse struct {
+	Posts []apiPost `json:"posts"`
+}
+
+// threadNode is a thread view; blocked and deleted posts have no Post.
+type threadNode struct {
+	Post    *apiPost     `json:"post"`
+	Parent  *threadNode  `json:"parent"`
+	Replies []threadNode `json:"replies"`
+}
+
+// Feed reads an author feed, a feed generator or a post search, newest first.
+func (c *Client) Feed(ctx context.Context, request bluesky.FeedRequest) ([]bluesky.Post, error) {
+	value := strings.TrimSpace(request.Value)
+	if value == "" {
+		return nil, fmt.Errorf("bluesky %s feed requires a value", request.Kind)
+	}
+	values := url.Values{}
+	if request.Limit > 0 {
+		values.Set("limit", strconv.Itoa(min(request.Limit, maxLimit)))
+	}
+
+	switch request.Kind {
+	case bluesky.FeedAuthor, bluesky.FeedGenerator:
+		method := "app.bsky.feed.getFeed"
+		if request.Kind == bluesky.FeedAuthor {
+			method = "app.bsky.feed.getAuthorFeed"
+			values.Set("actor", strings.TrimPrefix(value, "@"))
+			values.Set("filter", "posts_and_author_threads")
+		} else {
+			values.Set("feed", value)
+		}
+		var resp feedResponse
+		if err := c.getJSON(ctx, method, values, &resp); err != nil {
+			return nil, fmt.Errorf("bluesky %s feed %s: %w", request.Kind, value, err)
+		}
+		posts := make([]bluesky.Post, 0, len(resp.Feed))
+		for _, item := range resp.Feed {
+			post := item.Post.toPost()
+			if item.Reason != nil && strings.HasSuffix(item.Reason.Type, "#reasonRepost") {
+				post.RepostedBy = item.Reason.By.Handle
+			}
+			posts = append(posts, post)
+		}
+		return posts, nil
+	case bluesky.FeedSearch:
+		values.Set("q", value)
+		if request.Sort != "" {
+			values.Set("sort", request.Sort)
+		}
+		var resp searchResponse
+		if err := c.getJSON(ctx, "app.bsky.feed.searchPosts", values, &resp); err != nil {
+			return nil, fmt.Errorf("bluesky search %q: %w", value, err)
+		}
+		posts := make([]bluesky.Post, 0, len(resp.Posts))
+		for _, post := range resp.Posts {
+			posts = append(posts, post.toPost())
+		}
+		return posts, nil
+	default:
+		return nil, fmt.Errorf("unsupported bluesky feed %q", request.Kind)
+	}
+}
+
+// Thread returns the ancestors and descendants of the post at uri.
+func (c *Client) Thread(ctx context.Context, uri string) (bluesky.Thread, error) {
+	values := url.Values{
+		"uri":          {uri},
+		"depth":        {strconv.Itoa(threadDepth)},
+		"parentHeight": {strconv.Itoa(threadParentDepth)},
+	}
+	var resp struct {
+		Thread threadNode `json:"thread"`
+	}
+	if err := c.getJSON(ctx, "app.bsky.feed.getPostThread", values, &resp); err != nil {
+		return bluesky.Thread{}, fmt.Errorf("bluesky thread %s: %w", uri, err)
+	}
+
+	var thread bluesky.Thread
+	for parent := resp.Thread.Parent; parent != nil && parent.Post != nil; parent = parent.Parent {
+		thread.Ancestors = append([]bluesky.Post{parent.Post.toPost()}, thread.Ancestors...)
+	}
+	var walk func([]threadNode)
+	walk = func(nodes []threadNode) {
+		for _, node := range nodes {
+			if node.Post == nil {
+				continue
+			}
+			thread.Descendants = append(thread.Descendants, node.Post.toPost())
+			walk(node.Replies)
+		}
+	}
+	walk(resp.Thread.Replies)
+	return thread, nil
+}
+
+func (c *Client) getJSON(ctx context.Context, method string, values url.Values, out interface{}) error {
+	target := c.baseURL + "/xrpc/" + method + "?" + values.Encode()
+	return retry.Do(ctx, retry.Config{Attempts: 3, BaseDelay: 200 * time.Millisecond}, func() error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+		if err != nil {
+			return retry.Permanent(err)
+		}
+		req.Header.Set("User-Agent", c.userAgent)
+		req.Header.Set("Accept", "application/json")
+		resp, err := c.client.Do(req)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = resp.Body.Close() }()
+		if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError {
+			return fmt.Errorf("transient status %s", resp.Status)
+		}
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+			return retry.Permanent(fmt.Errorf("status %s: %s", resp.Status, strings.TrimSpace(string(body))))
+		}
+		return json.NewDecoder(resp.Body).Decode(out)
+	})
+}
+
+func (p apiPost) toPost() bluesky.Post {
+	post := bluesky.Post{
+		URI:  p.URI,
+		URL:  postURL(p.URI, p.Author),
+		Text: strings.TrimSpace(p.Record.Text),
+		Author: bluesky.Author{
+			DID:         p.Author.DID,
+			Handle:      p.Author.Handle,
+			DisplayName: strings.TrimSpace(p.Author.DisplayName),
+		},
+		Langs:       p.Record.Langs,
+		ReplyCount:  p.ReplyCount,
+		RepostCount: p.RepostCount,
+		LikeCount:   p.LikeCount,
+		QuoteCount:  p.QuoteCount,
+	}
+	if created, err := time.Parse(time.RFC3339, p.Record.CreatedAt); err == nil {
+		post.CreatedAt = created
+	} else if indexed, err := time.Parse(time.RFC3339, p.IndexedAt); err == nil {
+		post.CreatedAt = indexed
+	}
+	if p.Record.Reply != nil {
+		post.ParentURI = p.Record.Reply.Parent.URI
+		post.RootURI = p.Record.Reply.Root.URI
+	}
+	for _, facet := range p.Record.Facets {
+		for _, feature := range facet.Features {
+			if strings.HasSuffix(feature.Type, "#link") && feature.URI != "" {
+				post.Links = append(post.Links, feature.URI)
+			}
+		}
+	}
+	embed := p.Embed
+	if embed != nil && embed.Media != nil {
+		embed = embed.Media
+	}
+	if embed != nil {
+		for _, image := range embed.Images {
+			if image.Fullsize != "" {
+				post.Images = append(post.Images, bluesky.Image{URL: image.Fullsize, Alt: strings.TrimSpace(image.Alt)})
+			}
+		}
+		if embed.External != nil && strings.TrimSpace(embed.External.URI) != "" {
+			post.External = &bluesky.External{
+				URL:         strings.TrimSpace(embed.External.URI),
+				Title:       strings.TrimSpace(embed.External.Title),
+				Description: strings.TrimSpace(embed.External.Description),
+			}
+		}
+	}
+	return post
+}
+
+// postURL turns at://did/app.bsky.feed.post/rkey into the bsky.app web URL.
+func postURL(uri string, author apiProfile) string {
+	rest, ok := strings.CutPrefix(uri, "at://")
+	if !ok {
+		return ""
+	}
+	parts := strings.Split(rest, "/")
+	if len(parts) != 3 || parts[1] != "app.bsky.feed.post" {
+		return ""
+	}
+	profile := author.Handle
+	if profile == "" || profile == "handle.invalid" {
+		profile = parts[0]
+	}
+	return "https://bsky.app/profile/" + profile + "/post/" + parts[2]
+}
@@ -0,0 +1,116 @@
+package impl
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/bakkerme/curator-ai/internal/sources/bluesky"
+)
+
+const authorFeedFixture = `{"feed": [{
+	"post": {
+		"uri": "at://did:plc:alice/app.bsky.feed.post/3kabc",
+		"author": {"did": "did:plc:alice", "handle": "alice.bsky.social", "displayName": "Alice "},
+		"record": {
+			"text": "New paper on sparse attention",
+			"createdAt": "2024-05-01T09:00:00.000Z",
+			"langs": ["en"],
+			"reply": {"root": {"uri": "at://did:plc:alice/app.bsky.feed.post/3kroot"}, "parent": {"uri": "at://did:plc:alice/app.bsky.feed.post/3kroot"}},
+			"facets": [{"features": [{"$type": "app.bsky.richtext.facet#link", "uri": "https://github.com/alice/sparse"}]}]
+		},
+		"embed": {
+			"$type": "app.bsky.embed.recordWithMedia#view",
+			"media": {"$type": "app.bsky.embed.images#view", "images": [{"fullsize": "https://cdn.example/fig.jpg", "alt": " Figure 2 "}]}
+		},
+		"replyCount": 3, "repostCount": 7, "likeCount": 42, "quoteCount": 1
+	},
+	"reason": {"$type": "app.bsky.feed.defs#reasonRepost", "by": {"did": "did:plc:bob", "handle": "bob.bsky.social"}}
+}]}`
+
+func TestFeed_AuthorFeedMapsPosts(t *testing.T) {
+	var gotPath, gotActor, gotLimit string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath, gotActor, gotLimit = r.URL.Path, r.URL.Query().Get("actor"), r.URL.Query().Get("limit")
+		_, _ = w.Write([]byte(authorFeedFixture))
+	}))
+	defer server.Close()
+
+	client := NewClient(5*time.Second, server.URL, "")
+	posts, err := client.Feed(context.Background(), bluesky.FeedRequest{Kind: bluesky.FeedAuthor, Value: "@alice.bsky.social", Limit: 500})
+	if err != nil {
+		t.Fatalf("feed failed: %v", err)
+	}
+	if gotPath != "/xrpc/app.bsky.feed.getAuthorFeed" || gotActor != "alice.bsky.social" || gotLimit != "100" {
+		t.Fatalf("unexpected request path %q actor %q limit %q", gotPath, gotActor, gotLimit)
+	}
+	if len(posts) != 1 {
+		t.Fatalf("expected one post, got %d", len(posts))
+	}
+	post := posts[0]
+	if post.URL != "https://bsky.app/profile/alice.bsky.social/post/3kabc" || post.Author.DisplayName != "Alice" {
+		t.Fatalf("unexpected post %+v", post)
+	}
+	if post.ParentURI != "at://did:plc:alice/app.bsky.feed.post/3kroot" || post.RepostedBy != "bob.bsky.social" {
+		t.Fatalf("unexpected reply or repost fields %+v", post)
+	}
+	if post.LikeCount != 42 || post.RepostCount != 7 || post.QuoteCount != 1 || post.CreatedAt.Hour() != 9 {
+		t.Fatalf("unexpected counts %+v", post)
+	}
+	if len(post.Images) != 1 || post.Images[0].Alt != "Figure 2" || len(post.Links) != 1 {
+		t.Fatalf("unexpected embeds %+v", post)
+	}
+}
+
+func TestFeed_SearchPassesSort(t *testing.T) {
+	var gotPath, gotQuery, gotSort string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath, gotQuery, gotSort = r.URL.Path, r.URL.Query().Get("q"), r.URL.Query().Get("sort")
+		_, _ = w.Write([]byte(`{"posts": [{"uri": "at://did:plc:a/app.bsky.feed.post/1", "author": {"handle": "a.test"},
+			"record": {"text": "hi"}, "embed": {"$type": "app.bsky.embed.external#view",
+			"external": {"uri": "https://example.com", "title": "Example", "description": "Desc"}}}]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(5*time.Second, server.URL+"/", "")
+	posts, err := client.Feed(context.Background(), bluesky.FeedRequest{Kind: bluesky.FeedSearch, Value: "llm eval", Sort: "top"})
+	if err != nil {
+		t.Fatalf("search failed: %v", err)
+	}
+	if gotPath != "/xrpc/app.bsky.feed.searchPosts" || gotQuery != "llm eval" || gotSort != "top" {
+		t.Fatalf("unexpected request path %q q %q sort %q", gotPath, gotQuery, gotSort)
+	}
+	if len(posts) != 1 || posts[0].External == nil || posts[0].External.Title != "Example" {
+		t.Fatalf("expected external card, got %+v", posts)
+	}
+}
+
+func TestThread_FlattensParentsAndReplies(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"thread": {
+			"post": {"uri": "at://a/app.bsky.feed.post/2", "record": {"text": "two"}},
+			"parent": {"post": {"uri": "at://a/app.bsky.feed.post/1", "record": {"text": "one"}},
+				"parent": {"uri": "at://gone", "notFound": true}},
+			"replies": [
+				{"post": {"uri": "at://b/app.bsky.feed.post/3", "record": {"text": "three"}},
+					"replies": [{"post": {"uri": "at://a/app.bsky.feed.post/4", "record": {"text": "four"}}}]},
+				{"uri": "at://blocked", "blocked": true}
+			]
+		}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(5*time.Second, server.URL, "")
+	thread, err := client.Thread(context.Background(), "at://a/app.bsky.feed.post/2")
+	if err != nil {
+		t.Fatalf("thread failed: %v", err)
+	}
+	if len(thread.Ancestors) != 1 || thread.Ancestors[0].Text != "one" {
+		t.Fatalf("unexpected ancestors %+v", thread.Ancestors)
+	}
+	if len(thread.Descendants) != 2 || thread.Descendants[0].Text != "three" || thread.Descendants[1].Text != "four" {
+		t.Fatalf("unexpected descendants %+v", thread.Descendants)
+	}
+}
@@ -0,0 +1,371 @@
+package bluesky
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/bakkerme/curator-ai/internal/config"
+	"github.com/bakkerme/curator-ai/internal/core"
+	"github.com/bakkerme/curator-ai/internal/dedupe"
+	"github.com/bakkerme/curator-ai/internal/sources"
+)
+
+const (
+	defaultLimit   = 25
+	maxTitleLength = 100
+	dedupePrefix   = "bluesky:"
+)
+
+// BlueskyProcessor reads author feeds, feed generators and post searches from
+// the AppView API and emits one PostBlock per thread. An author's self-replies
+// are joined into their first post; with threads enabled the full thread is
+// fetched and other accounts' replies become comments.
+type BlueskyProcessor struct {
+	name   string
+	config config.BlueskySource
+	client Client
+	store  dedupe.SeenStore
+	logger *slog.Logger
+}
+
+// NewBlueskyProcessor wires a new Bluesky source.
+func NewBlueskyProcessor(cfg *config.BlueskySource, client Client, store dedupe.SeenStore, logger *slog.Logger) (*BlueskyProcessor, error) {
+	if cfg == nil {
+		return nil, fmt.Errorf("bluesky config is required")
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &BlueskyProcessor{
+		name:   "bluesky",
+		config: *cfg,
+		client: client,
+		store:  store,
+		logger: logger,
+	}, nil
+}
+
+func (p *BlueskyProcessor) Name() string {
+	return p.name
+}
+
+func (p *BlueskyProcessor) Configure(config map[string]interface{}) error {
+	return nil
+}
+
+func (p *BlueskyProcessor) Validate() error {
+	if len(p.config.Authors) == 0 && len(p.config.Feeds) == 0 && len(p.config.Searches) == 0 {
+		return fmt.Errorf("bluesky authors, feeds or searches are required")
+	}
+	if p.client == nil {
+		return fmt.Errorf("bluesky client is required")
+	}
+	return nil
+}
+
+func (p *BlueskyProcessor) Fetch(ctx context.Context) ([]*core.PostBlock, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	logger := core.LoggerFromContext(ctx).With("stage", "source", "processor", p.name)
+
+	limit := p.config.Limit
+	if limit <= 0 {
+		limit = defaultLimit
+	}
+	var requests []FeedRequest
+	for _, author := range p.config.Authors {
+		requests = append(requests, FeedRequest{Kind: FeedAuthor, Value: author, Limit: limit})
+	}
+	for _, feed := range p.config.Feeds {
+		requests = append(requests, FeedRequest{Kind: FeedGenerator, Value: feed, Limit: limit})
+	}
+	for _, query := range p.config.Searches {
+		requests = append(requests, FeedRequest{Kind: FeedSearch, Value: query, Limit: limit, Sort: p.config.SearchSort})
+	}
+
+	// Collect every post first so self-replies can be joined across feeds.
+	var posts []Post
+	feeds := make(map[string]string)
+	reposters := make(map[string][]string)
+	for _, request := range requests {
+		logger.Info("Fetching Bluesky feed", "feed", request.Kind, "value", request.Value)
+		fetched, err := p.client.Feed(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		for _, post := range fetched {
+			if post.RepostedBy != "" {
+				reposters[post.URI] = append(reposters[post.URI], post.RepostedBy)
+			}
+			if _, ok := feeds[post.URI]; ok {
+				continue
+			}
+			feeds[post.URI] = string(request.Kind) + ":" + request.Value
+			posts = append(posts, post)
+		}
+	}
+
+	var blocks []*core.PostBlock
+	covered := make(map[string]*core.PostBlock)
+	for _, post := range posts {
+		if block, ok := covered[post.URI]; ok {
+			addRepostedBy(block, reposters[post.URI])
+			continue
+		}
+		chain, replies := batchChain(post, posts), []Post(nil)
+		if p.config.Threads && (post.ParentURI != "" || post.ReplyCount > 0 || len(chain) > 1) {
+			chain, replies = p.thread(ctx, logger, chain[0])
+		}
+		root := chain[0]
+		key := dedupePrefix + root.URI
+		if block, ok := covered[root.URI]; ok {
+			covered[post.URI] = block
+			addRepostedBy(block, reposters[post.URI])
+			continue
+		}
+		if sources.Seen(ctx, p.store, logger, "post", key) {
+			for _, member := range chain {
+				covered[member.URI] = nil
+			}
+			continue
+		}
+
+		block := p.buildBlock(chain, replies, feeds[post.URI])
+		for _, member := range chain {
+			covered[member.URI] = block
+			addRepostedBy(block, reposters[member.URI])
+		}
+		covered[post.URI] = block
+		blocks = append(blocks, block)
+		if p.store != nil {
+			if err := p.store.MarkSeen(ctx, key); err != nil {
+				logger.Warn("Failed to mark post as seen", "post", key, "error", err)
+			}
+		}
+	}
+	return blocks, nil
+}
+
+// batchChain joins post with the author's self-replies around it among the
+// fetched posts, using reply parents. The result is oldest first.
+func batchChain(post Post, posts []Post) []Post {
+	byURI := make(map[string]Post, len(posts))
+	for _, candidate := range posts {
+		byURI[candidate.URI] = candidate
+	}
+	rootOf := func(current Post) string {
+		for current.ParentURI != "" {
+			parent, ok := byURI[current.ParentURI]
+			if !ok || parent.Author.DID != current.Author.DID {
+				break
+			}
+			current = parent
+		}
+		return current.URI
+	}
+	root := rootOf(post)
+	var chain []Post
+	for _, candidate := range posts {
+		if candidate.Author.DID == post.Author.DID && rootOf(candidate) == root {
+			chain = append(chain, candidate)
+		}
+	}
+	sort.SliceStable(chain, func(i, j int) bool {
+		return chain[i].CreatedAt.Before(chain[j].CreatedAt)
+	})
+	return chain
+}
+
+// thread fetches the thread around post and returns the author's self-reply
+// chain plus every other reply. It falls back to the post alone on errors.
+func (p *BlueskyProcessor) thread(ctx context.Context, logger *slog.Logger, post Post) ([]Post, []Post) {
+	thread, err := p.client.Thread(ctx, post.URI)
+	if err != nil {
+		logger.Warn("Failed to fetch Bluesky thread; using the post alone", "uri", post.URI, "error", err)
+		return []Post{post}, nil
+	}
+	chain, replies := selfThread(post, thread)
+	// A thread view only lists the post's own replies, so re-read the thread
+	// from the chain's root to see every reply.
+	if root := chain[0]; root.URI != post.URI {
+		rootThread, err := p.client.Thread(ctx, root.URI)
+		if err != nil {
+			logger.Warn("Failed to fetch Bluesky thread root", "uri", root.URI, "error", err)
+			return chain, replies
+		}
+		chain, replies = selfThread(root, rootThread)
+	}
+	return chain, replies
+}
+
+// selfThread returns the author's unbroken chain of self-replies containing
+// post, oldest first, plus every other reply in the thread.
+func selfThread(post Post, thread Thread) ([]Post, []Post) {
+	byURI := make(map[string]Post, len(thread.Ancestors))
+	for _, ancestor := range thread.Ancestors {
+		byURI[ancestor.URI] = ancestor
+	}
+	chain := []Post{post}
+	for current := post; current.ParentURI != ""; {
+		parent, ok := byURI[current.ParentURI]
+		if !ok || parent.Author.DID != post.Author.DID {
+			break
+		}
+		chain = append([]Post{parent}, chain...)
+		current = parent
+	}
+
+	inChain := make(map[string]bool, len(chain))
+	for _, member := range chain {
+		inChain[member.URI] = true
+	}
+	var replies []Post
+	for _, descendant := range thread.Descendants {
+		if descendant.Author.DID == post.Author.DID && inChain[descendant.ParentURI] {
+			chain = append(chain, descendant)
+			inChain[descendant.URI] = true
+			continue
+		}
+		replies = append(replies, descendant)
+	}
+	return chain, replies
+}
+
+func (p *BlueskyProcessor) buildBlock(chain []Post, replies []Post, feed string) *core.PostBlock {
+	root := chain[0]
+	parts := make([]string, 0, len(chain))
+	block := &core.PostBlock{
+		ID:          root.URI,
+		URL:         root.URL,
+		Author:      root.Author.Handle,
+		CreatedAt:   root.CreatedAt,
+		SummaryPlan: sources.SummaryPlanFromConfig(p.config.SummaryPlan),
+		ProcessedAt: time.Now().UTC(),
+	}
+	if root.Author.DisplayName != "" {
+		block.Authors = []core.Author{{Name: root.Author.DisplayName}}
+	}
+	links := make(map[string]bool)
+	addLink := func(web core.WebBlock) {
+		if web.URL == "" || links[web.URL] {
+			return
+		}
+		links[web.URL] = true
+		block.WebBlocks = append(block.WebBlocks, web)
+	}
+	for _, post := range chain {
+		if post.Text != "" {
+			parts = append(parts, post.Text)
+		}
+		for _, image := range post.Images {
+			block.ImageBlocks = append(block.ImageBlocks, core.ImageBlock{URL: image.URL, AltText: image.Alt})
+		}
+		if post.External != nil {
+			addLink(core.WebBlock{
+				URL:         post.External.URL,
+				Title:       post.External.Title,
+				Description: post.External.Description,
+			})
+		}
+		for _, link := range post.Links {
+			addLink(core.WebBlock{URL: link})
+		}
+	}
+	block.Content = strings.Join(parts, "\n\n")
+	block.Title = postTitle(block.Content, root.Author)
+	block.Comments = replyTree(replies, chain)
+
+	block.Metadata = map[string]string{
+		"handle":  root.Author.Handle,
+		"likes":   strconv.Itoa(root.LikeCount),
+		"reposts": strconv.Itoa(root.RepostCount),
+		"replies": strconv.Itoa(root.ReplyCount),
+		"quotes":  strconv.Itoa(root.QuoteCount),
+		"feed":    feed,
+	}
+	if len(chain) > 1 {
+		block.Metadata["thread_length"] = strconv.Itoa(len(chain))
+	}
+	if len(root.Langs) > 0 {
+		block.Metadata["language"] = root.Langs[0]
+	}
+	return block
+}
+
+// replyTree nests replies under the replies they answer; replies to the
+// post's own chain become top-level comments.
+func replyTree(replies []Post, chain []Post) []core.CommentBlock {
+	if len(replies) == 0 {
+		return nil
+	}
+	inChain := make(map[string]bool, len(chain))
+	for _, post := range chain {
+		inChain[post.URI] = true
+	}
+	children := make(map[string][]Post)
+	var top []Post
+	for _, reply := range replies {
+		if inChain[reply.ParentURI] {
+			top = append(top, reply)
+		} else {
+			children[reply.ParentURI] = append(children[reply.ParentURI], reply)
+		}
+	}
+	var build func([]Post) []core.CommentBlock
+	build = func(posts []Post) []core.CommentBlock {
+		comments := make([]core.CommentBlock, 0, len(posts))
+		for _, post := range posts {
+			comments = append(comments, core.CommentBlock{
+				ID:        post.URI,
+				Author:    post.Author.Handle,
+				Content:   post.Text,
+				CreatedAt: post.CreatedAt,
+				Score:     post.LikeCount,
+				Permalink: post.URL,
+				Replies:   build(children[post.URI]),
+			})
+		}
+		return comments
+	}
+	return build(top)
+}
+
+func postTitle(content string, author Author) string {
+	line, _, _ := strings.Cut(strings.TrimSpace(content), "\n")
+	line = strings.TrimSpace(line)
+	if line == "" {
+		name := author.DisplayName
+		if name == "" {
+			name = author.Handle
+		}
+		return "Post by " + name
+	}
+	if utf8.RuneCountInString(line) > maxTitleLength {
+		runes := []rune(line)
+		line = strings.TrimSpace(string(runes[:maxTitleLength])) + "…"
+	}
+	return line
+}
+
+func addRepostedBy(block *core.PostBlock, handles []string) {
+	if block == nil {
+		return
+	}
+	existing := strings.Fields(block.Metadata["reposted_by"])
+	for _, handle := range handles {
+		if !slices.Contains(existing, handle) {
+			existing = append(existing, handle)
+		}
+	}
+	if len(existing) > 0 {
+		block.Metadata["reposted_by"] = strings.Join(existing, " ")
+	}
+}
@@ -0,0 +1,156 @@
+package bluesky
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bakkerme/curator-ai/internal/config"
+)
+
+type clientMock struct {
+	feeds    map[string][]Post
+	threads  map[string]Thread
+	requests []FeedRequest
+}
+
+func (m *clientMock) Feed(ctx context.Context, request FeedRequest) ([]Post, error) {
+	m.requests = append(m.requests, request)
+	return m.feeds[string(request.Kind)+":"+request.Value], nil
+}
+
+func (m *clientMock) Thread(ctx context.Context, uri string) (Thread, error) {
+	return m.threads[uri], nil
+}
+
+type seenStoreMock struct {
+	seen map[string]bool
+}
+
+func (m *seenStoreMock) HasSeen(ctx context.Context, id string) (bool, error) { return m.seen[id], nil }
+func (m *seenStoreMock) MarkSeen(ctx context.Context, id string) error        { m.seen[id] = true; return nil }
+func (m *seenStoreMock) MarkSeenBatch(ctx context.Context, ids []string) error {
+	for _, id := range ids {
+		m.seen[id] = true
+	}
+	return nil
+}
+func (m *seenStoreMock) Close() error { return nil }
+
+var (
+	alice = Author{DID: "did:plc:alice", Handle: "alice.test", DisplayName: "Alice"}
+	bob   = Author{DID: "did:plc:bob", Handle: "bob.test"}
+	start = time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)
+)
+
+func post(author Author, id string, parent string, text string, minutes int) Post {
+	uri := "at://" + author.DID + "/app.bsky.feed.post/" + id
+	return Post{
+		URI:       uri,
+		URL:       "https://bsky.app/profile/" + author.Handle + "/post/" + id,
+		Author:    author,
+		Text:      text,
+		ParentURI: parent,
+		CreatedAt: start.Add(time.Duration(minutes) * time.Minute),
+	}
+}
+
+func TestBlueskyProcessor_JoinsSelfRepliesFromFeed(t *testing.T) {
+	first := post(alice, "1", "", "1/ Sparse attention thread", 0)
+	first.LikeCount, first.RepostCount, first.Langs = 40, 6, []string{"en"}
+	first.Images = []Image{{URL: "https://cdn/fig.jpg", Alt: "Figure 1"}}
+	first.External = &External{URL: "https://arxiv.org/abs/1", Title: "Paper", Description: "Abstract"}
+	second := post(alice, "2", first.URI, "2/ Code is out", 1)
+	second.Links = []string{"https://github.com/alice/sparse", "https://arxiv.org/abs/1"}
+	reposted := first
+	reposted.RepostedBy = "bob.test"
+	other := post(bob, "9", "", "Unrelated", 2)
+
+	client := &clientMock{feeds: map[string][]Post{
+		"author:alice.test": {second, first, other},
+		"search:sparse":     {reposted},
+	}}
+	cfg := &config.BlueskySource{Authors: []string{"alice.test"}, Searches: []string{"sparse"}, SearchSort: "top"}
+	processor, err := NewBlueskyProcessor(cfg, client, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to create processor: %v", err)
+	}
+
+	blocks, err := processor.Fetch(context.Background())
+	if err != nil {
+		t.Fatalf("fetch failed: %v", err)
+	}
+	if client.requests[0].Limit != defaultLimit || client.requests[1].Sort != "top" {
+		t.Fatalf("unexpected requests %+v", client.requests)
+	}
+	if len(blocks) != 2 {
+		t.Fatalf("expected the thread and the unrelated post, got %d blocks", len(blocks))
+	}
+	block := blocks[0]
+	if block.ID != first.URI || block.Content != "1/ Sparse attention thread\n\n2/ Code is out" || block.Title != "1/ Sparse attention thread" {
+		t.Fatalf("unexpected thread block %+v", block)
+	}
+	if block.Metadata["thread_length"] != "2" || block.Metadata["likes"] != "40" || block.Metadata["reposts"] != "6" ||
+		block.Metadata["language"] != "en" || block.Metadata["reposted_by"] != "bob.test" || block.Metadata["feed"] != "author:alice.test" {
+		t.Fatalf("unexpected metadata %v", block.Metadata)
+	}
+	if len(block.ImageBlocks) != 1 || block.ImageBlocks[0].AltText != "Figure 1" {
+		t.Fatalf("expected image with alt text, got %+v", block.ImageBlocks)
+	}
+	if len(block.WebBlocks) != 2 || block.WebBlocks[0].Title != "Paper" || block.WebBlocks[1].URL != "https://github.com/alice/sparse" {
+		t.Fatalf("expected card and deduplicated links, got %+v", block.WebBlocks)
+	}
+	if blocks[1].Author != "bob.test" || blocks[1].Comments != nil {
+		t.Fatalf("unexpected second block %+v", blocks[1])
+	}
+}
+
+func TestBlueskyProcessor_ThreadsAttachReplies(t *testing.T) {
+	first := post(alice, "1", "", "1/ Start", 0)
+	second := post(alice, "2", first.URI, "2/ End", 1)
+	reply := post(bob, "3", second.URI, "Great thread", 2)
+	reply.LikeCount = 4
+	answer := post(alice, "4", reply.URI, "Thanks!", 3)
+	client := &clientMock{
+		feeds: map[string][]Post{"feed:at://did:plc:gen/app.bsky.feed.generator/ml": {second}},
+		threads: map[string]Thread{
+			second.URI: {Ancestors: []Post{first}, Descendants: []Post{reply, answer}},
+			first.URI:  {Descendants: []Post{second, reply, answer}},
+		},
+	}
+	store := &seenStoreMock{seen: map[string]bool{}}
+	cfg := &config.BlueskySource{Feeds: []string{"at://did:plc:gen/app.bsky.feed.generator/ml"}, Threads: true}
+	processor, err := NewBlueskyProcessor(cfg, client, store, nil)
+	if err != nil {
+		t.Fatalf("failed to create processor: %v", err)
+	}
+
+	blocks, err := processor.Fetch(context.Background())
+	if err != nil {
+		t.Fatalf("fetch failed: %v", err)
+	}
+	if len(blocks) != 1 {
+		t.Fatalf("expected one block, got %d", len(blocks))
+	}
+	block := blocks[0]
+	if block.ID != first.URI || block.Content != "1/ Start\n\n2/ End" {
+		t.Fatalf("unexpected thread block %+v", block)
+	}
+	if len(block.Comments) != 1 || block.Comments[0].Author != "bob.test" || block.Comments[0].Score != 4 {
+		t.Fatalf("expected bob's reply as a comment, got %+v", block.Comments)
+	}
+	if len(block.Comments[0].Replies) != 1 || block.Comments[0].Replies[0].Content != "Thanks!" {
+		t.Fatalf("expected alice's answer nested under bob's reply, got %+v", block.Comments[0].Replies)
+	}
+	if !store.seen[dedupePrefix+first.URI] {
+		t.Fatalf("expected the thread root to be marked seen, got %v", store.seen)
+	}
+
+	blocks, err = processor.Fetch(context.Background())
+	if err != nil {
+		t.Fatalf("second fetch failed: %v", err)
+	}
+	if len(blocks) != 0 {
+		t.Fatalf("expected seen thread to be skipped, got %d blocks", len(blocks))
+	}
+}
@@ -0,0 +1,55 @@
+package sources
+
+import (
+	"strings"
+
+	"github.com/bakkerme/curator-ai/internal/core"
+)
+
+// DefaultMaxChunkChars is the chunk size sources use when the summary plan
+// sets none.
+const DefaultMaxChunkChars = 4000
+
+// PackChunks packs paragraphs, in order and separated by blank lines, into
+// chunks of at most maxChars characters. A paragraph longer than maxChars is
+// split. When breakBefore reports true for a paragraph, it starts a new chunk
+// once the current one is half full, so sections tend to stay together.
+// limit caps the number of chunks when positive.
+func PackChunks(paragraphs []string, maxChars int, limit int, breakBefore func(string) bool) []core.ContentChunk {
+	if maxChars <= 0 {
+		maxChars = DefaultMaxChunkChars
+	}
+	var chunks []core.ContentChunk
+	var current []rune
+	flush := func() {
+		if text := strings.TrimSpace(string(current)); text != "" {
+			chunks = append(chunks, core.ContentChunk{Content: text})
+		}
+		current = current[:0]
+	}
+	for _, paragraph := range paragraphs {
+		paragraph = strings.TrimSpace(paragraph)
+		if paragraph == "" {
+			continue
+		}
+		text := []rune(paragraph)
+		breaks := breakBefore != nil && breakBefore(paragraph)
+		if len(current) > 0 && (len(current)+len(text)+2 > maxChars || (breaks && len(current) >= maxChars/2)) {
+			flush()
+		}
+		for len(text) > maxChars {
+			current = append(current, text[:maxChars]...)
+			flush()
+			text = text[maxChars:]
+		}
+		if len(current) > 0 {
+			current = append(current, '\n', '\n')
+		}
+		current = append(current, text...)
+	}
+	flush()
+	if limit > 0 && len(chunks) > limit {
+		chunks = chunks[:limit]
+	}
+	return chunks
+}
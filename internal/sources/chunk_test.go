@@ -0,0 +1,34 @@
+package sources
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPackChunks_PacksSplitsAndLimits(t *testing.T) {
+	paragraphs := []string{"aaaa", "bbbb", "", "cccccccccccc", "dd"}
+	chunks := PackChunks(paragraphs, 10, 0, nil)
+
+	var got []string
+	for _, chunk := range chunks {
+		got = append(got, chunk.Content)
+	}
+	want := []string{"aaaa\n\nbbbb", "cccccccccc", "cc\n\ndd"}
+	if strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Fatalf("PackChunks = %q, want %q", got, want)
+	}
+	if limited := PackChunks(paragraphs, 10, 2, nil); len(limited) != 2 {
+		t.Fatalf("expected limit to cap chunks, got %d", len(limited))
+	}
+}
+
+func TestPackChunks_BreaksBeforeOnceHalfFull(t *testing.T) {
+	isHeading := func(paragraph string) bool { return strings.HasPrefix(paragraph, "#") }
+	chunks := PackChunks([]string{"# A", "intro text", "# B", "more"}, 24, 0, isHeading)
+	if len(chunks) != 2 || chunks[1].Content != "# B\n\nmore" {
+		t.Fatalf("expected a break at the second heading, got %+v", chunks)
+	}
+	if chunks := PackChunks([]string{"# A", "# B"}, 24, 0, isHeading); len(chunks) != 1 {
+		t.Fatalf("expected no break while the chunk is under half full, got %+v", chunks)
+	}
+}
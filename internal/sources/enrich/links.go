@@ -0,0 +1,140 @@
+package enrich
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+var (
+	markdownImagePattern = regexp.MustCompile(`!\[[^\]]*\]\(\s*<?([^)\s>]+)>?(?:\s+"[^"]*")?\s*\)`)
+	markdownLinkPattern  = regexp.MustCompile(`\[[^\]]*\]\(\s*<?([^)\s>]+)>?(?:\s+"[^"]*")?\s*\)`)
+	htmlImagePattern     = regexp.MustCompile(`(?i)<img\b[^>]*?\bsrc\s*=\s*["']([^"']+)["']`)
+	htmlLinkPattern      = regexp.MustCompile(`(?i)<a\b[^>]*?\bhref\s*=\s*["']([^"']+)["']`)
+	bareURLPattern       = regexp.MustCompile(`https?://[^\s<>"'\)\]]+`)
+)
+
+// ExtractLinks finds http(s) links in markdown or HTML content and splits them
+// into web links and image links. Results are de-duplicated and keep their
+// order of appearance. Explicit image syntax (markdown images, <img>) and image
+// file extensions are treated as images.
+func ExtractLinks(content string) (links []string, images []string) {
+	if strings.TrimSpace(content) == "" {
+		return nil, nil
+	}
+
+	seen := map[string]bool{}
+	add := func(raw string, forceImage bool) {
+		normalized, parsed, ok := normalizeLink(raw)
+		if !ok || seen[normalized] {
+			return
+		}
+		seen[normalized] = true
+		if forceImage || isImageURL(parsed) {
+			images = append(images, normalized)
+			return
+		}
+		links = append(links, normalized)
+	}
+
+	// Images first so a markdown image is not also picked up as a plain link.
+	for _, m := range markdownImagePattern.FindAllStringSubmatch(content, -1) {
+		add(m[1], true)
+	}
+	for _, m := range htmlImagePattern.FindAllStringSubmatch(content, -1) {
+		add(m[1], true)
+	}
+	for _, m := range markdownLinkPattern.FindAllStringSubmatch(content, -1) {
+		add(m[1], false)
+	}
+	for _, m := range htmlLinkPattern.FindAllStringSubmatch(content, -1) {
+		add(m[1], false)
+	}
+	for _, m := range bareURLPattern.FindAllString(content, -1) {
+		add(strings.TrimRight(m, ".,;:!?"), false)
+	}
+	return links, images
+}
+
+func normalizeLink(raw string) (string, *url.URL, bool) {
+	raw = strings.TrimSpace(strings.ReplaceAll(raw, "&amp;", "&"))
+	if !strings.HasPrefix(raw, "http://") && !strings.HasPrefix(raw, "https://") {
+		return "", nil, false
+	}
+	parsed, err := url.Parse(raw)
+	if err != nil || parsed.Host == "" {
+		return "", nil, false
+	}
+	parsed.Fragment = ""
+	return parsed.String(), parsed, true
+}
+
+func isImageURL(u *url.URL) bool {
+	if u == nil {
+		return false
+	}
+	switch strings.ToLower(u.Host) {
+	case "i.redd.it", "i.imgur.com":
+		return true
+	}
+	path := strings.ToLower(u.Path)
+	for _, ext := range []string{".jpg", ".jpeg", ".png", ".gif", ".webp", ".bmp", ".svg"} {
+		if strings.HasSuffix(path, ext) {
+			return true
+		}
+	}
+	return false
+}
+
+// domainFilter applies include/exclude rules to link hosts. A rule matches the
+// domain itself and any subdomain ("example.com" matches "blog.example.com").
+type domainFilter struct {
+	include []string
+	exclude []string
+}
+
+func newDomainFilter(include, exclude []string) domainFilter {
+	return domainFilter{include: normalizeDomains(include), exclude: normalizeDomains(exclude)}
+}
+
+func (f domainFilter) Allow(rawURL string) bool {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return false
+	}
+	host := strings.TrimPrefix(strings.ToLower(parsed.Hostname()), "www.")
+	if host == "" {
+		return false
+	}
+	for _, domain := range f.exclude {
+		if matchesDomain(host, domain) {
+			return false
+		}
+	}
+	if len(f.include) == 0 {
+		return true
+	}
+	for _, domain := range f.include {
+		if matchesDomain(host, domain) {
+			return true
+		}
+	}
+	return false
+}
+
+func normalizeDomains(domains []string) []string {
+	out := make([]string, 0, len(domains))
+	for _, domain := range domains {
+		domain = strings.ToLower(strings.TrimSpace(domain))
+		domain = strings.TrimPrefix(domain, "*.")
+		domain = strings.TrimPrefix(domain, "www.")
+		if domain != "" {
+			out = append(out, domain)
+		}
+	}
+	return out
+}
+
+func matchesDomain(host, domain string) bool {
+	return host == domain || strings.HasSuffix(host, "."+domain)
+}
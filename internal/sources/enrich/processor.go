@@ -0,0 +1,187 @@
+package enrich
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/bakkerme/curator-ai/internal/config"
+	"github.com/bakkerme/curator-ai/internal/core"
+	"github.com/bakkerme/curator-ai/internal/sources/reader"
+)
+
+const (
+	defaultMaxLinks       = 5
+	defaultMaxImages      = 5
+	defaultMaxConcurrency = 4
+)
+
+// Source wraps any source processor and enriches the blocks it emits with the
+// pages and images linked from each post's content.
+type Source struct {
+	core.SourceProcessor
+	config config.EnrichConfig
+	filter domainFilter
+	reader reader.Reader
+	logger *slog.Logger
+}
+
+// WrapSource returns source unchanged when cfg is nil, so factories can call it
+// unconditionally.
+func WrapSource(source core.SourceProcessor, cfg *config.EnrichConfig, r reader.Reader, logger *slog.Logger) core.SourceProcessor {
+	if source == nil || cfg == nil {
+		return source
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &Source{
+		SourceProcessor: source,
+		config:          *cfg,
+		filter:          newDomainFilter(cfg.IncludeDomains, cfg.ExcludeDomains),
+		reader:          r,
+		logger:          logger,
+	}
+}
+
+func (s *Source) Validate() error {
+	if err := s.SourceProcessor.Validate(); err != nil {
+		return err
+	}
+	if s.reader == nil {
+		return fmt.Errorf("enrich requires a web reader")
+	}
+	return nil
+}
+
+func (s *Source) Fetch(ctx context.Context) ([]*core.PostBlock, error) {
+	blocks, err := s.SourceProcessor.Fetch(ctx)
+	if err != nil {
+		return blocks, err
+	}
+	if s.reader == nil {
+		return blocks, fmt.Errorf("enrich requires a web reader")
+	}
+
+	maxConcurrency := s.config.MaxConcurrency
+	if maxConcurrency <= 0 {
+		maxConcurrency = defaultMaxConcurrency
+	}
+	sem := make(chan struct{}, maxConcurrency)
+
+	for _, block := range blocks {
+		if block == nil {
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			return blocks, err
+		}
+		s.enrichBlock(ctx, block, sem)
+	}
+	return blocks, nil
+}
+
+func (s *Source) enrichBlock(ctx context.Context, block *core.PostBlock, sem chan struct{}) {
+	links, images := ExtractLinks(block.Content)
+
+	existing := map[string]bool{block.URL: true}
+	for _, wb := range block.WebBlocks {
+		existing[wb.URL] = true
+	}
+	for _, ib := range block.ImageBlocks {
+		existing[ib.URL] = true
+	}
+
+	if s.includeImages() {
+		maxImages := s.config.MaxImages
+		if maxImages <= 0 {
+			maxImages = defaultMaxImages
+		}
+		added := 0
+		for _, u := range images {
+			if added >= maxImages {
+				break
+			}
+			if existing[u] || !s.filter.Allow(u) {
+				continue
+			}
+			existing[u] = true
+			block.ImageBlocks = append(block.ImageBlocks, core.ImageBlock{URL: u})
+			added++
+		}
+	}
+
+	maxLinks := s.config.MaxLinks
+	if maxLinks <= 0 {
+		maxLinks = defaultMaxLinks
+	}
+	selected := make([]string, 0, maxLinks)
+	for _, u := range links {
+		if len(selected) >= maxLinks {
+			break
+		}
+		if existing[u] || !s.filter.Allow(u) {
+			continue
+		}
+		existing[u] = true
+		selected = append(selected, u)
+	}
+	if len(selected) == 0 {
+		return
+	}
+
+	s.logger.Info("Enriching post links via reader", slog.String("post_id", block.ID), slog.Int("urls", len(selected)))
+	webBlocks := make([]core.WebBlock, len(selected))
+	errs := make([]*core.ProcessError, len(selected))
+	var wg sync.WaitGroup
+	for i, u := range selected {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			webBlocks[i] = core.WebBlock{URL: u}
+			continue
+		}
+		wg.Add(1)
+		go func(i int, u string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			webBlocks[i], errs[i] = s.fetchLink(ctx, block.ID, u)
+		}(i, u)
+	}
+	wg.Wait()
+
+	block.WebBlocks = append(block.WebBlocks, webBlocks...)
+	for _, e := range errs {
+		if e != nil {
+			block.Errors = append(block.Errors, *e)
+		}
+	}
+}
+
+func (s *Source) fetchLink(ctx context.Context, postID, u string) (core.WebBlock, *core.ProcessError) {
+	wb := core.WebBlock{URL: u}
+	started := time.Now()
+	page, err := s.reader.Read(ctx, u)
+	if err != nil {
+		s.logger.Warn("Failed to enrich URL via reader", slog.String("post_id", postID), slog.String("url", u), slog.Duration("elapsed", time.Since(started)), slog.String("error", err.Error()))
+		return wb, &core.ProcessError{
+			ProcessorName: "enrich",
+			Stage:         "source",
+			Error:         fmt.Sprintf("reader fetch %s: %v", u, err),
+			OccurredAt:    time.Now().UTC(),
+		}
+	}
+	s.logger.Info("Enriched URL via reader", slog.String("post_id", postID), slog.String("url", u), slog.Duration("elapsed", time.Since(started)))
+	wb.WasFetched = true
+	wb.Page = page
+	return wb, nil
+}
+
+func (s *Source) includeImages() bool {
+	if s.config.IncludeImages == nil {
+		return true
+	}
+	return *s.config.IncludeImages
+}
@@ -0,0 +1,123 @@
+package enrich_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/bakkerme/curator-ai/internal/config"
+	"github.com/bakkerme/curator-ai/internal/core"
+	"github.com/bakkerme/curator-ai/internal/sources/enrich"
+)
+
+type staticSource struct {
+	blocks []*core.PostBlock
+}
+
+func (s *staticSource) Name() string                                  { return "static" }
+func (s *staticSource) Configure(config map[string]interface{}) error { return nil }
+func (s *staticSource) Validate() error                               { return nil }
+func (s *staticSource) Fetch(ctx context.Context) ([]*core.PostBlock, error) {
+	return s.blocks, nil
+}
+
+type readerMock struct {
+	mu    sync.Mutex
+	pages map[string]string
+	errs  map[string]error
+	calls []string
+}
+
+func (r *readerMock) Read(ctx context.Context, url string) (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = append(r.calls, url)
+	if err := r.errs[url]; err != nil {
+		return "", err
+	}
+	return r.pages[url], nil
+}
+
+func TestExtractLinksHandlesMarkdownAndHTML(t *testing.T) {
+	content := `See [the paper](https://example.com/paper) and ![chart](https://cdn.example.com/chart.png).
+<p><a href="https://blog.example.org/post?a=1&amp;b=2">post</a> <img src="https://img.example.net/x.jpg"></p>
+Bare link: https://news.example.com/story. Duplicate: https://example.com/paper#section`
+
+	links, images := enrich.ExtractLinks(content)
+
+	wantLinks := []string{"https://example.com/paper", "https://blog.example.org/post?a=1&b=2", "https://news.example.com/story"}
+	if !reflect.DeepEqual(links, wantLinks) {
+		t.Fatalf("unexpected links: %#v", links)
+	}
+	wantImages := []string{"https://cdn.example.com/chart.png", "https://img.example.net/x.jpg"}
+	if !reflect.DeepEqual(images, wantImages) {
+		t.Fatalf("unexpected images: %#v", images)
+	}
+}
+
+func TestWrapSourceFetchesLinksWithFiltersAndCap(t *testing.T) {
+	block := &core.PostBlock{
+		ID:  "post-1",
+		URL: "https://self.example.com/post",
+		Content: `[self](https://self.example.com/post)
+[a](https://a.example.com/1) [tracker](https://ads.example.com/x)
+[b](https://other.org/2) [c](https://a.example.com/3) [d](https://a.example.com/4)
+![img](https://a.example.com/pic.png)`,
+	}
+	reader := &readerMock{
+		pages: map[string]string{"https://a.example.com/1": "page one"},
+		errs:  map[string]error{"https://a.example.com/3": errors.New("boom")},
+	}
+	source := enrich.WrapSource(&staticSource{blocks: []*core.PostBlock{block}}, &config.EnrichConfig{
+		MaxLinks:       2,
+		IncludeDomains: []string{"example.com"},
+		ExcludeDomains: []string{"ads.example.com"},
+	}, reader, nil)
+
+	blocks, err := source.Fetch(context.Background())
+	if err != nil {
+		t.Fatalf("fetch failed: %v", err)
+	}
+	got := blocks[0]
+
+	if len(got.WebBlocks) != 2 {
+		t.Fatalf("expected 2 web blocks, got %d", len(got.WebBlocks))
+	}
+	if got.WebBlocks[0].URL != "https://a.example.com/1" || !got.WebBlocks[0].WasFetched || got.WebBlocks[0].Page != "page one" {
+		t.Fatalf("unexpected first web block: %#v", got.WebBlocks[0])
+	}
+	if got.WebBlocks[1].URL != "https://a.example.com/3" || got.WebBlocks[1].WasFetched {
+		t.Fatalf("unexpected second web block: %#v", got.WebBlocks[1])
+	}
+	if len(got.Errors) != 1 || got.Errors[0].ProcessorName != "enrich" {
+		t.Fatalf("expected one enrich error, got %#v", got.Errors)
+	}
+	if len(got.ImageBlocks) != 1 || got.ImageBlocks[0].URL != "https://a.example.com/pic.png" {
+		t.Fatalf("unexpected image blocks: %#v", got.ImageBlocks)
+	}
+	if len(reader.calls) != 2 {
+		t.Fatalf("expected 2 reader calls, got %v", reader.calls)
+	}
+}
+
+func TestWrapSourceSkipsExistingWebBlocks(t *testing.T) {
+	block := &core.PostBlock{
+		ID:        "post-1",
+		Content:   "https://example.com/already",
+		WebBlocks: []core.WebBlock{{URL: "https://example.com/already", WasFetched: true}},
+	}
+	reader := &readerMock{}
+	source := enrich.WrapSource(&staticSource{blocks: []*core.PostBlock{block}}, &config.EnrichConfig{}, reader, nil)
+
+	if _, err := source.Fetch(context.Background()); err != nil {
+		t.Fatalf("fetch failed: %v", err)
+	}
+	if len(reader.calls) != 0 {
+		t.Fatalf("expected no reader calls, got %v", reader.calls)
+	}
+	if len(block.WebBlocks) != 1 {
+		t.Fatalf("expected existing web block to be kept as-is, got %d", len(block.WebBlocks))
+	}
+}
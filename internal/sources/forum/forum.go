@@ -0,0 +1,68 @@
+package forum
+
+import (
+	"context"
+	"time"
+)
+
+// Flavour names the forum software behind a forum source.
+type Flavour string
+
+const (
+	FlavourDiscourse Flavour = "discourse"
+	FlavourLemmy     Flavour = "lemmy"
+)
+
+// ListRequest lists topics of one board.
+type ListRequest struct {
+	// Board is a Discourse category slug or a Lemmy community name; empty
+	// lists the whole forum.
+	Board string
+	// Sort is "latest" or "top".
+	Sort string
+	// Period bounds "top" listings: "day", "week", "month", "year" or "all".
+	Period string
+	Limit  int
+}
+
+// Topic is a normalized forum topic (a Discourse topic or a Lemmy post).
+type Topic struct {
+	ID        string
+	Title     string
+	URL       string
+	Author    string
+	CreatedAt time.Time
+	Board     string
+	Tags      []string
+	// Link is the external URL a link post points at, if any.
+	Link    string
+	Replies int
+	Likes   int
+	Views   int
+}
+
+// Post is one message in a thread. Content is markdown.
+type Post struct {
+	ID string
+	// ParentID is the reply this post answers; empty for replies to the
+	// opening post.
+	ParentID  string
+	Author    string
+	Content   string
+	CreatedAt time.Time
+	Likes     int
+	URL       string
+}
+
+// Thread holds a topic's opening post and its replies in thread order.
+type Thread struct {
+	Opening Post
+	Replies []Post
+}
+
+// Client reads one forum flavour.
+type Client interface {
+	Topics(ctx context.Context, baseURL string, request ListRequest) ([]Topic, error)
+	// Thread loads the opening post and up to limit replies.
+	Thread(ctx context.Context, baseURL string, topic Topic, limit int) (Thread, error)
+}
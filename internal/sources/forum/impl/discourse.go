@@ -0,0 +1,256 @@
+package impl
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bakkerme/curator-ai/internal/sources/forum"
+	"github.com/bakkerme/curator-ai/internal/sources/htmlconv"
+)
+
+// discoursePostBatch is how many posts Discourse returns per request.
+const discoursePostBatch = 20
+
+// DiscourseClient reads the public JSON API of a Discourse forum.
+type DiscourseClient struct {
+	http httpClient
+}
+
+// NewDiscourseClient builds a Discourse API client.
+func NewDiscourseClient(timeout time.Duration, userAgent string) *DiscourseClient {
+	return &DiscourseClient{http: newHTTPClient(timeout, userAgent)}
+}
+
+type discourseTopic struct {
+	ID         int               `json:"id"`
+	Title      string            `json:"title"`
+	Slug       string            `json:"slug"`
+	ReplyCount int               `json:"reply_count"`
+	PostsCount int               `json:"posts_count"`
+	LikeCount  int               `json:"like_count"`
+	Views      int               `json:"views"`
+	CreatedAt  string            `json:"created_at"`
+	Tags       []json.RawMessage `json:"tags"`
+	Posters    []struct {
+		UserID      int    `json:"user_id"`
+		Description string `json:"description"`
+	} `json:"posters"`
+}
+
+type discourseTopicList struct {
+	Users []struct {
+		ID       int    `json:"id"`
+		Username string `json:"username"`
+	} `json:"users"`
+	TopicList struct {
+		Topics []discourseTopic `json:"topics"`
+	} `json:"topic_list"`
+}
+
+type discoursePost struct {
+	ID                int    `json:"id"`
+	Username          string `json:"username"`
+	Cooked            string `json:"cooked"`
+	CreatedAt         string `json:"created_at"`
+	PostNumber        int    `json:"post_number"`
+	PostType          int    `json:"post_type"`
+	ReplyToPostNumber *int   `json:"reply_to_post_number"`
+	LikeCount         int    `json:"like_count"`
+	ActionsSummary    []struct {
+		ID    int `json:"id"`
+		Count int `json:"count"`
+	} `json:"actions_summary"`
+}
+
+type discoursePostStream struct {
+	PostStream struct {
+		Posts  []discoursePost `json:"posts"`
+		Stream []int           `json:"stream"`
+	} `json:"post_stream"`
+}
+
+var discoursePeriods = map[string]string{
+	"day":   "daily",
+	"week":  "weekly",
+	"month": "monthly",
+	"year":  "yearly",
+	"all":   "all",
+}
+
+// Topics lists the latest or top topics of a category, or of the whole forum.
+func (c *DiscourseClient) Topics(ctx context.Context, baseURL string, request forum.ListRequest) ([]forum.Topic, error) {
+	base := strings.TrimRight(baseURL, "/")
+	listing := "latest"
+	values := url.Values{}
+	if request.Sort == "top" {
+		listing = "top"
+		period := discoursePeriods[request.Period]
+		if period == "" {
+			period = "weekly"
+		}
+		values.Set("period", period)
+	}
+	target := base + "/" + listing + ".json"
+	if board := strings.Trim(strings.TrimSpace(request.Board), "/"); board != "" {
+		target = base + "/c/" + board + "/l/" + listing + ".json"
+	}
+	if len(values) > 0 {
+		target += "?" + values.Encode()
+	}
+
+	var list discourseTopicList
+	if err := c.http.getJSON(ctx, target, &list); err != nil {
+		return nil, fmt.Errorf("discourse %s topics %s: %w", listing, request.Board, err)
+	}
+	usernames := make(map[int]string, len(list.Users))
+	for _, user := range list.Users {
+		usernames[user.ID] = user.Username
+	}
+
+	topics := make([]forum.Topic, 0, len(list.TopicList.Topics))
+	for _, item := range list.TopicList.Topics {
+		if request.Limit > 0 && len(topics) >= request.Limit {
+			break
+		}
+		topic := forum.Topic{
+			ID:        strconv.Itoa(item.ID),
+			Title:     strings.TrimSpace(item.Title),
+			URL:       base + "/t/" + item.Slug + "/" + strconv.Itoa(item.ID),
+			CreatedAt: parseTime(item.CreatedAt),
+			Board:     request.Board,
+			Tags:      discourseTags(item.Tags),
+			Replies:   item.ReplyCount,
+			Likes:     item.LikeCount,
+			Views:     item.Views,
+		}
+		if topic.Replies == 0 && item.PostsCount > 1 {
+			topic.Replies = item.PostsCount - 1
+		}
+		for _, poster := range item.Posters {
+			if strings.Contains(poster.Description, "Original Poster") {
+				topic.Author = usernames[poster.UserID]
+				break
+			}
+		}
+		topics = append(topics, topic)
+	}
+	return topics, nil
+}
+
+// Thread loads the opening post and up to limit replies, in post order.
+func (c *DiscourseClient) Thread(ctx context.Context, baseURL string, topic forum.Topic, limit int) (forum.Thread, error) {
+	base := strings.TrimRight(baseURL, "/")
+	var stream discoursePostStream
+	if err := c.http.getJSON(ctx, base+"/t/"+url.PathEscape(topic.ID)+".json", &stream); err != nil {
+		return forum.Thread{}, fmt.Errorf("discourse topic %s: %w", topic.ID, err)
+	}
+	posts := stream.PostStream.Posts
+	loaded := make(map[int]bool, len(posts))
+	for _, post := range posts {
+		loaded[post.ID] = true
+	}
+
+	// The topic response only embeds the first posts; fetch the rest of the
+	// stream in batches until limit replies are available.
+	var missing []int
+	for _, id := range stream.PostStream.Stream {
+		if len(posts)+len(missing) > limit {
+			break
+		}
+		if !loaded[id] {
+			missing = append(missing, id)
+		}
+	}
+	for start := 0; start < len(missing); start += discoursePostBatch {
+		end := min(start+discoursePostBatch, len(missing))
+		values := url.Values{}
+		for _, id := range missing[start:end] {
+			values.Add("post_ids[]", strconv.Itoa(id))
+		}
+		var batch discoursePostStream
+		if err := c.http.getJSON(ctx, base+"/t/"+url.PathEscape(topic.ID)+"/posts.json?"+values.Encode(), &batch); err != nil {
+			return forum.Thread{}, fmt.Errorf("discourse topic %s posts: %w", topic.ID, err)
+		}
+		posts = append(posts, batch.PostStream.Posts...)
+	}
+
+	idByNumber := make(map[int]string, len(posts))
+	for _, post := range posts {
+		idByNumber[post.PostNumber] = strconv.Itoa(post.ID)
+	}
+	var thread forum.Thread
+	for _, post := range posts {
+		// Skip moderator actions and small actions such as "closed".
+		if post.PostType != 0 && post.PostType != 1 {
+			continue
+		}
+		converted := forum.Post{
+			ID:        strconv.Itoa(post.ID),
+			Author:    post.Username,
+			Content:   cookedMarkdown(post.Cooked),
+			CreatedAt: parseTime(post.CreatedAt),
+			Likes:     discourseLikes(post),
+			URL:       topic.URL + "/" + strconv.Itoa(post.PostNumber),
+		}
+		if post.PostNumber == 1 {
+			converted.URL = topic.URL
+			thread.Opening = converted
+			continue
+		}
+		if post.ReplyToPostNumber != nil && *post.ReplyToPostNumber > 1 {
+			converted.ParentID = idByNumber[*post.ReplyToPostNumber]
+		}
+		if limit <= 0 || len(thread.Replies) < limit {
+			thread.Replies = append(thread.Replies, converted)
+		}
+	}
+	return thread, nil
+}
+
+func discourseLikes(post discoursePost) int {
+	if post.LikeCount > 0 {
+		return post.LikeCount
+	}
+	// Older Discourse versions only report likes as action 2.
+	for _, action := range post.ActionsSummary {
+		if action.ID == 2 {
+			return action.Count
+		}
+	}
+	return 0
+}
+
+// discourseTags reads tags as plain strings or, on newer versions, objects
+// with a name.
+func discourseTags(raw []json.RawMessage) []string {
+	var tags []string
+	for _, item := range raw {
+		var name string
+		if err := json.Unmarshal(item, &name); err != nil {
+			var tag struct {
+				Name string `json:"name"`
+			}
+			if err := json.Unmarshal(item, &tag); err != nil {
+				continue
+			}
+			name = tag.Name
+		}
+		if name = strings.TrimSpace(name); name != "" {
+			tags = append(tags, name)
+		}
+	}
+	return tags
+}
+
+func cookedMarkdown(cooked string) string {
+	text, err := htmlconv.ConvertHTMLToMarkdown(cooked)
+	if err != nil {
+		return strings.TrimSpace(cooked)
+	}
+	return strings.TrimSpace(text)
+}
@@ -0,0 +1,89 @@
+package impl
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/bakkerme/curator-ai/internal/sources/forum"
+)
+
+func TestDiscourseTopics_TopCategory(t *testing.T) {
+	var gotPath, gotPeriod string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath, gotPeriod = r.URL.Path, r.URL.Query().Get("period")
+		_, _ = w.Write([]byte(`{
+			"users": [{"id": 7, "username": "ptrblck"}],
+			"topic_list": {"topics": [
+				{"id": 101, "title": "CUDA OOM with DDP", "slug": "cuda-oom-with-ddp", "posts_count": 12, "reply_count": 9,
+					"like_count": 30, "views": 1200, "created_at": "2024-05-01T09:00:00.000Z",
+					"tags": ["ddp", {"id": 3, "name": "cuda", "slug": "cuda"}],
+					"posters": [{"user_id": 7, "description": "Original Poster, Most Recent Poster"}]},
+				{"id": 102, "title": "Second", "slug": "second"}
+			]}
+		}`))
+	}))
+	defer server.Close()
+
+	client := NewDiscourseClient(5*time.Second, "")
+	topics, err := client.Topics(context.Background(), server.URL+"/", forum.ListRequest{Board: "distributed", Sort: "top", Period: "month", Limit: 1})
+	if err != nil {
+		t.Fatalf("topics failed: %v", err)
+	}
+	if gotPath != "/c/distributed/l/top.json" || gotPeriod != "monthly" {
+		t.Fatalf("unexpected request path %q period %q", gotPath, gotPeriod)
+	}
+	if len(topics) != 1 {
+		t.Fatalf("expected limit to apply, got %d topics", len(topics))
+	}
+	topic := topics[0]
+	if topic.ID != "101" || topic.URL != server.URL+"/t/cuda-oom-with-ddp/101" || topic.Author != "ptrblck" {
+		t.Fatalf("unexpected topic %+v", topic)
+	}
+	if topic.Replies != 9 || topic.Likes != 30 || topic.Views != 1200 || len(topic.Tags) != 2 || topic.Tags[1] != "cuda" {
+		t.Fatalf("unexpected topic counts %+v", topic)
+	}
+}
+
+func TestDiscourseThread_LoadsRemainingPosts(t *testing.T) {
+	var batchIDs []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/t/101.json":
+			_, _ = w.Write([]byte(`{"post_stream": {"stream": [1, 2, 3, 4], "posts": [
+				{"id": 1, "post_number": 1, "post_type": 1, "username": "op", "cooked": "<p>Why does <code>DDP</code> OOM?</p>", "created_at": "2024-05-01T09:00:00Z"},
+				{"id": 2, "post_number": 2, "post_type": 1, "username": "helper", "cooked": "<p>Use smaller batches</p>", "actions_summary": [{"id": 2, "count": 4}]}
+			]}}`))
+		case "/t/101/posts.json":
+			batchIDs = r.URL.Query()["post_ids[]"]
+			_, _ = w.Write([]byte(`{"post_stream": {"posts": [
+				{"id": 3, "post_number": 3, "post_type": 3, "username": "system", "cooked": "closed"},
+				{"id": 4, "post_number": 4, "post_type": 1, "username": "op", "cooked": "<p>Thanks</p>", "reply_to_post_number": 2, "like_count": 1}
+			]}}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	client := NewDiscourseClient(5*time.Second, "")
+	topic := forum.Topic{ID: "101", URL: server.URL + "/t/cuda/101"}
+	thread, err := client.Thread(context.Background(), server.URL, topic, 10)
+	if err != nil {
+		t.Fatalf("thread failed: %v", err)
+	}
+	if len(batchIDs) != 2 || batchIDs[0] != "3" || batchIDs[1] != "4" {
+		t.Fatalf("expected remaining posts to be requested, got %v", batchIDs)
+	}
+	if thread.Opening.Author != "op" || thread.Opening.Content != "Why does `DDP` OOM?" || thread.Opening.URL != topic.URL {
+		t.Fatalf("unexpected opening post %+v", thread.Opening)
+	}
+	if len(thread.Replies) != 2 {
+		t.Fatalf("expected small actions to be skipped, got %+v", thread.Replies)
+	}
+	if thread.Replies[0].Likes != 4 || thread.Replies[0].ParentID != "" || thread.Replies[1].ParentID != "2" || thread.Replies[1].URL != topic.URL+"/4" {
+		t.Fatalf("unexpected replies %+v", thread.Replies)
+	}
+}
@@ -0,0 +1,64 @@
+package impl
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/bakkerme/curator-ai/internal/retry"
+)
+
+type httpClient struct {
+	client    *http.Client
+	userAgent string
+}
+
+func newHTTPClient(timeout time.Duration, userAgent string) httpClient {
+	if timeout <= 0 {
+		timeout = 15 * time.Second
+	}
+	if strings.TrimSpace(userAgent) == "" {
+		userAgent = "curator-ai/0.1"
+	}
+	return httpClient{client: &http.Client{Timeout: timeout}, userAgent: userAgent}
+}
+
+func (c httpClient) getJSON(ctx context.Context, target string, out interface{}) error {
+	return retry.Do(ctx, retry.Config{Attempts: 3, BaseDelay: 200 * time.Millisecond}, func() error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+		if err != nil {
+			return retry.Permanent(err)
+		}
+		req.Header.Set("User-Agent", c.userAgent)
+		req.Header.Set("Accept", "application/json")
+		resp, err := c.client.Do(req)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = resp.Body.Close() }()
+		if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError {
+			return fmt.Errorf("transient status %s", resp.Status)
+		}
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+			return retry.Permanent(fmt.Errorf("status %s: %s", resp.Status, strings.TrimSpace(string(body))))
+		}
+		return json.NewDecoder(resp.Body).Decode(out)
+	})
+}
+
+// parseTime accepts RFC 3339 timestamps with or without a zone; Lemmy
+// before 0.19 omits it.
+func parseTime(value string) time.Time {
+	if parsed, err := time.Parse(time.RFC3339, value); err == nil {
+		return parsed
+	}
+	if parsed, err := time.Parse("2006-01-02T15:04:05.999999999", value); err == nil {
+		return parsed
+	}
+	return time.Time{}
+}
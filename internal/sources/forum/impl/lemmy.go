@@ -0,0 +1,178 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bakkerme/curator-ai/internal/sources/forum"
+)
+
+// lemmyMaxLimit is the largest page size the Lemmy API accepts.
+const lemmyMaxLimit = 50
+
+// LemmyClient reads the v3 HTTP API of a Lemmy instance.
+type LemmyClient struct {
+	http httpClient
+}
+
+// NewLemmyClient builds a Lemmy API client.
+func NewLemmyClient(timeout time.Duration, userAgent string) *LemmyClient {
+	return &LemmyClient{http: newHTTPClient(timeout, userAgent)}
+}
+
+type lemmyPostView struct {
+	Post struct {
+		ID        int    `json:"id"`
+		Name      string `json:"name"`
+		URL       string `json:"url"`
+		Body      string `json:"body"`
+		Published string `json:"published"`
+	} `json:"post"`
+	Creator struct {
+		Name string `json:"name"`
+	} `json:"creator"`
+	Community struct {
+		Name string `json:"name"`
+	} `json:"community"`
+	Counts struct {
+		Comments int `json:"comments"`
+		Score    int `json:"score"`
+		Upvotes  int `json:"upvotes"`
+	} `json:"counts"`
+}
+
+type lemmyCommentView struct {
+	Comment struct {
+		ID        int    `json:"id"`
+		Content   string `json:"content"`
+		Published string `json:"published"`
+		Path      string `json:"path"`
+		Deleted   bool   `json:"deleted"`
+		Removed   bool   `json:"removed"`
+	} `json:"comment"`
+	Creator struct {
+		Name string `json:"name"`
+	} `json:"creator"`
+	Counts struct {
+		Upvotes int `json:"upvotes"`
+	} `json:"counts"`
+}
+
+var lemmyTopSorts = map[string]string{
+	"day":   "TopDay",
+	"week":  "TopWeek",
+	"month": "TopMonth",
+	"year":  "TopYear",
+	"all":   "TopAll",
+}
+
+// Topics lists the newest or top posts of a community, or of the instance.
+func (c *LemmyClient) Topics(ctx context.Context, baseURL string, request forum.ListRequest) ([]forum.Topic, error) {
+	base := strings.TrimRight(baseURL, "/")
+	values := url.Values{"type_": {"All"}, "sort": {"New"}}
+	if request.Sort == "top" {
+		sort := lemmyTopSorts[request.Period]
+		if sort == "" {
+			sort = "TopWeek"
+		}
+		values.Set("sort", sort)
+	}
+	if request.Limit > 0 {
+		values.Set("limit", strconv.Itoa(min(request.Limit, lemmyMaxLimit)))
+	}
+	if board := strings.TrimPrefix(strings.TrimSpace(request.Board), "!"); board != "" {
+		values.Set("community_name", board)
+	}
+
+	var resp struct {
+		Posts []lemmyPostView `json:"posts"`
+	}
+	if err := c.http.getJSON(ctx, base+"/api/v3/post/list?"+values.Encode(), &resp); err != nil {
+		return nil, fmt.Errorf("lemmy posts %s: %w", request.Board, err)
+	}
+	topics := make([]forum.Topic, 0, len(resp.Posts))
+	for _, view := range resp.Posts {
+		topics = append(topics, lemmyTopic(base, view))
+	}
+	return topics, nil
+}
+
+// Thread loads the post body and its top limit comments in score order.
+func (c *LemmyClient) Thread(ctx context.Context, baseURL string, topic forum.Topic, limit int) (forum.Thread, error) {
+	base := strings.TrimRight(baseURL, "/")
+	var post struct {
+		PostView lemmyPostView `json:"post_view"`
+	}
+	if err := c.http.getJSON(ctx, base+"/api/v3/post?"+url.Values{"id": {topic.ID}}.Encode(), &post); err != nil {
+		return forum.Thread{}, fmt.Errorf("lemmy post %s: %w", topic.ID, err)
+	}
+	thread := forum.Thread{Opening: forum.Post{
+		ID:        topic.ID,
+		Author:    post.PostView.Creator.Name,
+		Content:   strings.TrimSpace(post.PostView.Post.Body),
+		CreatedAt: parseTime(post.PostView.Post.Published),
+		Likes:     post.PostView.Counts.Upvotes,
+		URL:       topic.URL,
+	}}
+	if limit <= 0 || topic.Replies == 0 {
+		return thread, nil
+	}
+
+	values := url.Values{
+		"post_id": {topic.ID},
+		"sort":    {"Top"},
+		"type_":   {"All"},
+		"limit":   {strconv.Itoa(min(limit, lemmyMaxLimit))},
+	}
+	var comments struct {
+		Comments []lemmyCommentView `json:"comments"`
+	}
+	if err := c.http.getJSON(ctx, base+"/api/v3/comment/list?"+values.Encode(), &comments); err != nil {
+		return forum.Thread{}, fmt.Errorf("lemmy comments %s: %w", topic.ID, err)
+	}
+	for _, view := range comments.Comments {
+		if view.Comment.Deleted || view.Comment.Removed {
+			continue
+		}
+		id := strconv.Itoa(view.Comment.ID)
+		thread.Replies = append(thread.Replies, forum.Post{
+			ID:        id,
+			ParentID:  lemmyParentID(view.Comment.Path),
+			Author:    view.Creator.Name,
+			Content:   strings.TrimSpace(view.Comment.Content),
+			CreatedAt: parseTime(view.Comment.Published),
+			Likes:     view.Counts.Upvotes,
+			URL:       base + "/comment/" + id,
+		})
+	}
+	return thread, nil
+}
+
+func lemmyTopic(base string, view lemmyPostView) forum.Topic {
+	id := strconv.Itoa(view.Post.ID)
+	return forum.Topic{
+		ID:        id,
+		Title:     strings.TrimSpace(view.Post.Name),
+		URL:       base + "/post/" + id,
+		Author:    view.Creator.Name,
+		CreatedAt: parseTime(view.Post.Published),
+		Board:     view.Community.Name,
+		Link:      strings.TrimSpace(view.Post.URL),
+		Replies:   view.Counts.Comments,
+		Likes:     view.Counts.Upvotes,
+	}
+}
+
+// lemmyParentID reads the parent comment from a path like "0.12.34"; "0"
+// marks a top-level comment.
+func lemmyParentID(path string) string {
+	parts := strings.Split(path, ".")
+	if len(parts) < 3 {
+		return ""
+	}
+	return parts[len(parts)-2]
+}
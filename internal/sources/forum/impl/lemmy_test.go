@@ -0,0 +1,67 @@
+package impl
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/bakkerme/curator-ai/internal/sources/forum"
+)
+
+func TestLemmyTopicsAndThread(t *testing.T) {
+	var listQuery, commentQuery string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v3/post/list":
+			listQuery = r.URL.RawQuery
+			_, _ = w.Write([]byte(`{"posts": [{"post": {"id": 55, "name": "Llama 3 fine-tuning tips", "url": "https://example.com/guide",
+				"published": "2024-05-01T09:00:00.123456"}, "creator": {"name": "alice"}, "community": {"name": "localllama"},
+				"counts": {"comments": 3, "score": 40, "upvotes": 42}}]}`))
+		case "/api/v3/post":
+			_, _ = w.Write([]byte(`{"post_view": {"post": {"id": 55, "body": "  Some **tips**  ", "published": "2024-05-01T09:00:00Z"},
+				"creator": {"name": "alice"}, "counts": {"upvotes": 42}}}`))
+		case "/api/v3/comment/list":
+			commentQuery = r.URL.RawQuery
+			_, _ = w.Write([]byte(`{"comments": [
+				{"comment": {"id": 1, "content": "Great", "path": "0.1"}, "creator": {"name": "bob"}, "counts": {"upvotes": 9}},
+				{"comment": {"id": 2, "content": "Agreed", "path": "0.1.2"}, "creator": {"name": "carol"}, "counts": {"upvotes": 2}},
+				{"comment": {"id": 3, "content": "", "path": "0.3", "deleted": true}, "creator": {"name": "dave"}}
+			]}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	client := NewLemmyClient(5*time.Second, "")
+	topics, err := client.Topics(context.Background(), server.URL, forum.ListRequest{Board: "!localllama", Sort: "top", Period: "day", Limit: 80})
+	if err != nil {
+		t.Fatalf("topics failed: %v", err)
+	}
+	if listQuery != "community_name=localllama&limit=50&sort=TopDay&type_=All" {
+		t.Fatalf("unexpected list query %q", listQuery)
+	}
+	if len(topics) != 1 {
+		t.Fatalf("expected one topic, got %d", len(topics))
+	}
+	topic := topics[0]
+	if topic.URL != server.URL+"/post/55" || topic.Link != "https://example.com/guide" || topic.Likes != 42 || topic.Replies != 3 || topic.CreatedAt.IsZero() {
+		t.Fatalf("unexpected topic %+v", topic)
+	}
+
+	thread, err := client.Thread(context.Background(), server.URL, topic, 20)
+	if err != nil {
+		t.Fatalf("thread failed: %v", err)
+	}
+	if commentQuery != "limit=20&post_id=55&sort=Top&type_=All" {
+		t.Fatalf("unexpected comment query %q", commentQuery)
+	}
+	if thread.Opening.Content != "Some **tips**" || thread.Opening.Author != "alice" {
+		t.Fatalf("unexpected opening post %+v", thread.Opening)
+	}
+	if len(thread.Replies) != 2 || thread.Replies[0].ParentID != "" || thread.Replies[1].ParentID != "1" || thread.Replies[0].Likes != 9 {
+		t.Fatalf("unexpected replies %+v", thread.Replies)
+	}
+}
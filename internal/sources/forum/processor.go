@@ -0,0 +1,268 @@
+package forum
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bakkerme/curator-ai/internal/config"
+	"github.com/bakkerme/curator-ai/internal/core"
+	"github.com/bakkerme/curator-ai/internal/dedupe"
+	"github.com/bakkerme/curator-ai/internal/sources"
+)
+
+const (
+	defaultLimit      = 10
+	defaultMaxReplies = 20
+	// loadedReplies is how many replies are read per thread before the top
+	// max_replies are kept.
+	loadedReplies = 100
+	dedupePrefix  = "forum:"
+)
+
+// ForumProcessor reads latest or top topics from Discourse categories or
+// Lemmy communities and emits one PostBlock per topic, with the opening post
+// as content and the most-liked replies as comments.
+type ForumProcessor struct {
+	name   string
+	config config.ForumSource
+	client Client
+	store  dedupe.SeenStore
+	logger *slog.Logger
+}
+
+// NewForumProcessor wires a new forum source. client must match the
+// configured flavour.
+func NewForumProcessor(cfg *config.ForumSource, client Client, store dedupe.SeenStore, logger *slog.Logger) (*ForumProcessor, error) {
+	if cfg == nil {
+		return nil, fmt.Errorf("forum config is required")
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &ForumProcessor{
+		name:   "forum",
+		config: *cfg,
+		client: client,
+		store:  store,
+		logger: logger,
+	}, nil
+}
+
+func (p *ForumProcessor) Name() string {
+	return p.name
+}
+
+func (p *ForumProcessor) Configure(config map[string]interface{}) error {
+	return nil
+}
+
+func (p *ForumProcessor) Validate() error {
+	if strings.TrimSpace(p.config.BaseURL) == "" {
+		return fmt.Errorf("forum base_url is required")
+	}
+	switch Flavour(p.config.Flavour) {
+	case FlavourDiscourse, FlavourLemmy:
+	default:
+		return fmt.Errorf("unsupported forum flavour %q", p.config.Flavour)
+	}
+	if p.client == nil {
+		return fmt.Errorf("forum client is required")
+	}
+	return nil
+}
+
+func (p *ForumProcessor) Fetch(ctx context.Context) ([]*core.PostBlock, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	logger := core.LoggerFromContext(ctx).With("stage", "source", "processor", p.name)
+
+	limit := p.config.Limit
+	if limit <= 0 {
+		limit = defaultLimit
+	}
+	sortOrder := p.config.Sort
+	if sortOrder == "" {
+		sortOrder = "latest"
+	}
+	boards := p.config.Categories
+	if Flavour(p.config.Flavour) == FlavourLemmy {
+		boards = p.config.Communities
+	}
+	if len(boards) == 0 {
+		boards = []string{""}
+	}
+
+	var blocks []*core.PostBlock
+	var fetchErrs []error
+	emitted := make(map[string]bool)
+	for _, board := range boards {
+		logger.Info("Fetching forum topics", "flavour", p.config.Flavour, "base_url", p.config.BaseURL, "board", board, "sort", sortOrder)
+		topics, err := p.client.Topics(ctx, p.config.BaseURL, ListRequest{Board: board, Sort: sortOrder, Period: p.config.Period, Limit: limit})
+		if err != nil {
+			// Topics from earlier boards are already marked seen, so one
+			// failing board must not discard them.
+			logger.Warn("Failed to fetch forum topics", "board", board, "error", err)
+			fetchErrs = append(fetchErrs, fmt.Errorf("board %q: %w", board, err))
+			core.RecordRunError(ctx, core.ProcessError{
+				ProcessorName: p.name,
+				Stage:         "source",
+				Error:         fmt.Sprintf("board %q: %v", board, err),
+				OccurredAt:    time.Now().UTC(),
+			})
+			continue
+		}
+		for _, topic := range topics {
+			key := dedupePrefix + topic.URL
+			if emitted[key] || sources.Seen(ctx, p.store, logger, "topic", key) {
+				continue
+			}
+			thread, err := p.client.Thread(ctx, p.config.BaseURL, topic, loadedReplies)
+			if err != nil {
+				logger.Warn("Failed to load forum thread; skipping topic", "topic", topic.URL, "error", err)
+				continue
+			}
+			if topic.Board == "" {
+				topic.Board = board
+			}
+			blocks = append(blocks, p.buildBlock(topic, thread))
+			emitted[key] = true
+			if p.store != nil {
+				if err := p.store.MarkSeen(ctx, key); err != nil {
+					logger.Warn("Failed to mark topic as seen", "topic", key, "error", err)
+				}
+			}
+		}
+	}
+	if len(fetchErrs) == len(boards) {
+		return nil, fmt.Errorf("all forum boards failed: %w", errors.Join(fetchErrs...))
+	}
+	return blocks, nil
+}
+
+func (p *ForumProcessor) buildBlock(topic Topic, thread Thread) *core.PostBlock {
+	maxReplies := p.config.MaxReplies
+	if maxReplies <= 0 {
+		maxReplies = defaultMaxReplies
+	}
+	replies := topReplies(thread.Replies, maxReplies)
+
+	author := topic.Author
+	if author == "" {
+		author = thread.Opening.Author
+	}
+	createdAt := topic.CreatedAt
+	if createdAt.IsZero() {
+		createdAt = thread.Opening.CreatedAt
+	}
+	block := &core.PostBlock{
+		ID:          topic.ID,
+		URL:         topic.URL,
+		Title:       topic.Title,
+		Content:     thread.Opening.Content,
+		Author:      author,
+		CreatedAt:   createdAt,
+		Comments:    commentTree(replies),
+		SummaryPlan: sources.SummaryPlanFromConfig(p.config.SummaryPlan),
+		ProcessedAt: time.Now().UTC(),
+		Metadata: map[string]string{
+			"forum":   p.config.Flavour,
+			"board":   topic.Board,
+			"likes":   strconv.Itoa(topic.Likes),
+			"replies": strconv.Itoa(topic.Replies),
+		},
+	}
+	if topic.Views > 0 {
+		block.Metadata["views"] = strconv.Itoa(topic.Views)
+	}
+	if len(topic.Tags) > 0 {
+		block.Metadata["tags"] = strings.Join(topic.Tags, " ")
+	}
+	if topic.Link != "" {
+		block.WebBlocks = append(block.WebBlocks, core.WebBlock{URL: topic.Link})
+	}
+	if mode := block.SummaryPlan.Mode; mode == core.SummaryModePerChunk || mode == core.SummaryModeMapReduce {
+		block.Chunks = chunkThread(thread.Opening, replies, block.SummaryPlan.MaxChunkChars, block.SummaryPlan.ChunkLimit)
+	}
+	return block
+}
+
+// topReplies keeps the limit most-liked replies in their thread order.
+func topReplies(replies []Post, limit int) []Post {
+	if len(replies) <= limit {
+		return replies
+	}
+	order := make([]int, len(replies))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return replies[order[i]].Likes > replies[order[j]].Likes
+	})
+	order = order[:limit]
+	sort.Ints(order)
+	kept := make([]Post, 0, limit)
+	for _, i := range order {
+		kept = append(kept, replies[i])
+	}
+	return kept
+}
+
+// commentTree nests replies under the kept replies they answer; answers to
+// the opening post or to dropped replies become top-level comments.
+func commentTree(replies []Post) []core.CommentBlock {
+	if len(replies) == 0 {
+		return nil
+	}
+	kept := make(map[string]bool, len(replies))
+	for _, reply := range replies {
+		kept[reply.ID] = true
+	}
+	children := make(map[string][]Post)
+	var top []Post
+	for _, reply := range replies {
+		if reply.ParentID != "" && kept[reply.ParentID] {
+			children[reply.ParentID] = append(children[reply.ParentID], reply)
+		} else {
+			top = append(top, reply)
+		}
+	}
+	var build func([]Post) []core.CommentBlock
+	build = func(posts []Post) []core.CommentBlock {
+		comments := make([]core.CommentBlock, 0, len(posts))
+		for _, post := range posts {
+			comments = append(comments, core.CommentBlock{
+				ID:        post.ID,
+				Author:    post.Author,
+				Content:   post.Content,
+				CreatedAt: post.CreatedAt,
+				Score:     post.Likes,
+				Permalink: post.URL,
+				Replies:   build(children[post.ID]),
+			})
+		}
+		return comments
+	}
+	return build(top)
+}
+
+// chunkThread packs the opening post and replies, in thread order, into
+// chunks of at most maxChars runes. A post longer than maxChars is split.
+func chunkThread(opening Post, replies []Post, maxChars int, limit int) []core.ContentChunk {
+	posts := append([]Post{opening}, replies...)
+	paragraphs := make([]string, 0, len(posts))
+	for i, post := range posts {
+		heading := fmt.Sprintf("Reply by %s (%d likes):", post.Author, post.Likes)
+		if i == 0 {
+			heading = fmt.Sprintf("Opening post by %s:", post.Author)
+		}
+		paragraphs = append(paragraphs, heading+"\n\n"+strings.TrimSpace(post.Content))
+	}
+	return sources.PackChunks(paragraphs, maxChars, limit, nil)
+}
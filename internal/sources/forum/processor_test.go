@@ -0,0 +1,162 @@
+package forum
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/bakkerme/curator-ai/internal/config"
+	"github.com/bakkerme/curator-ai/internal/core"
+)
+
+type clientMock struct {
+	topics   map[string][]Topic
+	errs     map[string]error
+	threads  map[string]Thread
+	requests []ListRequest
+}
+
+func (m *clientMock) Topics(ctx context.Context, baseURL string, request ListRequest) ([]Topic, error) {
+	m.requests = append(m.requests, request)
+	return m.topics[request.Board], m.errs[request.Board]
+}
+
+func (m *clientMock) Thread(ctx context.Context, baseURL string, topic Topic, limit int) (Thread, error) {
+	return m.threads[topic.ID], nil
+}
+
+func TestForumProcessor_KeepsTopRepliesAsCommentTree(t *testing.T) {
+	client := &clientMock{
+		topics: map[string][]Topic{"distributed": {
+			{ID: "1", Title: "DDP hangs", URL: "https://discuss.example/t/ddp-hangs/1", Author: "op", Likes: 12, Replies: 4, Views: 300, Tags: []string{"ddp"}},
+		}},
+		threads: map[string]Thread{"1": {
+			Opening: Post{ID: "10", Author: "op", Content: "DDP hangs at init"},
+			Replies: []Post{
+				{ID: "11", Author: "a", Content: "Check NCCL", Likes: 8},
+				{ID: "12", Author: "b", Content: "+1", Likes: 0},
+				{ID: "13", Author: "op", Content: "That fixed it", Likes: 3, ParentID: "11"},
+				{ID: "14", Author: "c", Content: "Set timeout", Likes: 5},
+			},
+		}},
+	}
+	cfg := &config.ForumSource{Flavour: "discourse", BaseURL: "https://discuss.example", Categories: []string{"distributed"}, Sort: "top", MaxReplies: 3}
+	processor, err := NewForumProcessor(cfg, client, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to create processor: %v", err)
+	}
+
+	blocks, err := processor.Fetch(context.Background())
+	if err != nil {
+		t.Fatalf("fetch failed: %v", err)
+	}
+	if client.requests[0].Sort != "top" || client.requests[0].Limit != defaultLimit {
+		t.Fatalf("unexpected request %+v", client.requests[0])
+	}
+	if len(blocks) != 1 {
+		t.Fatalf("expected one block, got %d", len(blocks))
+	}
+	block := blocks[0]
+	if block.Title != "DDP hangs" || block.Content != "DDP hangs at init" || block.Author != "op" {
+		t.Fatalf("unexpected block %+v", block)
+	}
+	if block.Metadata["forum"] != "discourse" || block.Metadata["board"] != "distributed" || block.Metadata["likes"] != "12" ||
+		block.Metadata["replies"] != "4" || block.Metadata["views"] != "300" || block.Metadata["tags"] != "ddp" {
+		t.Fatalf("unexpected metadata %v", block.Metadata)
+	}
+	if len(block.Comments) != 2 || block.Comments[0].ID != "11" || block.Comments[1].ID != "14" {
+		t.Fatalf("expected the top replies in thread order, got %+v", block.Comments)
+	}
+	if len(block.Comments[0].Replies) != 1 || block.Comments[0].Replies[0].Content != "That fixed it" {
+		t.Fatalf("expected the answer nested under its parent, got %+v", block.Comments[0].Replies)
+	}
+	if block.Chunks != nil {
+		t.Fatalf("expected no chunks for full summaries, got %d", len(block.Chunks))
+	}
+}
+
+func TestForumProcessor_RecordsFailedBoardsAndKeepsOthers(t *testing.T) {
+	client := &clientMock{
+		topics:  map[string][]Topic{"news": {{ID: "1", Title: "Release", URL: "https://discuss.example/t/release/1"}}},
+		errs:    map[string]error{"private": errors.New("403 Forbidden")},
+		threads: map[string]Thread{"1": {Opening: Post{ID: "10", Content: "Out now"}}},
+	}
+	cfg := &config.ForumSource{Flavour: "discourse", BaseURL: "https://discuss.example", Categories: []string{"news", "private"}}
+	processor, err := NewForumProcessor(cfg, client, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to create processor: %v", err)
+	}
+
+	runErrors := &core.RunErrors{}
+	blocks, err := processor.Fetch(core.WithRunErrors(context.Background(), runErrors))
+	if err != nil {
+		t.Fatalf("expected a failing board not to fail the fetch, got %v", err)
+	}
+	if len(blocks) != 1 || blocks[0].ID != "1" {
+		t.Fatalf("expected the healthy board's topic, got %+v", blocks)
+	}
+	if errs := runErrors.Errors(); len(errs) != 1 || !strings.Contains(errs[0].Error, `"private"`) {
+		t.Fatalf("expected the failed board recorded as a run error, got %+v", errs)
+	}
+
+	cfg.Categories = []string{"private"}
+	processor, err = NewForumProcessor(cfg, client, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to create processor: %v", err)
+	}
+	if _, err := processor.Fetch(context.Background()); err == nil || !strings.Contains(err.Error(), "all forum boards failed") {
+		t.Fatalf("expected an error when every board fails, got %v", err)
+	}
+}
+
+func TestForumProcessor_ChunksLongThreadsForMapReduce(t *testing.T) {
+	long := strings.Repeat("x", 250)
+	replies := make([]Post, 0, 6)
+	for i := 0; i < 6; i++ {
+		replies = append(replies, Post{ID: string(rune('a' + i)), Author: "user", Content: long, Likes: i})
+	}
+	client := &clientMock{
+		topics:  map[string][]Topic{"": {{ID: "55", Title: "Long thread", URL: "https://lemmy.example/post/55", Link: "https://example.com/guide"}}},
+		threads: map[string]Thread{"55": {Opening: Post{ID: "55", Author: "alice", Content: long}, Replies: replies}},
+	}
+	cfg := &config.ForumSource{
+		Flavour:     "lemmy",
+		BaseURL:     "https://lemmy.example",
+		SummaryPlan: &config.SummaryPlanConfig{Mode: core.SummaryModeMapReduce, MaxChunkChars: 600, ChunkLimit: 3},
+	}
+	processor, err := NewForumProcessor(cfg, client, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to create processor: %v", err)
+	}
+
+	blocks, err := processor.Fetch(context.Background())
+	if err != nil {
+		t.Fatalf("fetch failed: %v", err)
+	}
+	if len(blocks) != 1 {
+		t.Fatalf("expected one block, got %d", len(blocks))
+	}
+	block := blocks[0]
+	if block.Author != "alice" || len(block.WebBlocks) != 1 || block.WebBlocks[0].URL != "https://example.com/guide" {
+		t.Fatalf("unexpected block %+v", block)
+	}
+	if len(block.Chunks) != 3 {
+		t.Fatalf("expected chunk_limit to cap chunks at 3, got %d", len(block.Chunks))
+	}
+	if !strings.HasPrefix(block.Chunks[0].Content, "Opening post by alice:") || !strings.Contains(block.Chunks[0].Content, "Reply by user (0 likes):") {
+		t.Fatalf("unexpected first chunk %q", block.Chunks[0].Content)
+	}
+	for _, chunk := range block.Chunks {
+		if len([]rune(chunk.Content)) > 600 {
+			t.Fatalf("chunk exceeds max_chunk_chars: %d", len([]rune(chunk.Content)))
+		}
+	}
+}
+
+func TestChunkThread_SplitsOversizedPosts(t *testing.T) {
+	chunks := chunkThread(Post{Author: "op", Content: strings.Repeat("y", 2500)}, nil, 1000, 0)
+	if len(chunks) != 3 {
+		t.Fatalf("expected an oversized post to split into 3 chunks, got %d", len(chunks))
+	}
+}
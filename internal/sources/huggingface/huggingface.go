@@ -0,0 +1,49 @@
+package huggingface
+
+import (
+	"context"
+	"time"
+)
+
+// DailyPaper is an entry from the Hugging Face Daily Papers feed.
+type DailyPaper struct {
+	// ArxivID is the paper's arXiv identifier, which is also its Hugging Face ID.
+	ArxivID     string
+	Title       string
+	Summary     string
+	Authors     []string
+	Upvotes     int
+	Comments    int
+	PublishedAt time.Time
+}
+
+// ModelQuery selects models from the Hub model listing.
+type ModelQuery struct {
+	// Sort is "trending" or "created".
+	Sort        string
+	PipelineTag string
+	Library     string
+	Search      string
+	Author      string
+	Limit       int
+}
+
+// Model is a Hub model listing entry.
+type Model struct {
+	ID          string
+	Author      string
+	PipelineTag string
+	Library     string
+	Tags        []string
+	Downloads   int
+	Likes       int
+	CreatedAt   time.Time
+}
+
+// Client reads the Hugging Face Hub API.
+type Client interface {
+	DailyPapers(ctx context.Context, date string) ([]DailyPaper, error)
+	Models(ctx context.Context, query ModelQuery) ([]Model, error)
+	// ModelURL returns the model card page for id.
+	ModelURL(id string) string
+}
@@ -0,0 +1,218 @@
+package impl
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bakkerme/curator-ai/internal/retry"
+	"github.com/bakkerme/curator-ai/internal/sources/huggingface"
+)
+
+const defaultBaseURL = "https://huggingface.co"
+
+// Client calls the public Hugging Face Hub API.
+type Client struct {
+	client    *http.Client
+	baseURL   string
+	token     string
+	userAgent string
+}
+
+// NewClient builds a Hub API client for baseURL (default: https://huggingface.co).
+// token is optional and raises rate limits.
+func NewClient(timeout time.Duration, baseURL string, token string, userAgent string) *Client {
+	if timeout <= 0 {
+		timeout = 15 * time.Second
+	}
+	if strings.TrimSpace(baseURL) == "" {
+		baseURL = defaultBaseURL
+	}
+	if strings.TrimSpace(userAgent) == "" {
+		userAgent = "curator-ai/0.1"
+	}
+	return &Client{
+		client:    &http.Client{Timeout: timeout},
+		baseURL:   strings.TrimRight(baseURL, "/"),
+		token:     token,
+		userAgent: userAgent,
+	}
+}
+
+type dailyPaperEntry struct {
+	Paper struct {
+		ID      string `json:"id"`
+		Title   string `json:"title"`
+		Summary string `json:"summary"`
+		Authors []struct {
+			Name string `json:"name"`
+		} `json:"authors"`
+		Upvotes     int    `json:"upvotes"`
+		PublishedAt string `json:"publishedAt"`
+	} `json:"paper"`
+	Title       string `json:"title"`
+	NumComments int    `json:"numComments"`
+	PublishedAt string `json:"publishedAt"`
+}
+
+type modelEntry struct {
+	ID          string   `json:"id"`
+	ModelID     string   `json:"modelId"`
+	Author      string   `json:"author"`
+	PipelineTag string   `json:"pipeline_tag"`
+	Library     string   `json:"library_name"`
+	Tags        []string `json:"tags"`
+	Downloads   int      `json:"downloads"`
+	Likes       int      `json:"likes"`
+	CreatedAt   string   `json:"createdAt"`
+}
+
+// DailyPapers lists the Daily Papers for date (YYYY-MM-DD), or the latest day when empty.
+func (c *Client) DailyPapers(ctx context.Context, date string) ([]huggingface.DailyPaper, error) {
+	values := url.Values{}
+	if date = strings.TrimSpace(date); date != "" {
+		values.Set("date", date)
+	}
+	var entries []dailyPaperEntry
+	if err := c.getJSON(ctx, "/api/daily_papers", values, &entries); err != nil {
+		return nil, fmt.Errorf("hugging face daily papers: %w", err)
+	}
+	papers := make([]huggingface.DailyPaper, 0, len(entries))
+	for _, entry := range entries {
+		id := strings.TrimSpace(entry.Paper.ID)
+		if id == "" {
+			continue
+		}
+		title := strings.TrimSpace(entry.Paper.Title)
+		if title == "" {
+			title = strings.TrimSpace(entry.Title)
+		}
+		paper := huggingface.DailyPaper{
+			ArxivID:     id,
+			Title:       title,
+			Summary:     strings.TrimSpace(entry.Paper.Summary),
+			Upvotes:     entry.Paper.Upvotes,
+			Comments:    entry.NumComments,
+			PublishedAt: parseTime(entry.PublishedAt),
+		}
+		if paper.PublishedAt.IsZero() {
+			paper.PublishedAt = parseTime(entry.Paper.PublishedAt)
+		}
+		for _, author := range entry.Paper.Authors {
+			if name := strings.TrimSpace(author.Name); name != "" {
+				paper.Authors = append(paper.Authors, name)
+			}
+		}
+		papers = append(papers, paper)
+	}
+	return papers, nil
+}
+
+// Models lists Hub models matching query, most trending or newest first.
+func (c *Client) Models(ctx context.Context, query huggingface.ModelQuery) ([]huggingface.Model, error) {
+	values := url.Values{}
+	switch query.Sort {
+	case "created":
+		values.Set("sort", "createdAt")
+	default:
+		values.Set("sort", "trendingScore")
+	}
+	values.Set("direction", "-1")
+	if query.Limit > 0 {
+		values.Set("limit", strconv.Itoa(query.Limit))
+	}
+	if tag := strings.TrimSpace(query.PipelineTag); tag != "" {
+		values.Set("pipeline_tag", tag)
+	}
+	if library := strings.TrimSpace(query.Library); library != "" {
+		values.Set("library", library)
+	}
+	if search := strings.TrimSpace(query.Search); search != "" {
+		values.Set("search", search)
+	}
+	if author := strings.TrimSpace(query.Author); author != "" {
+		values.Set("author", author)
+	}
+	// full=true includes createdAt, downloads and likes for each model.
+	values.Set("full", "true")
+
+	var entries []modelEntry
+	if err := c.getJSON(ctx, "/api/models", values, &entries); err != nil {
+		return nil, fmt.Errorf("hugging face models: %w", err)
+	}
+	models := make([]huggingface.Model, 0, len(entries))
+	for _, entry := range entries {
+		id := strings.TrimSpace(entry.ID)
+		if id == "" {
+			id = strings.TrimSpace(entry.ModelID)
+		}
+		if id == "" {
+			continue
+		}
+		author := strings.TrimSpace(entry.Author)
+		if author == "" {
+			author, _, _ = strings.Cut(id, "/")
+		}
+		models = append(models, huggingface.Model{
+			ID:          id,
+			Author:      author,
+			PipelineTag: strings.TrimSpace(entry.PipelineTag),
+			Library:     strings.TrimSpace(entry.Library),
+			Tags:        entry.Tags,
+			Downloads:   entry.Downloads,
+			Likes:       entry.Likes,
+			CreatedAt:   parseTime(entry.CreatedAt),
+		})
+	}
+	return models, nil
+}
+
+// ModelURL returns the model card page on the configured host.
+func (c *Client) ModelURL(id string) string {
+	return c.baseURL + "/" + strings.TrimLeft(id, "/")
+}
+
+func (c *Client) getJSON(ctx context.Context, path string, values url.Values, out interface{}) error {
+	endpoint := c.baseURL + path
+	if len(values) > 0 {
+		endpoint += "?" + values.Encode()
+	}
+	return retry.Do(ctx, retry.Config{Attempts: 3, BaseDelay: 200 * time.Millisecond}, func() error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+		if err != nil {
+			return retry.Permanent(err)
+		}
+		req.Header.Set("User-Agent", c.userAgent)
+		req.Header.Set("Accept", "application/json")
+		if c.token != "" {
+			req.Header.Set("Authorization", "Bearer "+c.token)
+		}
+		resp, err := c.client.Do(req)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = resp.Body.Close() }()
+		if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError {
+			return fmt.Errorf("transient status %s", resp.Status)
+		}
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+			return retry.Permanent(fmt.Errorf("status %s: %s", resp.Status, strings.TrimSpace(string(body))))
+		}
+		return json.NewDecoder(resp.Body).Decode(out)
+	})
+}
+
+func parseTime(value string) time.Time {
+	parsed, err := time.Parse(time.RFC3339, strings.TrimSpace(value))
+	if err != nil {
+		return time.Time{}
+	}
+	return parsed
+}
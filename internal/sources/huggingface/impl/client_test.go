@@ -0,0 +1,84 @@
+package impl
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/bakkerme/curator-ai/internal/sources/huggingface"
+)
+
+func TestDailyPapers_MapsFixture(t *testing.T) {
+	var gotDate, gotAuth string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/daily_papers" {
+			http.NotFound(w, r)
+			return
+		}
+		gotDate = r.URL.Query().Get("date")
+		gotAuth = r.Header.Get("Authorization")
+		_, _ = w.Write([]byte(`[
+			{"paper": {"id": "2401.12345", "title": "Scaling Things", "summary": " We scale. ", "upvotes": 42,
+			  "authors": [{"name": "Ada Lovelace"}, {"name": " "}], "publishedAt": "2024-01-22T10:00:00.000Z"},
+			 "title": "Scaling Things", "numComments": 3, "publishedAt": "2024-01-23T08:00:00.000Z"},
+			{"paper": {"id": ""}, "title": "no id"}
+		]`))
+	}))
+	defer server.Close()
+
+	client := NewClient(5*time.Second, server.URL, "secret", "")
+	papers, err := client.DailyPapers(context.Background(), "2024-01-23")
+	if err != nil {
+		t.Fatalf("daily papers failed: %v", err)
+	}
+	if gotDate != "2024-01-23" || gotAuth != "Bearer secret" {
+		t.Fatalf("unexpected request date %q auth %q", gotDate, gotAuth)
+	}
+	if len(papers) != 1 {
+		t.Fatalf("expected 1 paper, got %d", len(papers))
+	}
+	paper := papers[0]
+	if paper.ArxivID != "2401.12345" || paper.Upvotes != 42 || paper.Comments != 3 || paper.Summary != "We scale." {
+		t.Fatalf("unexpected paper %+v", paper)
+	}
+	if len(paper.Authors) != 1 || paper.PublishedAt.Day() != 23 {
+		t.Fatalf("unexpected authors or date %+v", paper)
+	}
+}
+
+func TestModels_SendsFiltersAndMapsFixture(t *testing.T) {
+	var query map[string]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = map[string]string{}
+		for key := range r.URL.Query() {
+			query[key] = r.URL.Query().Get(key)
+		}
+		_, _ = w.Write([]byte(`[
+			{"id": "org/model-7b", "likes": 120, "downloads": 5000, "pipeline_tag": "text-generation",
+			 "library_name": "transformers", "tags": ["llama"], "createdAt": "2024-02-01T00:00:00.000Z"}
+		]`))
+	}))
+	defer server.Close()
+
+	client := NewClient(5*time.Second, server.URL+"/", "", "")
+	models, err := client.Models(context.Background(), huggingface.ModelQuery{
+		Sort:        "created",
+		PipelineTag: "text-generation",
+		Library:     "transformers",
+		Limit:       10,
+	})
+	if err != nil {
+		t.Fatalf("models failed: %v", err)
+	}
+	if query["sort"] != "createdAt" || query["direction"] != "-1" || query["pipeline_tag"] != "text-generation" || query["library"] != "transformers" || query["limit"] != "10" {
+		t.Fatalf("unexpected query %v", query)
+	}
+	if len(models) != 1 || models[0].Author != "org" || models[0].Downloads != 5000 || models[0].Library != "transformers" {
+		t.Fatalf("unexpected models %+v", models)
+	}
+	if got := client.ModelURL("org/model-7b"); got != server.URL+"/org/model-7b" {
+		t.Fatalf("unexpected model url %q", got)
+	}
+}
@@ -0,0 +1,245 @@
+package huggingface
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bakkerme/curator-ai/internal/config"
+	"github.com/bakkerme/curator-ai/internal/core"
+	"github.com/bakkerme/curator-ai/internal/dedupe"
+	"github.com/bakkerme/curator-ai/internal/sources"
+	"github.com/bakkerme/curator-ai/internal/sources/reader"
+)
+
+const (
+	defaultModelLimit = 20
+	modelKeyPrefix    = "hf-model:"
+)
+
+// HuggingFaceProcessor emits Daily Papers and Hub models as PostBlocks.
+// Papers use their arXiv ID as block ID and dedupe key, so a paper already
+// emitted by an arXiv source sharing the dedupe store is skipped (and vice
+// versa). Model cards are read through the reader.
+type HuggingFaceProcessor struct {
+	name   string
+	config config.HuggingFaceSource
+	client Client
+	reader reader.Reader
+	store  dedupe.SeenStore
+	logger *slog.Logger
+}
+
+// NewHuggingFaceProcessor wires a new Hugging Face source processor.
+func NewHuggingFaceProcessor(cfg *config.HuggingFaceSource, client Client, r reader.Reader, store dedupe.SeenStore, logger *slog.Logger) (*HuggingFaceProcessor, error) {
+	if cfg == nil {
+		return nil, fmt.Errorf("huggingface config is required")
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &HuggingFaceProcessor{
+		name:   "huggingface",
+		config: *cfg,
+		client: client,
+		reader: r,
+		store:  store,
+		logger: logger,
+	}, nil
+}
+
+func (p *HuggingFaceProcessor) Name() string {
+	return p.name
+}
+
+func (p *HuggingFaceProcessor) Configure(config map[string]interface{}) error {
+	return nil
+}
+
+func (p *HuggingFaceProcessor) Validate() error {
+	if p.config.DailyPapers == nil && p.config.Models == nil {
+		return fmt.Errorf("huggingface daily_papers or models are required")
+	}
+	if p.client == nil {
+		return fmt.Errorf("huggingface client is required")
+	}
+	if p.config.Models != nil && p.reader == nil {
+		return fmt.Errorf("huggingface models require a reader for model cards")
+	}
+	return nil
+}
+
+func (p *HuggingFaceProcessor) Fetch(ctx context.Context) ([]*core.PostBlock, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	logger := core.LoggerFromContext(ctx).With("stage", "source", "processor", p.name)
+
+	var blocks []*core.PostBlock
+	if p.config.DailyPapers != nil {
+		paperBlocks, err := p.fetchDailyPapers(ctx, logger)
+		if err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, paperBlocks...)
+	}
+	if p.config.Models != nil {
+		modelBlocks, err := p.fetchModels(ctx, logger)
+		if err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, modelBlocks...)
+	}
+	return blocks, nil
+}
+
+func (p *HuggingFaceProcessor) fetchDailyPapers(ctx context.Context, logger *slog.Logger) ([]*core.PostBlock, error) {
+	cfg := p.config.DailyPapers
+	logger.Info("Fetching Hugging Face daily papers", "date", cfg.Date)
+	papers, err := p.client.DailyPapers(ctx, cfg.Date)
+	if err != nil {
+		return nil, err
+	}
+
+	var blocks []*core.PostBlock
+	for _, paper := range papers {
+		if cfg.Limit > 0 && len(blocks) >= cfg.Limit {
+			break
+		}
+		if paper.Upvotes < cfg.MinUpvotes {
+			continue
+		}
+		if sources.Seen(ctx, p.store, logger, "item", paper.ArxivID) {
+			continue
+		}
+		blocks = append(blocks, &core.PostBlock{
+			ID:          paper.ArxivID,
+			URL:         "https://arxiv.org/abs/" + paper.ArxivID,
+			Title:       paper.Title,
+			Content:     paper.Summary,
+			Author:      strings.Join(paper.Authors, ", "),
+			Authors:     authorList(paper.Authors),
+			CreatedAt:   paper.PublishedAt,
+			SummaryPlan: sources.SummaryPlanFromConfig(p.config.SummaryPlan),
+			ProcessedAt: time.Now().UTC(),
+			Metadata: map[string]string{
+				"hf_kind":     "paper",
+				"arxiv_id":    paper.ArxivID,
+				"upvotes":     strconv.Itoa(paper.Upvotes),
+				"hf_comments": strconv.Itoa(paper.Comments),
+			},
+		})
+		p.markSeen(ctx, logger, paper.ArxivID)
+	}
+	return blocks, nil
+}
+
+func (p *HuggingFaceProcessor) fetchModels(ctx context.Context, logger *slog.Logger) ([]*core.PostBlock, error) {
+	cfg := p.config.Models
+	query := ModelQuery{
+		Sort:        cfg.Sort,
+		PipelineTag: cfg.PipelineTag,
+		Library:     cfg.Library,
+		Search:      cfg.Search,
+		Author:      cfg.Author,
+		Limit:       cfg.Limit,
+	}
+	if query.Limit <= 0 {
+		query.Limit = defaultModelLimit
+	}
+	logger.Info("Fetching Hugging Face models", "sort", query.Sort, "pipeline_tag", query.PipelineTag, "library", query.Library)
+	models, err := p.client.Models(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var blocks []*core.PostBlock
+	for _, model := range models {
+		if model.Downloads < cfg.MinDownloads || model.Likes < cfg.MinLikes {
+			continue
+		}
+		key := modelKeyPrefix + model.ID
+		if sources.Seen(ctx, p.store, logger, "item", key) {
+			continue
+		}
+		url := p.client.ModelURL(model.ID)
+		block := &core.PostBlock{
+			ID:          model.ID,
+			URL:         url,
+			Title:       model.ID,
+			Author:      model.Author,
+			CreatedAt:   model.CreatedAt,
+			SummaryPlan: sources.SummaryPlanFromConfig(p.config.SummaryPlan),
+			ProcessedAt: time.Now().UTC(),
+			Metadata: map[string]string{
+				"hf_kind":   "model",
+				"downloads": strconv.Itoa(model.Downloads),
+				"likes":     strconv.Itoa(model.Likes),
+			},
+		}
+		if model.PipelineTag != "" {
+			block.Metadata["pipeline_tag"] = model.PipelineTag
+		}
+		if model.Library != "" {
+			block.Metadata["library"] = model.Library
+		}
+
+		card, err := p.reader.Read(ctx, url)
+		if err != nil || strings.TrimSpace(card) == "" {
+			msg := "model card is empty"
+			if err != nil {
+				msg = fmt.Sprintf("model card fetch failed: %v", err)
+			}
+			logger.Warn("Failed to read model card", "model", model.ID, "url", url, "error", msg)
+			block.Errors = append(block.Errors, core.ProcessError{
+				ProcessorName: p.name,
+				Stage:         "source",
+				Error:         msg,
+				OccurredAt:    time.Now().UTC(),
+			})
+			card = modelSummary(model)
+		}
+		block.Content = card
+		blocks = append(blocks, block)
+		p.markSeen(ctx, logger, key)
+	}
+	return blocks, nil
+}
+
+func (p *HuggingFaceProcessor) markSeen(ctx context.Context, logger *slog.Logger, key string) {
+	if p.store == nil {
+		return
+	}
+	if err := p.store.MarkSeen(ctx, key); err != nil {
+		logger.Warn("Failed to mark item as seen", "id", key, "error", err)
+	}
+}
+
+// modelSummary stands in for a model card that could not be read.
+func modelSummary(model Model) string {
+	parts := []string{model.ID}
+	if model.PipelineTag != "" {
+		parts = append(parts, "pipeline: "+model.PipelineTag)
+	}
+	if model.Library != "" {
+		parts = append(parts, "library: "+model.Library)
+	}
+	if len(model.Tags) > 0 {
+		parts = append(parts, "tags: "+strings.Join(model.Tags, ", "))
+	}
+	return strings.Join(parts, "\n")
+}
+
+func authorList(names []string) []core.Author {
+	if len(names) == 0 {
+		return nil
+	}
+	authors := make([]core.Author, 0, len(names))
+	for _, name := range names {
+		authors = append(authors, core.Author{Name: name})
+	}
+	return authors
+}
@@ -0,0 +1,120 @@
+package huggingface
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/bakkerme/curator-ai/internal/config"
+)
+
+type clientMock struct {
+	papers []DailyPaper
+	models []Model
+	query  ModelQuery
+}
+
+func (m *clientMock) DailyPapers(ctx context.Context, date string) ([]DailyPaper, error) {
+	return m.papers, nil
+}
+
+func (m *clientMock) Models(ctx context.Context, query ModelQuery) ([]Model, error) {
+	m.query = query
+	return m.models, nil
+}
+
+func (m *clientMock) ModelURL(id string) string { return "https://hf.test/" + id }
+
+type readerMock struct {
+	pages map[string]string
+}
+
+func (m *readerMock) Read(ctx context.Context, url string) (string, error) {
+	if page, ok := m.pages[url]; ok {
+		return page, nil
+	}
+	return "", errors.New("not found")
+}
+
+type seenStoreMock struct {
+	seen map[string]bool
+}
+
+func (m *seenStoreMock) HasSeen(ctx context.Context, id string) (bool, error) { return m.seen[id], nil }
+func (m *seenStoreMock) MarkSeen(ctx context.Context, id string) error        { m.seen[id] = true; return nil }
+func (m *seenStoreMock) MarkSeenBatch(ctx context.Context, ids []string) error {
+	for _, id := range ids {
+		m.seen[id] = true
+	}
+	return nil
+}
+func (m *seenStoreMock) Close() error { return nil }
+
+func TestHuggingFaceProcessor_DailyPapersShareArxivDedupeKeys(t *testing.T) {
+	client := &clientMock{papers: []DailyPaper{
+		{ArxivID: "2401.00001", Title: "Seen on arXiv", Upvotes: 50},
+		{ArxivID: "2401.00002", Title: "Popular", Summary: "Abstract.", Upvotes: 30, Authors: []string{"A"}},
+		{ArxivID: "2401.00003", Title: "Quiet", Upvotes: 1},
+	}}
+	store := &seenStoreMock{seen: map[string]bool{"2401.00001": true}}
+	cfg := &config.HuggingFaceSource{DailyPapers: &config.HFDailyPapersConfig{MinUpvotes: 10}}
+	processor, err := NewHuggingFaceProcessor(cfg, client, nil, store, nil)
+	if err != nil {
+		t.Fatalf("failed to create processor: %v", err)
+	}
+
+	blocks, err := processor.Fetch(context.Background())
+	if err != nil {
+		t.Fatalf("fetch failed: %v", err)
+	}
+	if len(blocks) != 1 || blocks[0].ID != "2401.00002" {
+		t.Fatalf("expected only the unseen popular paper, got %+v", blocks)
+	}
+	if blocks[0].Metadata["arxiv_id"] != "2401.00002" || blocks[0].Metadata["upvotes"] != "30" || blocks[0].URL != "https://arxiv.org/abs/2401.00002" {
+		t.Fatalf("unexpected paper block %+v", blocks[0])
+	}
+	if !store.seen["2401.00002"] {
+		t.Fatalf("expected paper to be marked seen under its arXiv ID")
+	}
+}
+
+func TestHuggingFaceProcessor_ModelsFilterAndReadCards(t *testing.T) {
+	client := &clientMock{models: []Model{
+		{ID: "org/big", Author: "org", Downloads: 900, Likes: 40, PipelineTag: "text-generation"},
+		{ID: "org/small", Author: "org", Downloads: 10, Likes: 40},
+		{ID: "org/nocard", Author: "org", Downloads: 900, Likes: 40, Library: "gguf"},
+	}}
+	reader := &readerMock{pages: map[string]string{"https://hf.test/org/big": "# Big model card"}}
+	cfg := &config.HuggingFaceSource{Models: &config.HFModelsConfig{Sort: "created", PipelineTag: "text-generation", MinDownloads: 100}}
+	processor, err := NewHuggingFaceProcessor(cfg, client, reader, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to create processor: %v", err)
+	}
+
+	blocks, err := processor.Fetch(context.Background())
+	if err != nil {
+		t.Fatalf("fetch failed: %v", err)
+	}
+	if client.query.Sort != "created" || client.query.Limit != defaultModelLimit || client.query.PipelineTag != "text-generation" {
+		t.Fatalf("unexpected model query %+v", client.query)
+	}
+	if len(blocks) != 2 {
+		t.Fatalf("expected 2 models after download filter, got %d", len(blocks))
+	}
+	if blocks[0].Content != "# Big model card" || blocks[0].Metadata["hf_kind"] != "model" || blocks[0].Metadata["downloads"] != "900" {
+		t.Fatalf("unexpected model block %+v", blocks[0])
+	}
+	if len(blocks[1].Errors) != 1 || blocks[1].Content == "" {
+		t.Fatalf("expected card failure to be recorded with fallback content, got %+v", blocks[1])
+	}
+}
+
+func TestHuggingFaceProcessor_ModelsRequireReader(t *testing.T) {
+	processor, err := NewHuggingFaceProcessor(&config.HuggingFaceSource{Models: &config.HFModelsConfig{}}, &clientMock{}, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to create processor: %v", err)
+	}
+	if err := processor.Validate(); err == nil {
+		t.Fatalf("expected validation error without reader")
+	}
+}
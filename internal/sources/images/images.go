@@ -0,0 +1,20 @@
+package images
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrTooLarge is returned by fetchers when an image exceeds the configured byte limit.
+var ErrTooLarge = errors.New("image exceeds max bytes")
+
+// FetchOptions controls image download behavior.
+type FetchOptions struct {
+	MaxBytes  int64
+	UserAgent string
+}
+
+// Fetcher downloads raw image bytes.
+type Fetcher interface {
+	Fetch(ctx context.Context, url string, options FetchOptions) ([]byte, error)
+}
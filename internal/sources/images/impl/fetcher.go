@@ -0,0 +1,87 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/bakkerme/curator-ai/internal/retry"
+	"github.com/bakkerme/curator-ai/internal/sources/images"
+)
+
+const defaultMaxBytes = 10 << 20 // 10 MiB
+
+type Fetcher struct {
+	client    *http.Client
+	userAgent string
+}
+
+func NewFetcher(timeout time.Duration, userAgent string) *Fetcher {
+	if timeout <= 0 {
+		timeout = 15 * time.Second
+	}
+	return &Fetcher{
+		client:    &http.Client{Timeout: timeout},
+		userAgent: userAgent,
+	}
+}
+
+func (f *Fetcher) Fetch(ctx context.Context, url string, options images.FetchOptions) ([]byte, error) {
+	maxBytes := options.MaxBytes
+	if maxBytes <= 0 {
+		maxBytes = defaultMaxBytes
+	}
+	userAgent := options.UserAgent
+	if userAgent == "" {
+		userAgent = f.userAgent
+	}
+
+	var body []byte
+	err := retry.Do(ctx, retry.Config{Attempts: 3, BaseDelay: 200 * time.Millisecond}, func() error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return retry.Permanent(err)
+		}
+		if userAgent != "" {
+			req.Header.Set("User-Agent", userAgent)
+		}
+		req.Header.Set("Accept", "image/*")
+
+		resp, err := f.client.Do(req)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = resp.Body.Close() }()
+
+		if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError {
+			return fmt.Errorf("image fetch transient error: %s", resp.Status)
+		}
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			return retry.Permanent(fmt.Errorf("image fetch failed: %s", resp.Status))
+		}
+		if resp.ContentLength > maxBytes {
+			return retry.Permanent(images.ErrTooLarge)
+		}
+		contentType := strings.ToLower(resp.Header.Get("Content-Type"))
+		if contentType != "" && !strings.HasPrefix(contentType, "image/") && !strings.HasPrefix(contentType, "application/octet-stream") {
+			return retry.Permanent(fmt.Errorf("unexpected content type %q", contentType))
+		}
+
+		data, err := io.ReadAll(io.LimitReader(resp.Body, maxBytes+1))
+		if err != nil {
+			return err
+		}
+		if int64(len(data)) > maxBytes {
+			return retry.Permanent(images.ErrTooLarge)
+		}
+		body = data
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("fetch image: %w", err)
+	}
+	return body, nil
+}
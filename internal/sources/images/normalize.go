@@ -0,0 +1,153 @@
+package images
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	_ "image/gif"
+	"image/jpeg"
+	"image/png"
+	"strings"
+
+	"github.com/gabriel-vasile/mimetype"
+)
+
+// NormalizeOptions controls how fetched image bytes are validated and resized.
+type NormalizeOptions struct {
+	AllowedMIMETypes []string
+	// MaxDimension downsizes images whose longer edge exceeds this many pixels.
+	MaxDimension int
+	// MinDimension drops images whose width or height is below this many pixels
+	// (tracking pixels, spacers, icons).
+	MinDimension int
+	JPEGQuality  int
+}
+
+// Rejection explains why an image was dropped during normalization. It is not an
+// error: the image was readable but should not be sent to a model.
+type Rejection struct {
+	Reason string
+}
+
+func (r *Rejection) Error() string {
+	return r.Reason
+}
+
+// Normalize validates the MIME type and dimensions of data and downsizes it when
+// it is larger than MaxDimension. Formats the standard library cannot decode
+// (e.g. WebP) are passed through unchanged when their MIME type is explicitly
+// allowed; they are not in the default allow-list.
+func Normalize(data []byte, options NormalizeOptions) ([]byte, string, error) {
+	if len(data) == 0 {
+		return nil, "", &Rejection{Reason: "empty image"}
+	}
+	mime := mimetype.Detect(data).String()
+	if !mimeAllowed(mime, options.AllowedMIMETypes) {
+		return nil, mime, &Rejection{Reason: fmt.Sprintf("mime type %q not allowed", mime)}
+	}
+
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		// Allowed but not decodable here; size limits were already enforced on download.
+		return data, mime, nil
+	}
+	if options.MinDimension > 0 && (cfg.Width < options.MinDimension || cfg.Height < options.MinDimension) {
+		return nil, mime, &Rejection{Reason: fmt.Sprintf("image too small (%dx%d)", cfg.Width, cfg.Height)}
+	}
+	if options.MaxDimension <= 0 || (cfg.Width <= options.MaxDimension && cfg.Height <= options.MaxDimension) {
+		return data, mime, nil
+	}
+
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, mime, fmt.Errorf("decode image: %w", err)
+	}
+	resized := downscale(src, options.MaxDimension)
+
+	var buf bytes.Buffer
+	if mime == "image/png" && !resized.Opaque() {
+		if err := png.Encode(&buf, resized); err != nil {
+			return nil, mime, fmt.Errorf("encode png: %w", err)
+		}
+		return buf.Bytes(), "image/png", nil
+	}
+	quality := options.JPEGQuality
+	if quality <= 0 || quality > 100 {
+		quality = 85
+	}
+	if err := jpeg.Encode(&buf, flatten(resized), &jpeg.Options{Quality: quality}); err != nil {
+		return nil, mime, fmt.Errorf("encode jpeg: %w", err)
+	}
+	return buf.Bytes(), "image/jpeg", nil
+}
+
+func mimeAllowed(mime string, allowed []string) bool {
+	if !strings.HasPrefix(mime, "image/") {
+		return false
+	}
+	if len(allowed) == 0 {
+		return true
+	}
+	for _, candidate := range allowed {
+		if strings.EqualFold(strings.TrimSpace(candidate), mime) {
+			return true
+		}
+	}
+	return false
+}
+
+// downscale resizes src so its longer edge is maxDimension using box filtering,
+// which averages every source pixel that falls into a destination pixel.
+func downscale(src image.Image, maxDimension int) *image.RGBA {
+	bounds := src.Bounds()
+	srcW, srcH := bounds.Dx(), bounds.Dy()
+	dstW, dstH := srcW, srcH
+	if srcW >= srcH {
+		dstW = maxDimension
+		dstH = max(1, srcH*maxDimension/srcW)
+	} else {
+		dstH = maxDimension
+		dstW = max(1, srcW*maxDimension/srcH)
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, dstW, dstH))
+	for y := 0; y < dstH; y++ {
+		y0 := bounds.Min.Y + y*srcH/dstH
+		y1 := max(y0+1, bounds.Min.Y+(y+1)*srcH/dstH)
+		for x := 0; x < dstW; x++ {
+			x0 := bounds.Min.X + x*srcW/dstW
+			x1 := max(x0+1, bounds.Min.X+(x+1)*srcW/dstW)
+			var r, g, b, a, n uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					cr, cg, cb, ca := src.At(sx, sy).RGBA()
+					r += uint64(cr)
+					g += uint64(cg)
+					b += uint64(cb)
+					a += uint64(ca)
+					n++
+				}
+			}
+			dst.SetRGBA(x, y, color.RGBA{
+				R: uint8((r / n) >> 8),
+				G: uint8((g / n) >> 8),
+				B: uint8((b / n) >> 8),
+				A: uint8((a / n) >> 8),
+			})
+		}
+	}
+	return dst
+}
+
+// flatten composites img over white so transparent regions don't turn black in JPEG output.
+func flatten(img *image.RGBA) image.Image {
+	if img.Opaque() {
+		return img
+	}
+	out := image.NewRGBA(img.Bounds())
+	draw.Draw(out, out.Bounds(), image.White, image.Point{}, draw.Src)
+	draw.Draw(out, out.Bounds(), img, img.Bounds().Min, draw.Over)
+	return out
+}
@@ -0,0 +1,263 @@
+package images
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bakkerme/curator-ai/internal/config"
+	"github.com/bakkerme/curator-ai/internal/core"
+)
+
+const (
+	defaultMaxBytes       = 10 << 20 // 10 MiB
+	defaultMaxDimension   = 1568
+	defaultMinDimension   = 32
+	defaultMaxConcurrency = 4
+)
+
+// defaultAllowedMIMETypes lists the formats Normalize can decode and check.
+// WebP is left out: it would pass through without dimension checks.
+var defaultAllowedMIMETypes = []string{"image/jpeg", "image/png", "image/gif"}
+
+// Source wraps any source processor and downloads, validates and normalizes the
+// images attached to the blocks it emits. Images that cannot be fetched or are
+// rejected (wrong type, tracking pixels) are removed from the block.
+type Source struct {
+	core.SourceProcessor
+	config  config.ImageFetchConfig
+	fetcher Fetcher
+	logger  *slog.Logger
+}
+
+// WrapSource returns source unchanged when cfg is nil, so factories can call it
+// unconditionally.
+func WrapSource(source core.SourceProcessor, cfg *config.ImageFetchConfig, fetcher Fetcher, logger *slog.Logger) core.SourceProcessor {
+	if source == nil || cfg == nil {
+		return source
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &Source{
+		SourceProcessor: source,
+		config:          *cfg,
+		fetcher:         fetcher,
+		logger:          logger,
+	}
+}
+
+func (s *Source) Validate() error {
+	if err := s.SourceProcessor.Validate(); err != nil {
+		return err
+	}
+	if s.fetcher == nil {
+		return fmt.Errorf("image_fetch requires an image fetcher")
+	}
+	return nil
+}
+
+func (s *Source) Fetch(ctx context.Context) ([]*core.PostBlock, error) {
+	blocks, err := s.SourceProcessor.Fetch(ctx)
+	if err != nil {
+		return blocks, err
+	}
+	if s.fetcher == nil {
+		return blocks, fmt.Errorf("image_fetch requires an image fetcher")
+	}
+
+	maxConcurrency := s.config.MaxConcurrency
+	if maxConcurrency <= 0 {
+		maxConcurrency = defaultMaxConcurrency
+	}
+	sem := make(chan struct{}, maxConcurrency)
+
+	for _, block := range blocks {
+		if block == nil {
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			return blocks, err
+		}
+		block.ImageBlocks = s.processImages(ctx, block, block.ImageBlocks, sem)
+		if s.config.IncludeCommentImages {
+			s.processCommentImages(ctx, block, block.Comments, sem)
+		}
+	}
+	return blocks, nil
+}
+
+type imageOutcome struct {
+	keep bool
+	err  *core.ProcessError
+}
+
+// processCommentImages fetches the images of comments and, recursively, of
+// their replies.
+func (s *Source) processCommentImages(ctx context.Context, block *core.PostBlock, comments []core.CommentBlock, sem chan struct{}) {
+	for i := range comments {
+		comments[i].Images = s.processImages(ctx, block, comments[i].Images, sem)
+		s.processCommentImages(ctx, block, comments[i].Replies, sem)
+	}
+}
+
+func (s *Source) processImages(ctx context.Context, block *core.PostBlock, imgs []core.ImageBlock, sem chan struct{}) []core.ImageBlock {
+	if len(imgs) == 0 {
+		return imgs
+	}
+
+	outcomes := make([]imageOutcome, len(imgs))
+	var wg sync.WaitGroup
+	for i := range imgs {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			outcomes[i] = imageOutcome{keep: true}
+			continue
+		}
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			outcomes[i] = s.processImage(ctx, block.ID, &imgs[i])
+		}(i)
+	}
+	wg.Wait()
+
+	kept := imgs[:0]
+	for i, outcome := range outcomes {
+		if outcome.err != nil {
+			block.Errors = append(block.Errors, *outcome.err)
+		}
+		if outcome.keep {
+			kept = append(kept, imgs[i])
+		}
+	}
+	return kept
+}
+
+func (s *Source) processImage(ctx context.Context, postID string, image *core.ImageBlock) imageOutcome {
+	logger := s.logger.With(slog.String("post_id", postID), slog.String("image_url", image.URL))
+
+	data := image.ImageData
+	fromCache := false
+	if len(data) == 0 {
+		if !isHTTPURL(image.URL) {
+			// Placeholders without bytes can't be fetched; leave them for the caller.
+			return imageOutcome{keep: true}
+		}
+		if cached, ok := s.readCache(image.URL); ok {
+			data = cached
+			fromCache = true
+		} else {
+			fetched, err := s.fetcher.Fetch(ctx, image.URL, FetchOptions{MaxBytes: s.maxBytes()})
+			if err != nil {
+				logger.Warn("Dropping image: fetch failed", slog.String("error", err.Error()))
+				return imageOutcome{err: &core.ProcessError{
+					ProcessorName: "image_fetch",
+					Stage:         "source",
+					Error:         fmt.Sprintf("image fetch %s: %v", image.URL, err),
+					OccurredAt:    time.Now().UTC(),
+				}}
+			}
+			data = fetched
+		}
+	}
+
+	normalized, mime, err := Normalize(data, NormalizeOptions{
+		AllowedMIMETypes: s.allowedMIMETypes(),
+		MaxDimension:     s.maxDimension(),
+		MinDimension:     s.minDimension(),
+	})
+	if err != nil {
+		var rejection *Rejection
+		if errors.As(err, &rejection) {
+			logger.Info("Dropping image", slog.String("reason", rejection.Reason))
+			return imageOutcome{}
+		}
+		logger.Warn("Dropping image: normalize failed", slog.String("error", err.Error()))
+		return imageOutcome{err: &core.ProcessError{
+			ProcessorName: "image_fetch",
+			Stage:         "source",
+			Error:         fmt.Sprintf("image normalize %s: %v", image.URL, err),
+			OccurredAt:    time.Now().UTC(),
+		}}
+	}
+
+	if !fromCache && isHTTPURL(image.URL) {
+		s.writeCache(image.URL, normalized, logger)
+	}
+	logger.Debug("Fetched image", slog.String("mime", mime), slog.Int("bytes", len(normalized)), slog.Bool("cached", fromCache))
+	image.ImageData = normalized
+	image.WasFetched = true
+	return imageOutcome{keep: true}
+}
+
+func (s *Source) readCache(url string) ([]byte, bool) {
+	if s.config.CacheDir == "" {
+		return nil, false
+	}
+	data, err := os.ReadFile(s.cachePath(url))
+	if err != nil || len(data) == 0 {
+		return nil, false
+	}
+	return data, true
+}
+
+func (s *Source) writeCache(url string, data []byte, logger *slog.Logger) {
+	if s.config.CacheDir == "" {
+		return
+	}
+	if err := os.MkdirAll(s.config.CacheDir, 0o755); err != nil {
+		logger.Warn("Failed to create image cache directory", slog.String("error", err.Error()))
+		return
+	}
+	if err := os.WriteFile(s.cachePath(url), data, 0o644); err != nil {
+		logger.Warn("Failed to write image cache", slog.String("error", err.Error()))
+	}
+}
+
+func (s *Source) cachePath(url string) string {
+	sum := sha256.Sum256([]byte(url))
+	return filepath.Join(s.config.CacheDir, hex.EncodeToString(sum[:]))
+}
+
+func (s *Source) maxBytes() int64 {
+	if s.config.MaxBytes > 0 {
+		return s.config.MaxBytes
+	}
+	return defaultMaxBytes
+}
+
+func (s *Source) maxDimension() int {
+	if s.config.MaxDimension > 0 {
+		return s.config.MaxDimension
+	}
+	return defaultMaxDimension
+}
+
+func (s *Source) minDimension() int {
+	if s.config.MinDimension > 0 {
+		return s.config.MinDimension
+	}
+	return defaultMinDimension
+}
+
+func (s *Source) allowedMIMETypes() []string {
+	if len(s.config.AllowedMIMETypes) > 0 {
+		return s.config.AllowedMIMETypes
+	}
+	return defaultAllowedMIMETypes
+}
+
+func isHTTPURL(raw string) bool {
+	return strings.HasPrefix(raw, "http://") || strings.HasPrefix(raw, "https://")
+}
@@ -0,0 +1,163 @@
+package images_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/bakkerme/curator-ai/internal/config"
+	"github.com/bakkerme/curator-ai/internal/core"
+	"github.com/bakkerme/curator-ai/internal/sources/images"
+)
+
+type staticSource struct {
+	blocks []*core.PostBlock
+}
+
+func (s *staticSource) Name() string                                  { return "static" }
+func (s *staticSource) Configure(config map[string]interface{}) error { return nil }
+func (s *staticSource) Validate() error                               { return nil }
+func (s *staticSource) Fetch(ctx context.Context) ([]*core.PostBlock, error) {
+	return s.blocks, nil
+}
+
+type fetcherMock struct {
+	mu    sync.Mutex
+	data  map[string][]byte
+	calls int
+}
+
+func (f *fetcherMock) Fetch(ctx context.Context, url string, options images.FetchOptions) ([]byte, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls++
+	data, ok := f.data[url]
+	if !ok {
+		return nil, errors.New("404 not found")
+	}
+	return data, nil
+}
+
+func pngBytes(t *testing.T, w, h int) []byte {
+	t.Helper()
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			img.Set(x, y, color.RGBA{R: uint8(x), G: uint8(y), B: 200, A: 255})
+		}
+	}
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatalf("encode png: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func TestWrapSourceFetchesNormalizesAndDropsImages(t *testing.T) {
+	block := &core.PostBlock{
+		ID: "post-1",
+		ImageBlocks: []core.ImageBlock{
+			{URL: "https://example.com/large.png"},
+			{URL: "https://example.com/pixel.png"},
+			{URL: "https://example.com/missing.png"},
+			{URL: "https://example.com/page.html"},
+			{URL: "https://example.com/photo.webp"},
+		},
+	}
+	fetcher := &fetcherMock{data: map[string][]byte{
+		"https://example.com/large.png": pngBytes(t, 400, 200),
+		"https://example.com/pixel.png": pngBytes(t, 1, 1),
+		"https://example.com/page.html": []byte("<html><body>not an image</body></html>"),
+		// WebP can't be decoded for dimension checks, so it is not allowed by default.
+		"https://example.com/photo.webp": []byte("RIFF\x1a\x00\x00\x00WEBPVP8 \x0e\x00\x00\x00"),
+	}}
+	source := images.WrapSource(&staticSource{blocks: []*core.PostBlock{block}}, &config.ImageFetchConfig{MaxDimension: 100}, fetcher, nil)
+
+	blocks, err := source.Fetch(context.Background())
+	if err != nil {
+		t.Fatalf("fetch failed: %v", err)
+	}
+	got := blocks[0]
+	if len(got.ImageBlocks) != 1 {
+		t.Fatalf("expected only the large image to survive, got %#v", got.ImageBlocks)
+	}
+	kept := got.ImageBlocks[0]
+	if kept.URL != "https://example.com/large.png" || !kept.WasFetched {
+		t.Fatalf("unexpected kept image: %+v", kept)
+	}
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(kept.ImageData))
+	if err != nil {
+		t.Fatalf("decode normalized image: %v", err)
+	}
+	if cfg.Width != 100 || cfg.Height != 50 {
+		t.Fatalf("expected image downsized to 100x50, got %dx%d", cfg.Width, cfg.Height)
+	}
+	if len(got.Errors) != 1 || got.Errors[0].ProcessorName != "image_fetch" {
+		t.Fatalf("expected one image_fetch error for the missing image, got %#v", got.Errors)
+	}
+}
+
+func TestWrapSourceFetchesNestedCommentImages(t *testing.T) {
+	block := &core.PostBlock{
+		ID: "post-1",
+		Comments: []core.CommentBlock{{
+			ID:     "c1",
+			Images: []core.ImageBlock{{URL: "https://example.com/top.png"}},
+			Replies: []core.CommentBlock{{
+				ID:     "c2",
+				Images: []core.ImageBlock{{URL: "https://example.com/reply.png"}},
+			}},
+		}},
+	}
+	fetcher := &fetcherMock{data: map[string][]byte{
+		"https://example.com/top.png":   pngBytes(t, 64, 64),
+		"https://example.com/reply.png": pngBytes(t, 64, 64),
+	}}
+	source := images.WrapSource(&staticSource{blocks: []*core.PostBlock{block}}, &config.ImageFetchConfig{IncludeCommentImages: true}, fetcher, nil)
+
+	if _, err := source.Fetch(context.Background()); err != nil {
+		t.Fatalf("fetch failed: %v", err)
+	}
+	top := block.Comments[0]
+	for _, image := range []core.ImageBlock{top.Images[0], top.Replies[0].Images[0]} {
+		if !image.WasFetched || len(image.ImageData) == 0 {
+			t.Fatalf("expected comment image fetched, got %+v", image)
+		}
+	}
+}
+
+func TestWrapSourceCachesByURLHash(t *testing.T) {
+	cacheDir := t.TempDir()
+	fetcher := &fetcherMock{data: map[string][]byte{
+		"https://example.com/a.png": pngBytes(t, 64, 64),
+	}}
+	cfg := &config.ImageFetchConfig{CacheDir: cacheDir}
+
+	for i := 0; i < 2; i++ {
+		block := &core.PostBlock{ID: "post-1", ImageBlocks: []core.ImageBlock{{URL: "https://example.com/a.png"}}}
+		source := images.WrapSource(&staticSource{blocks: []*core.PostBlock{block}}, cfg, fetcher, nil)
+		if _, err := source.Fetch(context.Background()); err != nil {
+			t.Fatalf("fetch failed: %v", err)
+		}
+		if len(block.ImageBlocks) != 1 || len(block.ImageBlocks[0].ImageData) == 0 {
+			t.Fatalf("expected image data on run %d", i)
+		}
+	}
+	if fetcher.calls != 1 {
+		t.Fatalf("expected a single download, got %d", fetcher.calls)
+	}
+	entries, err := os.ReadDir(cacheDir)
+	if err != nil {
+		t.Fatalf("read cache dir: %v", err)
+	}
+	if len(entries) != 1 || filepath.Ext(entries[0].Name()) != "" {
+		t.Fatalf("expected one hashed cache entry, got %v", entries)
+	}
+}
@@ -0,0 +1,218 @@
+package impl
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bakkerme/curator-ai/internal/retry"
+	"github.com/bakkerme/curator-ai/internal/sources/mastodon"
+)
+
+// maxLimit is the largest page size Mastodon timelines accept.
+const maxLimit = 40
+
+// Client calls the Mastodon REST API of any instance.
+type Client struct {
+	client    *http.Client
+	userAgent string
+}
+
+// NewClient builds a Mastodon API client.
+func NewClient(timeout time.Duration, userAgent string) *Client {
+	if timeout <= 0 {
+		timeout = 15 * time.Second
+	}
+	if strings.TrimSpace(userAgent) == "" {
+		userAgent = "curator-ai/0.1"
+	}
+	return &Client{
+		client:    &http.Client{Timeout: timeout},
+		userAgent: userAgent,
+	}
+}
+
+type apiAccount struct {
+	ID          string `json:"id"`
+	Acct        string `json:"acct"`
+	DisplayName string `json:"display_name"`
+	URL         string `json:"url"`
+}
+
+type apiStatus struct {
+	ID                 string     `json:"id"`
+	URI                string     `json:"uri"`
+	URL                string     `json:"url"`
+	CreatedAt          string     `json:"created_at"`
+	Content            string     `json:"content"`
+	SpoilerText        string     `json:"spoiler_text"`
+	Language           string     `json:"language"`
+	InReplyToID        string     `json:"in_reply_to_id"`
+	InReplyToAccountID string     `json:"in_reply_to_account_id"`
+	Account            apiAccount `json:"account"`
+	Reblog             *apiStatus `json:"reblog"`
+	RepliesCount       int        `json:"replies_count"`
+	ReblogsCount       int        `json:"reblogs_count"`
+	FavouritesCount    int        `json:"favourites_count"`
+	MediaAttachments   []struct {
+		Type        string `json:"type"`
+		URL         string `json:"url"`
+		Description string `json:"description"`
+	} `json:"media_attachments"`
+	Card *struct {
+		URL         string `json:"url"`
+		Title       string `json:"title"`
+		Description string `json:"description"`
+	} `json:"card"`
+	Tags []struct {
+		Name string `json:"name"`
+	} `json:"tags"`
+}
+
+type apiContext struct {
+	Ancestors   []apiStatus `json:"ancestors"`
+	Descendants []apiStatus `json:"descendants"`
+}
+
+// Timeline reads a hashtag, list or account timeline, newest first.
+func (c *Client) Timeline(ctx context.Context, endpoint mastodon.Endpoint, request mastodon.TimelineRequest) ([]mastodon.Status, error) {
+	value := strings.TrimSpace(request.Value)
+	if value == "" {
+		return nil, fmt.Errorf("mastodon %s timeline requires a value", request.Kind)
+	}
+	values := url.Values{}
+	if request.Limit > 0 {
+		values.Set("limit", strconv.Itoa(min(request.Limit, maxLimit)))
+	}
+
+	var path string
+	switch request.Kind {
+	case mastodon.TimelineHashtag:
+		path = "/api/v1/timelines/tag/" + url.PathEscape(strings.TrimPrefix(value, "#"))
+	case mastodon.TimelineList:
+		path = "/api/v1/timelines/list/" + url.PathEscape(value)
+	case mastodon.TimelineAccount:
+		var account apiAccount
+		lookup := url.Values{"acct": {strings.TrimPrefix(value, "@")}}
+		if err := c.getJSON(ctx, endpoint, "/api/v1/accounts/lookup", lookup, &account); err != nil {
+			return nil, fmt.Errorf("mastodon account lookup %s: %w", value, err)
+		}
+		path = "/api/v1/accounts/" + url.PathEscape(account.ID) + "/statuses"
+	default:
+		return nil, fmt.Errorf("unsupported mastodon timeline %q", request.Kind)
+	}
+
+	var statuses []apiStatus
+	if err := c.getJSON(ctx, endpoint, path, values, &statuses); err != nil {
+		return nil, fmt.Errorf("mastodon %s timeline %s: %w", request.Kind, value, err)
+	}
+	return toStatuses(statuses), nil
+}
+
+// Context returns the ancestors and descendants of a status.
+func (c *Client) Context(ctx context.Context, endpoint mastodon.Endpoint, statusID string) (mastodon.Thread, error) {
+	var thread apiContext
+	if err := c.getJSON(ctx, endpoint, "/api/v1/statuses/"+url.PathEscape(statusID)+"/context", nil, &thread); err != nil {
+		return mastodon.Thread{}, fmt.Errorf("mastodon status context %s: %w", statusID, err)
+	}
+	return mastodon.Thread{
+		Ancestors:   toStatuses(thread.Ancestors),
+		Descendants: toStatuses(thread.Descendants),
+	}, nil
+}
+
+func (c *Client) getJSON(ctx context.Context, endpoint mastodon.Endpoint, path string, values url.Values, out interface{}) error {
+	target := strings.TrimRight(endpoint.Instance, "/") + path
+	if len(values) > 0 {
+		target += "?" + values.Encode()
+	}
+	return retry.Do(ctx, retry.Config{Attempts: 3, BaseDelay: 200 * time.Millisecond}, func() error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+		if err != nil {
+			return retry.Permanent(err)
+		}
+		req.Header.Set("User-Agent", c.userAgent)
+		req.Header.Set("Accept", "application/json")
+		if endpoint.Token != "" {
+			req.Header.Set("Authorization", "Bearer "+endpoint.Token)
+		}
+		resp, err := c.client.Do(req)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = resp.Body.Close() }()
+		if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError {
+			return fmt.Errorf("transient status %s", resp.Status)
+		}
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+			return retry.Permanent(fmt.Errorf("status %s: %s", resp.Status, strings.TrimSpace(string(body))))
+		}
+		return json.NewDecoder(resp.Body).Decode(out)
+	})
+}
+
+func toStatuses(statuses []apiStatus) []mastodon.Status {
+	out := make([]mastodon.Status, 0, len(statuses))
+	for _, status := range statuses {
+		out = append(out, status.toStatus())
+	}
+	return out
+}
+
+func (s apiStatus) toStatus() mastodon.Status {
+	status := mastodon.Status{
+		ID:                 s.ID,
+		URL:                s.URL,
+		Content:            s.Content,
+		SpoilerText:        strings.TrimSpace(s.SpoilerText),
+		Language:           s.Language,
+		InReplyToID:        s.InReplyToID,
+		InReplyToAccountID: s.InReplyToAccountID,
+		Account: mastodon.Account{
+			ID:          s.Account.ID,
+			Acct:        s.Account.Acct,
+			DisplayName: strings.TrimSpace(s.Account.DisplayName),
+			URL:         s.Account.URL,
+		},
+		RepliesCount:    s.RepliesCount,
+		ReblogsCount:    s.ReblogsCount,
+		FavouritesCount: s.FavouritesCount,
+	}
+	if status.URL == "" {
+		status.URL = s.URI
+	}
+	if created, err := time.Parse(time.RFC3339, s.CreatedAt); err == nil {
+		status.CreatedAt = created
+	}
+	if s.Reblog != nil {
+		reblog := s.Reblog.toStatus()
+		status.Reblog = &reblog
+	}
+	for _, media := range s.MediaAttachments {
+		status.Media = append(status.Media, mastodon.Media{
+			Type:        media.Type,
+			URL:         media.URL,
+			Description: strings.TrimSpace(media.Description),
+		})
+	}
+	if s.Card != nil && strings.TrimSpace(s.Card.URL) != "" {
+		status.Card = &mastodon.Card{
+			URL:         strings.TrimSpace(s.Card.URL),
+			Title:       strings.TrimSpace(s.Card.Title),
+			Description: strings.TrimSpace(s.Card.Description),
+		}
+	}
+	for _, tag := range s.Tags {
+		if tag.Name != "" {
+			status.Tags = append(status.Tags, tag.Name)
+		}
+	}
+	return status
+}
@@ -0,0 +1,78 @@
+package impl
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/bakkerme/curator-ai/internal/sources/mastodon"
+)
+
+const statusFixture = `[{
+	"id": "2", "url": "https://social.example/@bob/2", "created_at": "2024-05-01T10:00:00.000Z",
+	"content": "", "account": {"id": "20", "acct": "bob"},
+	"reblog": {
+		"id": "1", "url": "https://social.example/@alice/1", "created_at": "2024-05-01T09:00:00.000Z",
+		"content": "<p>New paper!</p>", "account": {"id": "10", "acct": "alice@other.example", "display_name": "Alice"},
+		"reblogs_count": 12, "favourites_count": 30, "replies_count": 2,
+		"media_attachments": [{"type": "image", "url": "https://files.example/fig.png", "description": " Figure 1 "}],
+		"card": {"url": "https://arxiv.org/abs/2405.00001", "title": "A Paper", "description": "Abstract"},
+		"tags": [{"name": "MachineLearning"}]
+	}
+}]`
+
+func TestTimeline_HashtagMapsBoostsMediaAndCards(t *testing.T) {
+	var gotPath, gotAuth, gotLimit string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath, gotAuth, gotLimit = r.URL.Path, r.Header.Get("Authorization"), r.URL.Query().Get("limit")
+		_, _ = w.Write([]byte(statusFixture))
+	}))
+	defer server.Close()
+
+	client := NewClient(5*time.Second, "")
+	statuses, err := client.Timeline(context.Background(), mastodon.Endpoint{Instance: server.URL, Token: "tok"},
+		mastodon.TimelineRequest{Kind: mastodon.TimelineHashtag, Value: "#MachineLearning", Limit: 100})
+	if err != nil {
+		t.Fatalf("timeline failed: %v", err)
+	}
+	if gotPath != "/api/v1/timelines/tag/MachineLearning" || gotAuth != "Bearer tok" || gotLimit != "40" {
+		t.Fatalf("unexpected request path %q auth %q limit %q", gotPath, gotAuth, gotLimit)
+	}
+	if len(statuses) != 1 || statuses[0].Reblog == nil {
+		t.Fatalf("expected one boost, got %+v", statuses)
+	}
+	original := statuses[0].Reblog
+	if original.ReblogsCount != 12 || original.Account.Acct != "alice@other.example" || original.CreatedAt.Hour() != 9 {
+		t.Fatalf("unexpected boosted status %+v", original)
+	}
+	if len(original.Media) != 1 || original.Media[0].Description != "Figure 1" {
+		t.Fatalf("unexpected media %+v", original.Media)
+	}
+	if original.Card == nil || original.Card.Title != "A Paper" || len(original.Tags) != 1 {
+		t.Fatalf("unexpected card or tags %+v", original)
+	}
+}
+
+func TestTimeline_AccountLooksUpID(t *testing.T) {
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path+"?"+r.URL.RawQuery)
+		if r.URL.Path == "/api/v1/accounts/lookup" {
+			_, _ = w.Write([]byte(`{"id": "99", "acct": "alice"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	client := NewClient(5*time.Second, "")
+	if _, err := client.Timeline(context.Background(), mastodon.Endpoint{Instance: server.URL + "/"},
+		mastodon.TimelineRequest{Kind: mastodon.TimelineAccount, Value: "@alice@other.example"}); err != nil {
+		t.Fatalf("timeline failed: %v", err)
+	}
+	if len(paths) != 2 || paths[0] != "/api/v1/accounts/lookup?acct=alice%40other.example" || paths[1] != "/api/v1/accounts/99/statuses?" {
+		t.Fatalf("unexpected requests %v", paths)
+	}
+}
@@ -0,0 +1,84 @@
+package mastodon
+
+import (
+	"context"
+	"time"
+)
+
+// Endpoint identifies the instance to query and the optional bearer token.
+type Endpoint struct {
+	Instance string
+	Token    string
+}
+
+// TimelineKind selects which timeline to read.
+type TimelineKind string
+
+const (
+	TimelineHashtag TimelineKind = "tag"
+	TimelineList    TimelineKind = "list"
+	TimelineAccount TimelineKind = "account"
+)
+
+// TimelineRequest reads one hashtag, list or account timeline.
+type TimelineRequest struct {
+	Kind TimelineKind
+	// Value is the hashtag (without #), list ID or account handle (user or user@host).
+	Value string
+	Limit int
+}
+
+// Account is a status author.
+type Account struct {
+	ID          string
+	Acct        string
+	DisplayName string
+	URL         string
+}
+
+// Media is a status attachment.
+type Media struct {
+	Type        string
+	URL         string
+	Description string
+}
+
+// Card is a status link preview.
+type Card struct {
+	URL         string
+	Title       string
+	Description string
+}
+
+// Status is a normalized Mastodon status.
+type Status struct {
+	ID                 string
+	URL                string
+	CreatedAt          time.Time
+	Content            string // HTML
+	SpoilerText        string
+	Language           string
+	InReplyToID        string
+	InReplyToAccountID string
+	Account            Account
+	// Reblog is the boosted status when this status is a boost.
+	Reblog          *Status
+	RepliesCount    int
+	ReblogsCount    int
+	FavouritesCount int
+	Media           []Media
+	Card            *Card
+	Tags            []string
+}
+
+// Thread holds the statuses around a status, oldest first.
+type Thread struct {
+	Ancestors   []Status
+	Descendants []Status
+}
+
+// Client reads the Mastodon REST API.
+type Client interface {
+	Timeline(ctx context.Context, endpoint Endpoint, request TimelineRequest) ([]Status, error)
+	Context(ctx context.Context, endpoint Endpoint, statusID string) (Thread, error)
+}
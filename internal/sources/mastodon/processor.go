@@ -0,0 +1,369 @@
+package mastodon
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/bakkerme/curator-ai/internal/config"
+	"github.com/bakkerme/curator-ai/internal/core"
+	"github.com/bakkerme/curator-ai/internal/dedupe"
+	"github.com/bakkerme/curator-ai/internal/sources"
+	"github.com/bakkerme/curator-ai/internal/sources/htmlconv"
+)
+
+const (
+	defaultLimit   = 20
+	maxTitleLength = 100
+	dedupePrefix   = "mastodon:"
+)
+
+// MastodonProcessor reads hashtag, list and account timelines and emits one
+// PostBlock per original status. Boosts are unwrapped to the boosted status,
+// and with threads enabled a self-thread becomes a single block.
+type MastodonProcessor struct {
+	name     string
+	config   config.MastodonSource
+	client   Client
+	endpoint Endpoint
+	store    dedupe.SeenStore
+	logger   *slog.Logger
+}
+
+// NewMastodonProcessor wires a new Mastodon source. defaultToken is used
+// when the config has no access_token.
+func NewMastodonProcessor(cfg *config.MastodonSource, client Client, defaultToken string, store dedupe.SeenStore, logger *slog.Logger) (*MastodonProcessor, error) {
+	if cfg == nil {
+		return nil, fmt.Errorf("mastodon config is required")
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+	token := strings.TrimSpace(cfg.AccessToken)
+	if token == "" {
+		token = strings.TrimSpace(defaultToken)
+	}
+	return &MastodonProcessor{
+		name:     "mastodon",
+		config:   *cfg,
+		client:   client,
+		endpoint: Endpoint{Instance: strings.TrimRight(strings.TrimSpace(cfg.Instance), "/"), Token: token},
+		store:    store,
+		logger:   logger,
+	}, nil
+}
+
+func (p *MastodonProcessor) Name() string {
+	return p.name
+}
+
+func (p *MastodonProcessor) Configure(config map[string]interface{}) error {
+	return nil
+}
+
+func (p *MastodonProcessor) Validate() error {
+	if p.endpoint.Instance == "" {
+		return fmt.Errorf("mastodon instance is required")
+	}
+	if len(p.config.Hashtags) == 0 && len(p.config.Lists) == 0 && len(p.config.Accounts) == 0 {
+		return fmt.Errorf("mastodon hashtags, lists or accounts are required")
+	}
+	if len(p.config.Lists) > 0 && p.endpoint.Token == "" {
+		return fmt.Errorf("mastodon lists require an access token")
+	}
+	if p.client == nil {
+		return fmt.Errorf("mastodon client is required")
+	}
+	return nil
+}
+
+func (p *MastodonProcessor) Fetch(ctx context.Context) ([]*core.PostBlock, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	logger := core.LoggerFromContext(ctx).With("stage", "source", "processor", p.name)
+
+	limit := p.config.Limit
+	if limit <= 0 {
+		limit = defaultLimit
+	}
+	var requests []TimelineRequest
+	for _, tag := range p.config.Hashtags {
+		requests = append(requests, TimelineRequest{Kind: TimelineHashtag, Value: tag, Limit: limit})
+	}
+	for _, list := range p.config.Lists {
+		requests = append(requests, TimelineRequest{Kind: TimelineList, Value: list, Limit: limit})
+	}
+	for _, account := range p.config.Accounts {
+		requests = append(requests, TimelineRequest{Kind: TimelineAccount, Value: account, Limit: limit})
+	}
+
+	var blocks []*core.PostBlock
+	var fetchErrs []error
+	emitted := make(map[string]*core.PostBlock)
+	for _, request := range requests {
+		timeline := string(request.Kind) + ":" + request.Value
+		logger.Info("Fetching Mastodon timeline", "instance", p.endpoint.Instance, "timeline", request.Kind, "value", request.Value)
+		statuses, err := p.client.Timeline(ctx, p.endpoint, request)
+		if err != nil {
+			// Statuses from earlier timelines are already marked seen, so one
+			// failing timeline must not discard them.
+			logger.Warn("Failed to fetch Mastodon timeline", "timeline", timeline, "error", err)
+			fetchErrs = append(fetchErrs, fmt.Errorf("timeline %s: %w", timeline, err))
+			core.RecordRunError(ctx, core.ProcessError{
+				ProcessorName: p.name,
+				Stage:         "source",
+				Error:         fmt.Sprintf("timeline %s: %v", timeline, err),
+				OccurredAt:    time.Now().UTC(),
+			})
+			continue
+		}
+		for _, status := range statuses {
+			post, boostedBy := status, ""
+			if status.Reblog != nil {
+				post, boostedBy = *status.Reblog, status.Account.Acct
+			}
+			if post.ReblogsCount < p.config.MinBoosts || post.FavouritesCount < p.config.MinFavourites {
+				continue
+			}
+
+			chain, replies := []Status{post}, []Status(nil)
+			if p.config.Threads && (post.InReplyToID != "" || post.RepliesCount > 0) {
+				thread, err := p.client.Context(ctx, p.endpoint, post.ID)
+				if err != nil {
+					logger.Warn("Failed to fetch Mastodon thread; using the status alone", "status_id", post.ID, "error", err)
+				} else {
+					chain, replies = selfThread(post, thread)
+					// A status's context only lists its own descendants, so
+					// re-read the thread from its root to see every reply.
+					if root := chain[0]; root.ID != post.ID {
+						if rootThread, err := p.client.Context(ctx, p.endpoint, root.ID); err == nil {
+							chain, replies = selfThread(root, rootThread)
+						} else {
+							logger.Warn("Failed to fetch Mastodon thread root", "status_id", root.ID, "error", err)
+						}
+					}
+				}
+			}
+			root := chain[0]
+			key := dedupePrefix + statusKey(root)
+			if block, ok := emitted[key]; ok {
+				addBoostedBy(block, boostedBy)
+				continue
+			}
+			if sources.Seen(ctx, p.store, logger, "status", key) {
+				continue
+			}
+
+			block := p.buildBlock(chain, replies, timeline)
+			addBoostedBy(block, boostedBy)
+			emitted[key] = block
+			blocks = append(blocks, block)
+			if p.store != nil {
+				if err := p.store.MarkSeen(ctx, key); err != nil {
+					logger.Warn("Failed to mark status as seen", "status", key, "error", err)
+				}
+			}
+		}
+	}
+	if len(fetchErrs) == len(requests) {
+		return nil, fmt.Errorf("all mastodon timelines failed: %w", errors.Join(fetchErrs...))
+	}
+	return blocks, nil
+}
+
+// selfThread returns the author's unbroken chain of self-replies containing
+// post, oldest first, plus every other reply in the thread.
+func selfThread(post Status, thread Thread) ([]Status, []Status) {
+	byID := make(map[string]Status, len(thread.Ancestors))
+	for _, ancestor := range thread.Ancestors {
+		byID[ancestor.ID] = ancestor
+	}
+	chain := []Status{post}
+	for current := post; current.InReplyToID != ""; {
+		parent, ok := byID[current.InReplyToID]
+		if !ok || parent.Account.ID != post.Account.ID {
+			break
+		}
+		chain = append([]Status{parent}, chain...)
+		current = parent
+	}
+
+	inChain := make(map[string]bool, len(chain))
+	for _, status := range chain {
+		inChain[status.ID] = true
+	}
+	var replies []Status
+	for _, descendant := range thread.Descendants {
+		if descendant.Account.ID == post.Account.ID && inChain[descendant.InReplyToID] {
+			chain = append(chain, descendant)
+			inChain[descendant.ID] = true
+			continue
+		}
+		replies = append(replies, descendant)
+	}
+	return chain, replies
+}
+
+func (p *MastodonProcessor) buildBlock(chain []Status, replies []Status, timeline string) *core.PostBlock {
+	root := chain[0]
+	parts := make([]string, 0, len(chain))
+	block := &core.PostBlock{
+		ID:          root.ID,
+		URL:         root.URL,
+		Author:      root.Account.Acct,
+		CreatedAt:   root.CreatedAt,
+		SummaryPlan: sources.SummaryPlanFromConfig(p.config.SummaryPlan),
+		ProcessedAt: time.Now().UTC(),
+	}
+	if root.Account.DisplayName != "" {
+		block.Authors = []core.Author{{Name: root.Account.DisplayName}}
+	}
+	var tags []string
+	for _, status := range chain {
+		text := statusText(status)
+		if text != "" {
+			parts = append(parts, text)
+		}
+		for _, media := range status.Media {
+			if media.Type == "image" && media.URL != "" {
+				block.ImageBlocks = append(block.ImageBlocks, core.ImageBlock{URL: media.URL, AltText: media.Description})
+			}
+		}
+		if status.Card != nil {
+			block.WebBlocks = append(block.WebBlocks, core.WebBlock{
+				URL:         status.Card.URL,
+				Title:       status.Card.Title,
+				Description: status.Card.Description,
+			})
+		}
+		tags = append(tags, status.Tags...)
+	}
+	block.Content = strings.Join(parts, "\n\n")
+	block.Title = statusTitle(block.Content, root.Account)
+	block.Comments = replyTree(replies, chain)
+
+	block.Metadata = map[string]string{
+		"account":    root.Account.Acct,
+		"boosts":     strconv.Itoa(root.ReblogsCount),
+		"favourites": strconv.Itoa(root.FavouritesCount),
+		"replies":    strconv.Itoa(root.RepliesCount),
+		"timeline":   timeline,
+	}
+	if len(chain) > 1 {
+		block.Metadata["thread_length"] = strconv.Itoa(len(chain))
+	}
+	if len(tags) > 0 {
+		block.Metadata["tags"] = strings.Join(uniqueLower(tags), " ")
+	}
+	if root.Language != "" {
+		block.Metadata["language"] = root.Language
+	}
+	return block
+}
+
+// replyTree nests replies under the replies they answer; replies to the
+// post's own chain become top-level comments.
+func replyTree(replies []Status, chain []Status) []core.CommentBlock {
+	if len(replies) == 0 {
+		return nil
+	}
+	inChain := make(map[string]bool, len(chain))
+	for _, status := range chain {
+		inChain[status.ID] = true
+	}
+	children := make(map[string][]Status)
+	var top []Status
+	for _, reply := range replies {
+		if inChain[reply.InReplyToID] {
+			top = append(top, reply)
+		} else {
+			children[reply.InReplyToID] = append(children[reply.InReplyToID], reply)
+		}
+	}
+	var build func([]Status) []core.CommentBlock
+	build = func(statuses []Status) []core.CommentBlock {
+		comments := make([]core.CommentBlock, 0, len(statuses))
+		for _, status := range statuses {
+			comments = append(comments, core.CommentBlock{
+				ID:        status.ID,
+				Author:    status.Account.Acct,
+				Content:   statusText(status),
+				CreatedAt: status.CreatedAt,
+				Score:     status.FavouritesCount,
+				Permalink: status.URL,
+				Replies:   build(children[status.ID]),
+			})
+		}
+		return comments
+	}
+	return build(top)
+}
+
+// statusText converts status HTML to markdown, keeping any content warning.
+func statusText(status Status) string {
+	text, err := htmlconv.ConvertHTMLToMarkdown(status.Content)
+	if err != nil {
+		text = status.Content
+	}
+	text = strings.TrimSpace(text)
+	if status.SpoilerText != "" {
+		text = strings.TrimSpace("CW: " + status.SpoilerText + "\n\n" + text)
+	}
+	return text
+}
+
+func statusTitle(content string, account Account) string {
+	line, _, _ := strings.Cut(strings.TrimSpace(content), "\n")
+	line = strings.TrimSpace(line)
+	if line == "" {
+		name := account.DisplayName
+		if name == "" {
+			name = account.Acct
+		}
+		return "Post by " + name
+	}
+	if utf8.RuneCountInString(line) > maxTitleLength {
+		runes := []rune(line)
+		line = strings.TrimSpace(string(runes[:maxTitleLength])) + "…"
+	}
+	return line
+}
+
+func statusKey(status Status) string {
+	if status.URL != "" {
+		return status.URL
+	}
+	return status.ID
+}
+
+func addBoostedBy(block *core.PostBlock, acct string) {
+	if acct == "" {
+		return
+	}
+	existing := block.Metadata["boosted_by"]
+	for _, name := range strings.Fields(existing) {
+		if name == acct {
+			return
+		}
+	}
+	block.Metadata["boosted_by"] = strings.TrimSpace(existing + " " + acct)
+}
+
+func uniqueLower(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	out := make([]string, 0, len(values))
+	for _, value := range values {
+		value = strings.ToLower(value)
+		if !seen[value] {
+			seen[value] = true
+			out = append(out, value)
+		}
+	}
+	return out
+}
@@ -0,0 +1,178 @@
+package mastodon
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/bakkerme/curator-ai/internal/config"
+	"github.com/bakkerme/curator-ai/internal/core"
+)
+
+type clientMock struct {
+	timelines map[string][]Status
+	errs      map[string]error
+	threads   map[string]Thread
+	requests  []TimelineRequest
+	endpoint  Endpoint
+}
+
+func (m *clientMock) Timeline(ctx context.Context, endpoint Endpoint, request TimelineRequest) ([]Status, error) {
+	m.endpoint = endpoint
+	m.requests = append(m.requests, request)
+	timeline := string(request.Kind) + ":" + request.Value
+	return m.timelines[timeline], m.errs[timeline]
+}
+
+func (m *clientMock) Context(ctx context.Context, endpoint Endpoint, statusID string) (Thread, error) {
+	return m.threads[statusID], nil
+}
+
+type seenStoreMock struct {
+	seen map[string]bool
+}
+
+func (m *seenStoreMock) HasSeen(ctx context.Context, id string) (bool, error) { return m.seen[id], nil }
+func (m *seenStoreMock) MarkSeen(ctx context.Context, id string) error        { m.seen[id] = true; return nil }
+func (m *seenStoreMock) MarkSeenBatch(ctx context.Context, ids []string) error {
+	for _, id := range ids {
+		m.seen[id] = true
+	}
+	return nil
+}
+func (m *seenStoreMock) Close() error { return nil }
+
+var (
+	alice = Account{ID: "1", Acct: "alice", DisplayName: "Alice"}
+	bob   = Account{ID: "2", Acct: "bob"}
+)
+
+func TestMastodonProcessor_UnwrapsBoostsAndAppliesThresholds(t *testing.T) {
+	original := Status{
+		ID: "10", URL: "https://social.example/@alice/10", Account: alice,
+		Content: "<p>Our new <strong>paper</strong> is out</p>", ReblogsCount: 5, FavouritesCount: 20,
+		Media: []Media{{Type: "image", URL: "https://files/fig.png", Description: "Loss curves"}, {Type: "video", URL: "https://files/clip.mp4"}},
+		Card:  &Card{URL: "https://arxiv.org/abs/1", Title: "Paper", Description: "Abstract"},
+		Tags:  []string{"ML"},
+	}
+	client := &clientMock{timelines: map[string][]Status{
+		"tag:ml": {
+			{ID: "11", Account: bob, Reblog: &original},
+			{ID: "12", Account: bob, Content: "<p>meh</p>", ReblogsCount: 0, FavouritesCount: 1},
+		},
+		"account:alice": {original},
+	}}
+	cfg := &config.MastodonSource{Instance: "https://social.example/", Hashtags: []string{"ml"}, Accounts: []string{"alice"}, MinBoosts: 2}
+	processor, err := NewMastodonProcessor(cfg, client, "env-token", nil, nil)
+	if err != nil {
+		t.Fatalf("failed to create processor: %v", err)
+	}
+
+	blocks, err := processor.Fetch(context.Background())
+	if err != nil {
+		t.Fatalf("fetch failed: %v", err)
+	}
+	if client.endpoint.Instance != "https://social.example" || client.endpoint.Token != "env-token" || client.requests[0].Limit != defaultLimit {
+		t.Fatalf("unexpected endpoint %+v or request %+v", client.endpoint, client.requests[0])
+	}
+	if len(blocks) != 1 {
+		t.Fatalf("expected the boosted post once, got %d blocks", len(blocks))
+	}
+	block := blocks[0]
+	if block.ID != "10" || block.Author != "alice" || block.Content != "Our new **paper** is out" || block.Title != block.Content {
+		t.Fatalf("unexpected block %+v", block)
+	}
+	if block.Metadata["boosted_by"] != "bob" || block.Metadata["boosts"] != "5" || block.Metadata["favourites"] != "20" || block.Metadata["tags"] != "ml" {
+		t.Fatalf("unexpected metadata %v", block.Metadata)
+	}
+	if len(block.ImageBlocks) != 1 || block.ImageBlocks[0].AltText != "Loss curves" {
+		t.Fatalf("expected image with alt text, got %+v", block.ImageBlocks)
+	}
+	if len(block.WebBlocks) != 1 || block.WebBlocks[0].Title != "Paper" || block.WebBlocks[0].WasFetched {
+		t.Fatalf("expected unfetched card web block, got %+v", block.WebBlocks)
+	}
+}
+
+func TestMastodonProcessor_JoinsSelfThreadAndNestsReplies(t *testing.T) {
+	first := Status{ID: "1", URL: "u1", Account: alice, Content: "<p>1/ A thread</p>", RepliesCount: 2}
+	second := Status{ID: "2", URL: "u2", Account: alice, Content: "<p>2/ More</p>", InReplyToID: "1", InReplyToAccountID: "1"}
+	third := Status{ID: "3", URL: "u3", Account: alice, Content: "<p>3/ End</p>", InReplyToID: "2", InReplyToAccountID: "1"}
+	reply := Status{ID: "4", URL: "u4", Account: bob, Content: "<p>Nice</p>", InReplyToID: "2", FavouritesCount: 3}
+	answer := Status{ID: "5", URL: "u5", Account: alice, Content: "<p>Thanks</p>", InReplyToID: "4"}
+	client := &clientMock{
+		timelines: map[string][]Status{"account:alice": {third, second, first}},
+		threads: map[string]Thread{
+			"3": {Ancestors: []Status{first, second}},
+			"2": {Ancestors: []Status{first}, Descendants: []Status{third, reply, answer}},
+			"1": {Descendants: []Status{second, third, reply, answer}},
+		},
+	}
+	cfg := &config.MastodonSource{Instance: "https://social.example", Accounts: []string{"alice"}, Threads: true}
+	processor, err := NewMastodonProcessor(cfg, client, "", nil, nil)
+	if err != nil {
+		t.Fatalf("failed to create processor: %v", err)
+	}
+
+	blocks, err := processor.Fetch(context.Background())
+	if err != nil {
+		t.Fatalf("fetch failed: %v", err)
+	}
+	if len(blocks) != 1 {
+		t.Fatalf("expected the thread as one block, got %d", len(blocks))
+	}
+	block := blocks[0]
+	if block.ID != "1" || block.Content != "1/ A thread\n\n2/ More\n\n3/ End" || block.Metadata["thread_length"] != "3" {
+		t.Fatalf("unexpected thread block %+v", block)
+	}
+	if len(block.Comments) != 1 || block.Comments[0].Author != "bob" || block.Comments[0].Score != 3 {
+		t.Fatalf("expected bob's reply as a comment, got %+v", block.Comments)
+	}
+	if len(block.Comments[0].Replies) != 1 || block.Comments[0].Replies[0].Content != "Thanks" {
+		t.Fatalf("expected alice's answer nested under bob's reply, got %+v", block.Comments[0].Replies)
+	}
+}
+
+func TestMastodonProcessor_ListsRequireToken(t *testing.T) {
+	processor, err := NewMastodonProcessor(&config.MastodonSource{Instance: "https://social.example", Lists: []string{"42"}}, &clientMock{}, "", nil, nil)
+	if err != nil {
+		t.Fatalf("failed to create processor: %v", err)
+	}
+	if err := processor.Validate(); err == nil {
+		t.Fatalf("expected lists without a token to fail validation")
+	}
+}
+
+func TestMastodonProcessor_KeepsEarlierTimelinesWhenOneFails(t *testing.T) {
+	client := &clientMock{
+		timelines: map[string][]Status{"tag:ml": {{ID: "10", Account: alice, Content: "<p>New paper</p>"}}},
+		errs:      map[string]error{"account:bob": errors.New("503 Service Unavailable")},
+	}
+	store := &seenStoreMock{seen: map[string]bool{}}
+	cfg := &config.MastodonSource{Instance: "https://social.example", Hashtags: []string{"ml"}, Accounts: []string{"bob"}}
+	processor, err := NewMastodonProcessor(cfg, client, "", store, nil)
+	if err != nil {
+		t.Fatalf("failed to create processor: %v", err)
+	}
+
+	runErrors := &core.RunErrors{}
+	blocks, err := processor.Fetch(core.WithRunErrors(context.Background(), runErrors))
+	if err != nil {
+		t.Fatalf("expected a failing timeline not to fail the fetch, got %v", err)
+	}
+	if len(blocks) != 1 || blocks[0].ID != "10" || len(store.seen) != 1 {
+		t.Fatalf("expected the earlier timeline's status delivered and marked seen, got %+v (seen %v)", blocks, store.seen)
+	}
+	if errs := runErrors.Errors(); len(errs) != 1 || !strings.Contains(errs[0].Error, "account:bob") {
+		t.Fatalf("expected the failed timeline recorded as a run error, got %+v", errs)
+	}
+
+	cfg.Hashtags = nil
+	processor, err = NewMastodonProcessor(cfg, client, "", store, nil)
+	if err != nil {
+		t.Fatalf("failed to create processor: %v", err)
+	}
+	if _, err := processor.Fetch(context.Background()); err == nil || !strings.Contains(err.Error(), "all mastodon timelines failed") {
+		t.Fatalf("expected an error when every timeline fails, got %v", err)
+	}
+}
@@ -0,0 +1,210 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mmcdole/gofeed"
+	ext "github.com/mmcdole/gofeed/extensions"
+
+	"github.com/bakkerme/curator-ai/internal/retry"
+	"github.com/bakkerme/curator-ai/internal/sources/media"
+)
+
+// maxTranscriptBytes caps published transcript downloads.
+const maxTranscriptBytes = 10 << 20
+
+// Feeds reads podcast RSS and YouTube Atom feeds.
+type Feeds struct {
+	client    *http.Client
+	parser    *gofeed.Parser
+	userAgent string
+}
+
+// NewFeeds builds a feed reader.
+func NewFeeds(timeout time.Duration, userAgent string) *Feeds {
+	if timeout <= 0 {
+		timeout = 15 * time.Second
+	}
+	if strings.TrimSpace(userAgent) == "" {
+		userAgent = "curator-ai/0.1"
+	}
+	client := &http.Client{Timeout: timeout}
+	parser := gofeed.NewParser()
+	parser.Client = client
+	parser.UserAgent = userAgent
+	return &Feeds{client: client, parser: parser, userAgent: userAgent}
+}
+
+// Episodes returns the newest limit entries of a feed.
+func (f *Feeds) Episodes(ctx context.Context, feedURL string, kind media.Kind, limit int) ([]media.Episode, error) {
+	var feed *gofeed.Feed
+	err := retry.Do(ctx, retry.Config{Attempts: 3, BaseDelay: 200 * time.Millisecond}, func() error {
+		parsed, err := f.parser.ParseURLWithContext(feedURL, ctx)
+		if err != nil {
+			return err
+		}
+		feed = parsed
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("parse media feed %s: %w", feedURL, err)
+	}
+
+	episodes := make([]media.Episode, 0, len(feed.Items))
+	for _, item := range feed.Items {
+		if limit > 0 && len(episodes) >= limit {
+			break
+		}
+		episode := media.Episode{
+			Kind:        kind,
+			Title:       strings.TrimSpace(item.Title),
+			URL:         strings.TrimSpace(item.Link),
+			Description: strings.TrimSpace(item.Description),
+			Feed:        strings.TrimSpace(feed.Title),
+		}
+		if item.Author != nil {
+			episode.Author = item.Author.Name
+		}
+		if item.PublishedParsed != nil {
+			episode.PublishedAt = *item.PublishedParsed
+		} else if item.UpdatedParsed != nil {
+			episode.PublishedAt = *item.UpdatedParsed
+		}
+
+		switch kind {
+		case media.KindVideo:
+			videoID := extensionValue(item.Extensions, "yt", "videoId")
+			if videoID == "" {
+				continue
+			}
+			episode.ID = "youtube:" + videoID
+			if !isHTTPURL(episode.URL) {
+				episode.URL = "https://www.youtube.com/watch?v=" + videoID
+			}
+			if group := extensions(item.Extensions, "media", "group"); len(group) > 0 && episode.Description == "" {
+				if description := group[0].Children["description"]; len(description) > 0 {
+					episode.Description = strings.TrimSpace(description[0].Value)
+				}
+			}
+		default:
+			for _, enclosure := range item.Enclosures {
+				if strings.HasPrefix(enclosure.Type, "audio/") || enclosure.Type == "" {
+					episode.AudioURL = strings.TrimSpace(enclosure.URL)
+					break
+				}
+			}
+			if !isHTTPURL(episode.AudioURL) {
+				continue
+			}
+			episode.ID = strings.TrimSpace(item.GUID)
+			if episode.ID == "" {
+				episode.ID = episode.AudioURL
+			}
+			if !isHTTPURL(episode.URL) {
+				episode.URL = episode.AudioURL
+			}
+			if item.ITunesExt != nil {
+				episode.Duration = parseDuration(item.ITunesExt.Duration)
+				if episode.Author == "" {
+					episode.Author = item.ITunesExt.Author
+				}
+			}
+			episode.TranscriptURL, episode.TranscriptType = podcastTranscript(item.Extensions)
+		}
+		// Episode URLs are handed to yt-dlp, so anything a feed supplies that
+		// isn't a plain web link is dropped.
+		if !isHTTPURL(episode.URL) {
+			continue
+		}
+		episodes = append(episodes, episode)
+	}
+	return episodes, nil
+}
+
+// isHTTPURL reports whether raw is an absolute http(s) URL.
+func isHTTPURL(raw string) bool {
+	parsed, err := url.Parse(raw)
+	return err == nil && (parsed.Scheme == "http" || parsed.Scheme == "https") && parsed.Host != ""
+}
+
+// Transcript downloads and parses a WebVTT or SRT transcript.
+func (f *Feeds) Transcript(ctx context.Context, url string) ([]media.Cue, error) {
+	var body []byte
+	err := retry.Do(ctx, retry.Config{Attempts: 3, BaseDelay: 200 * time.Millisecond}, func() error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return retry.Permanent(err)
+		}
+		req.Header.Set("User-Agent", f.userAgent)
+		resp, err := f.client.Do(req)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = resp.Body.Close() }()
+		if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError {
+			return fmt.Errorf("transient status %s", resp.Status)
+		}
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			return retry.Permanent(fmt.Errorf("status %s", resp.Status))
+		}
+		body, err = io.ReadAll(io.LimitReader(resp.Body, maxTranscriptBytes))
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("fetch transcript %s: %w", url, err)
+	}
+	return media.ParseSubtitles(string(body)), nil
+}
+
+// podcastTranscript picks a podcast:transcript the subtitle parser can read,
+// preferring WebVTT over SRT.
+func podcastTranscript(extensionsByPrefix ext.Extensions) (string, string) {
+	var url, kind string
+	for _, transcript := range extensions(extensionsByPrefix, "podcast", "transcript") {
+		candidate := strings.ToLower(transcript.Attrs["type"])
+		switch {
+		case candidate == "text/vtt":
+			return transcript.Attrs["url"], candidate
+		case strings.Contains(candidate, "srt") || strings.Contains(candidate, "subrip"):
+			if url == "" {
+				url, kind = transcript.Attrs["url"], candidate
+			}
+		}
+	}
+	return url, kind
+}
+
+func extensions(extensionsByPrefix ext.Extensions, prefix string, name string) []ext.Extension {
+	if extensionsByPrefix == nil {
+		return nil
+	}
+	return extensionsByPrefix[prefix][name]
+}
+
+func extensionValue(extensionsByPrefix ext.Extensions, prefix string, name string) string {
+	values := extensions(extensionsByPrefix, prefix, name)
+	if len(values) == 0 {
+		return ""
+	}
+	return strings.TrimSpace(values[0].Value)
+}
+
+// parseDuration reads iTunes durations: seconds, MM:SS or HH:MM:SS.
+func parseDuration(value string) time.Duration {
+	var total time.Duration
+	for _, part := range strings.Split(strings.TrimSpace(value), ":") {
+		number, err := strconv.Atoi(part)
+		if err != nil {
+			return 0
+		}
+		total = total*60 + time.Duration(number)
+	}
+	return total * time.Second
+}
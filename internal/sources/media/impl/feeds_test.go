@@ -0,0 +1,124 @@
+package impl
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bakkerme/curator-ai/internal/sources/media"
+)
+
+const podcastFeed = `<?xml version="1.0"?>
+<rss version="2.0" xmlns:itunes="http://www.itunes.com/dtds/podcast-1.0.dtd" xmlns:podcast="https://podcastindex.org/namespace/1.0">
+<channel>
+  <title>Latent Space</title>
+  <item>
+    <title>Scaling laws</title>
+    <guid>ep-42</guid>
+    <link>https://pod.example/ep-42</link>
+    <pubDate>Wed, 01 May 2024 09:00:00 GMT</pubDate>
+    <itunes:duration>1:02:03</itunes:duration>
+    <itunes:author>Swyx</itunes:author>
+    <enclosure url="https://cdn.example/ep-42.mp3" type="audio/mpeg" length="1"/>
+    <podcast:transcript url="{{base}}/ep-42.json" type="application/json"/>
+    <podcast:transcript url="{{base}}/ep-42.vtt" type="text/vtt"/>
+  </item>
+  <item>
+    <title>No audio</title>
+    <guid>post-1</guid>
+  </item>
+  <item>
+    <title>Hostile enclosure</title>
+    <guid>ep-43</guid>
+    <enclosure url="--exec=touch /tmp/pwned" type="audio/mpeg" length="1"/>
+  </item>
+</channel>
+</rss>`
+
+const youTubeFeed = `<?xml version="1.0" encoding="UTF-8"?>
+<feed xmlns:yt="http://www.youtube.com/xml/schemas/2015" xmlns:media="http://search.yahoo.com/mrss/" xmlns="http://www.w3.org/2005/Atom">
+  <title>Two Minute Papers</title>
+  <entry>
+    <id>yt:video:abc123</id>
+    <yt:videoId>abc123</yt:videoId>
+    <title>New diffusion paper</title>
+    <link rel="alternate" href="https://www.youtube.com/watch?v=abc123"/>
+    <author><name>Károly</name></author>
+    <published>2024-05-02T10:00:00+00:00</published>
+    <media:group><media:description>Paper link below</media:description></media:group>
+  </entry>
+</feed>`
+
+func newMediaServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/podcast.xml":
+			_, _ = w.Write([]byte(strings.ReplaceAll(podcastFeed, "{{base}}", server.URL)))
+		case "/youtube.xml":
+			_, _ = w.Write([]byte(youTubeFeed))
+		case "/ep-42.vtt":
+			_, _ = w.Write([]byte("WEBVTT\n\n00:00:00.000 --> 00:00:04.000\nWelcome back to the pod\n"))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	return server
+}
+
+func TestEpisodes_PodcastEnclosuresAndTranscripts(t *testing.T) {
+	server := newMediaServer(t)
+	defer server.Close()
+
+	feeds := NewFeeds(5*time.Second, "")
+	episodes, err := feeds.Episodes(context.Background(), server.URL+"/podcast.xml", media.KindPodcast, 10)
+	if err != nil {
+		t.Fatalf("episodes failed: %v", err)
+	}
+	if len(episodes) != 1 {
+		t.Fatalf("expected items without a web audio URL to be skipped, got %+v", episodes)
+	}
+	episode := episodes[0]
+	if episode.ID != "ep-42" || episode.AudioURL != "https://cdn.example/ep-42.mp3" || episode.Feed != "Latent Space" || episode.Author != "Swyx" {
+		t.Fatalf("unexpected episode %+v", episode)
+	}
+	if episode.Duration != time.Hour+2*time.Minute+3*time.Second {
+		t.Fatalf("unexpected duration %v", episode.Duration)
+	}
+	if episode.TranscriptURL != server.URL+"/ep-42.vtt" || episode.TranscriptType != "text/vtt" {
+		t.Fatalf("expected the WebVTT transcript, got %q (%q)", episode.TranscriptURL, episode.TranscriptType)
+	}
+
+	cues, err := feeds.Transcript(context.Background(), episode.TranscriptURL)
+	if err != nil {
+		t.Fatalf("transcript failed: %v", err)
+	}
+	if len(cues) != 1 || cues[0].Text != "Welcome back to the pod" {
+		t.Fatalf("unexpected cues %+v", cues)
+	}
+}
+
+func TestEpisodes_YouTubeFeed(t *testing.T) {
+	server := newMediaServer(t)
+	defer server.Close()
+
+	feeds := NewFeeds(5*time.Second, "")
+	episodes, err := feeds.Episodes(context.Background(), server.URL+"/youtube.xml", media.KindVideo, 10)
+	if err != nil {
+		t.Fatalf("episodes failed: %v", err)
+	}
+	if len(episodes) != 1 {
+		t.Fatalf("expected one video, got %d", len(episodes))
+	}
+	video := episodes[0]
+	if video.ID != "youtube:abc123" || video.URL != "https://www.youtube.com/watch?v=abc123" || video.Author != "Károly" {
+		t.Fatalf("unexpected video %+v", video)
+	}
+	if video.Description != "Paper link below" || video.PublishedAt.Day() != 2 {
+		t.Fatalf("unexpected video details %+v", video)
+	}
+}
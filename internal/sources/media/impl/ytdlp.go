@@ -0,0 +1,105 @@
+package impl
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/bakkerme/curator-ai/internal/sources/media"
+)
+
+// YTDLP runs the yt-dlp binary to fetch subtitles and audio.
+type YTDLP struct {
+	binary string
+}
+
+// NewYTDLP builds an extractor for the yt-dlp binary at path (default: "yt-dlp" on PATH).
+func NewYTDLP(path string) *YTDLP {
+	if strings.TrimSpace(path) == "" {
+		path = "yt-dlp"
+	}
+	return &YTDLP{binary: path}
+}
+
+// Subtitles downloads uploaded or automatic subtitles and returns the cues of
+// the first language in languages that yt-dlp found.
+func (y *YTDLP) Subtitles(ctx context.Context, url string, languages []string) ([]media.Cue, error) {
+	dir, err := os.MkdirTemp("", "curator-subs-*")
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	err = y.run(ctx,
+		"--skip-download", "--write-subs", "--write-auto-subs",
+		"--sub-langs", strings.Join(languages, ","),
+		"--sub-format", "vtt/srt/best",
+		"--no-playlist", "--no-progress", "--quiet",
+		"-o", filepath.Join(dir, "subs.%(ext)s"),
+		// "--" keeps a feed-supplied URL from being parsed as an option.
+		"--", url,
+	)
+	if err != nil {
+		return nil, err
+	}
+	files, err := filepath.Glob(filepath.Join(dir, "subs.*"))
+	if err != nil || len(files) == 0 {
+		return nil, err
+	}
+	path := files[0]
+pick:
+	for _, language := range languages {
+		for _, file := range files {
+			if strings.HasPrefix(filepath.Base(file), "subs."+language) {
+				path = file
+				break pick
+			}
+		}
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return media.ParseSubtitles(string(data)), nil
+}
+
+// Audio downloads the best audio stream of url into dir.
+func (y *YTDLP) Audio(ctx context.Context, url string, dir string) (string, error) {
+	err := y.run(ctx,
+		"-f", "bestaudio/best",
+		"--no-playlist", "--no-progress", "--quiet",
+		"-o", filepath.Join(dir, "audio.%(ext)s"),
+		"--", url,
+	)
+	if err != nil {
+		return "", err
+	}
+	files, err := filepath.Glob(filepath.Join(dir, "audio.*"))
+	if err != nil {
+		return "", err
+	}
+	for _, file := range files {
+		if !strings.HasSuffix(file, ".part") {
+			return file, nil
+		}
+	}
+	return "", fmt.Errorf("yt-dlp wrote no audio for %s", url)
+}
+
+func (y *YTDLP) run(ctx context.Context, args ...string) error {
+	var stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, y.binary, args...)
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		message := strings.TrimSpace(stderr.String())
+		if len(message) > 512 {
+			message = message[len(message)-512:]
+		}
+		return fmt.Errorf("yt-dlp: %w: %s", err, message)
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package impl
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// fakeYTDLP writes a shell script that mimics yt-dlp's output files: English
+// subtitles for --skip-download runs (none for URLs containing "nosubs") and
+// an m4a file otherwise. Each invocation's arguments are logged to calls.log.
+func fakeYTDLP(t *testing.T) (string, string) {
+	t.Helper()
+	dir := t.TempDir()
+	script := `#!/bin/sh
+echo "$@" >> "` + filepath.Join(dir, "calls.log") + `"
+out=""; skip=""; url=""
+while [ $# -gt 0 ]; do
+  case "$1" in
+    -o) out="$2"; shift ;;
+    --skip-download) skip=1 ;;
+    --sub-langs|--sub-format|-f) shift ;;
+    -*) ;;
+    *) url="$1" ;;
+  esac
+  shift
+done
+case "$url" in *fail*) echo "ERROR: video unavailable" >&2; exit 1 ;; esac
+if [ -n "$skip" ]; then
+  case "$url" in *nosubs*) exit 0 ;; esac
+  printf 'WEBVTT\n\n00:00:01.000 --> 00:00:03.000\nHello from subtitles\n' > "$(echo "$out" | sed 's/%(ext)s/en.vtt/')"
+else
+  printf 'audio-bytes' > "$(echo "$out" | sed 's/%(ext)s/m4a/')"
+fi
+`
+	path := filepath.Join(dir, "yt-dlp")
+	if err := os.WriteFile(path, []byte(script), 0o755); err != nil {
+		t.Fatalf("write fake yt-dlp: %v", err)
+	}
+	return path, filepath.Join(dir, "calls.log")
+}
+
+func TestYTDLP_Subtitles(t *testing.T) {
+	binary, calls := fakeYTDLP(t)
+	extractor := NewYTDLP(binary)
+
+	cues, err := extractor.Subtitles(context.Background(), "https://www.youtube.com/watch?v=abc", []string{"de", "en"})
+	if err != nil {
+		t.Fatalf("subtitles failed: %v", err)
+	}
+	if len(cues) != 1 || cues[0].Text != "Hello from subtitles" {
+		t.Fatalf("unexpected cues %+v", cues)
+	}
+	logged, _ := os.ReadFile(calls)
+	if !strings.Contains(string(logged), "--sub-langs de,en") || !strings.Contains(string(logged), "--write-auto-subs") ||
+		!strings.Contains(string(logged), "-- https://www.youtube.com/watch?v=abc") {
+		t.Fatalf("unexpected yt-dlp arguments %q", logged)
+	}
+
+	cues, err = extractor.Subtitles(context.Background(), "https://www.youtube.com/watch?v=nosubs", []string{"en"})
+	if err != nil || len(cues) != 0 {
+		t.Fatalf("expected no cues and no error without subtitles, got %+v, %v", cues, err)
+	}
+}
+
+func TestYTDLP_AudioAndErrors(t *testing.T) {
+	binary, calls := fakeYTDLP(t)
+	extractor := NewYTDLP(binary)
+
+	dir := t.TempDir()
+	path, err := extractor.Audio(context.Background(), "https://cdn.example/ep.mp3", dir)
+	if err != nil {
+		t.Fatalf("audio failed: %v", err)
+	}
+	if path != filepath.Join(dir, "audio.m4a") {
+		t.Fatalf("unexpected audio path %q", path)
+	}
+	if logged, _ := os.ReadFile(calls); !strings.HasSuffix(strings.TrimSpace(string(logged)), "-- https://cdn.example/ep.mp3") {
+		t.Fatalf("expected the URL after --, got %q", logged)
+	}
+
+	_, err = extractor.Audio(context.Background(), "https://cdn.example/fail.mp3", t.TempDir())
+	if err == nil || !strings.Contains(err.Error(), "video unavailable") {
+		t.Fatalf("expected yt-dlp stderr in error, got %v", err)
+	}
+}
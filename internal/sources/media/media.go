@@ -0,0 +1,56 @@
+package media
+
+import (
+	"context"
+	"time"
+)
+
+// Kind distinguishes audio episodes from videos.
+type Kind string
+
+const (
+	KindPodcast Kind = "podcast"
+	KindVideo   Kind = "video"
+)
+
+// Episode is a podcast episode or a video read from a feed.
+type Episode struct {
+	ID    string
+	Kind  Kind
+	Title string
+	// URL is the episode or video page; for podcasts without a page it is
+	// the audio URL.
+	URL         string
+	AudioURL    string
+	Description string
+	Author      string
+	Feed        string
+	PublishedAt time.Time
+	Duration    time.Duration
+	// TranscriptURL and TranscriptType point at a transcript the publisher
+	// provides (podcast:transcript), if any.
+	TranscriptURL  string
+	TranscriptType string
+}
+
+// Cue is one timed line of a transcript.
+type Cue struct {
+	Start time.Duration
+	End   time.Duration
+	Text  string
+}
+
+// Feeds reads podcast RSS and YouTube Atom feeds and published transcripts.
+type Feeds interface {
+	Episodes(ctx context.Context, feedURL string, kind Kind, limit int) ([]Episode, error)
+	Transcript(ctx context.Context, url string) ([]Cue, error)
+}
+
+// Extractor fetches subtitles and audio for a media URL (yt-dlp).
+type Extractor interface {
+	// Subtitles returns published or automatic subtitles in the first
+	// available language, or no cues when there are none.
+	Subtitles(ctx context.Context, url string, languages []string) ([]Cue, error)
+	// Audio downloads the audio track into dir and returns the file path.
+	Audio(ctx context.Context, url string, dir string) (string, error)
+}
@@ -0,0 +1,352 @@
+package media
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bakkerme/curator-ai/internal/config"
+	"github.com/bakkerme/curator-ai/internal/core"
+	"github.com/bakkerme/curator-ai/internal/dedupe"
+	"github.com/bakkerme/curator-ai/internal/llm"
+	"github.com/bakkerme/curator-ai/internal/sources"
+)
+
+const (
+	defaultLimit              = 5
+	defaultTranscriptionModel = "whisper-1"
+	defaultMaxAudioMB         = 25
+	// paragraphSpan is how much audio a timestamped transcript paragraph covers.
+	paragraphSpan  = time.Minute
+	youTubeFeedURL = "https://www.youtube.com/feeds/videos.xml"
+	dedupePrefix   = "media:"
+	// paragraphMarker is the widest separator written before a cue.
+	paragraphMarker = "\n\n[00:00:00] "
+)
+
+// Transcript sources recorded in the "transcript_source" metadata key.
+const (
+	TranscriptPublished     = "published"
+	TranscriptSubtitles     = "subtitles"
+	TranscriptTranscription = "transcription"
+)
+
+// MediaProcessor emits one PostBlock per podcast episode or video, with the
+// transcript as timestamped content and chunks. Transcripts come from the
+// publisher's transcript or subtitles when available and otherwise from
+// transcribing the audio.
+type MediaProcessor struct {
+	name        string
+	config      config.MediaSource
+	feeds       Feeds
+	extractor   Extractor
+	transcriber llm.Transcriber
+	store       dedupe.SeenStore
+}
+
+// NewMediaProcessor wires a new media source. extractor is needed for
+// YouTube feeds and transcription; transcriber only for transcription.
+func NewMediaProcessor(cfg *config.MediaSource, feeds Feeds, extractor Extractor, transcriber llm.Transcriber, store dedupe.SeenStore) (*MediaProcessor, error) {
+	if cfg == nil {
+		return nil, fmt.Errorf("media config is required")
+	}
+	return &MediaProcessor{
+		name:        "media",
+		config:      *cfg,
+		feeds:       feeds,
+		extractor:   extractor,
+		transcriber: transcriber,
+		store:       store,
+	}, nil
+}
+
+func (p *MediaProcessor) Name() string {
+	return p.name
+}
+
+func (p *MediaProcessor) Configure(config map[string]interface{}) error {
+	return nil
+}
+
+func (p *MediaProcessor) Validate() error {
+	if len(p.config.Podcasts) == 0 && len(p.config.Channels) == 0 && len(p.config.Playlists) == 0 {
+		return fmt.Errorf("media podcasts, channels or playlists are required")
+	}
+	if p.feeds == nil {
+		return fmt.Errorf("media feed reader is required")
+	}
+	if p.extractor == nil && (len(p.config.Channels) > 0 || len(p.config.Playlists) > 0 || p.config.Transcription != nil) {
+		return fmt.Errorf("media extractor (yt-dlp) is required for YouTube feeds and transcription")
+	}
+	if p.transcriber == nil && p.config.Transcription != nil {
+		return fmt.Errorf("media transcription requires a transcriber")
+	}
+	return nil
+}
+
+type mediaFeed struct {
+	url  string
+	kind Kind
+}
+
+func (p *MediaProcessor) Fetch(ctx context.Context) ([]*core.PostBlock, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	logger := core.LoggerFromContext(ctx).With("stage", "source", "processor", p.name)
+
+	limit := p.config.Limit
+	if limit <= 0 {
+		limit = defaultLimit
+	}
+	var feeds []mediaFeed
+	for _, podcast := range p.config.Podcasts {
+		feeds = append(feeds, mediaFeed{url: podcast, kind: KindPodcast})
+	}
+	for _, channel := range p.config.Channels {
+		feeds = append(feeds, mediaFeed{url: youTubeFeedURL + "?" + url.Values{"channel_id": {channel}}.Encode(), kind: KindVideo})
+	}
+	for _, playlist := range p.config.Playlists {
+		feeds = append(feeds, mediaFeed{url: youTubeFeedURL + "?" + url.Values{"playlist_id": {playlist}}.Encode(), kind: KindVideo})
+	}
+
+	var blocks []*core.PostBlock
+	var fetchErrs []error
+	emitted := make(map[string]bool)
+	for _, feed := range feeds {
+		logger.Info("Fetching media feed", "feed", feed.url, "kind", feed.kind)
+		episodes, err := p.feeds.Episodes(ctx, feed.url, feed.kind, limit)
+		if err != nil {
+			// Episodes from earlier feeds are already marked seen, so one
+			// dead feed must not discard them.
+			logger.Warn("Failed to fetch media feed", "feed", feed.url, "error", err)
+			fetchErrs = append(fetchErrs, fmt.Errorf("feed %s: %w", feed.url, err))
+			core.RecordRunError(ctx, core.ProcessError{
+				ProcessorName: p.name,
+				Stage:         "source",
+				Error:         fmt.Sprintf("feed %s: %v", feed.url, err),
+				OccurredAt:    time.Now().UTC(),
+			})
+			continue
+		}
+		for _, episode := range episodes {
+			key := dedupePrefix + episode.ID
+			if emitted[key] || sources.Seen(ctx, p.store, logger, "episode", key) {
+				continue
+			}
+			cues, source, err := p.transcript(ctx, logger, episode)
+			if err != nil {
+				logger.Warn("Failed to obtain transcript; skipping episode", "episode", episode.URL, "error", err)
+				continue
+			}
+			if len(cues) == 0 {
+				logger.Info("No transcript available; skipping episode", "episode", episode.URL)
+				continue
+			}
+			blocks = append(blocks, p.buildBlock(episode, cues, source))
+			emitted[key] = true
+			if p.store != nil {
+				if err := p.store.MarkSeen(ctx, key); err != nil {
+					logger.Warn("Failed to mark episode as seen", "episode", key, "error", err)
+				}
+			}
+		}
+	}
+	if len(fetchErrs) == len(feeds) {
+		return nil, fmt.Errorf("all media feeds failed: %w", errors.Join(fetchErrs...))
+	}
+	return blocks, nil
+}
+
+// transcript prefers the publisher's transcript, then subtitles, then
+// transcribing the audio when transcription is configured.
+func (p *MediaProcessor) transcript(ctx context.Context, logger *slog.Logger, episode Episode) ([]Cue, string, error) {
+	if episode.TranscriptURL != "" {
+		cues, err := p.feeds.Transcript(ctx, episode.TranscriptURL)
+		if err != nil {
+			logger.Warn("Failed to read published transcript", "episode", episode.URL, "transcript", episode.TranscriptURL, "error", err)
+		} else if len(cues) > 0 {
+			return cues, TranscriptPublished, nil
+		}
+	}
+	if episode.Kind == KindVideo && p.extractor != nil {
+		languages := p.config.SubtitleLanguages
+		if len(languages) == 0 {
+			languages = []string{"en"}
+		}
+		cues, err := p.extractor.Subtitles(ctx, episode.URL, languages)
+		if err != nil {
+			logger.Warn("Failed to read subtitles", "episode", episode.URL, "error", err)
+		} else if len(cues) > 0 {
+			return cues, TranscriptSubtitles, nil
+		}
+	}
+	if p.config.Transcription == nil {
+		return nil, "", nil
+	}
+	cues, err := p.transcribe(ctx, episode)
+	return cues, TranscriptTranscription, err
+}
+
+func (p *MediaProcessor) transcribe(ctx context.Context, episode Episode) ([]Cue, error) {
+	cfg := p.config.Transcription
+	dir, err := os.MkdirTemp("", "curator-media-*")
+	if err != nil {
+		return nil, fmt.Errorf("create audio dir: %w", err)
+	}
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	source := episode.AudioURL
+	if source == "" {
+		source = episode.URL
+	}
+	path, err := p.extractor.Audio(ctx, source, dir)
+	if err != nil {
+		return nil, fmt.Errorf("download audio: %w", err)
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("stat audio: %w", err)
+	}
+	maxMB := cfg.MaxAudioMB
+	if maxMB <= 0 {
+		maxMB = defaultMaxAudioMB
+	}
+	if info.Size() > int64(maxMB)<<20 {
+		return nil, fmt.Errorf("audio is %d MB, above max_audio_mb %d", info.Size()>>20, maxMB)
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("open audio: %w", err)
+	}
+	defer func() { _ = file.Close() }()
+
+	model := cfg.Model
+	if model == "" {
+		model = defaultTranscriptionModel
+	}
+	response, err := p.transcriber.Transcribe(ctx, llm.TranscriptionRequest{
+		Model:    model,
+		Audio:    file,
+		Filename: filepath.Base(path),
+		Language: cfg.Language,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("transcribe audio: %w", err)
+	}
+	cues := make([]Cue, 0, len(response.Segments))
+	for _, segment := range response.Segments {
+		cues = append(cues, Cue{Start: segment.Start, End: segment.End, Text: segment.Text})
+	}
+	return cues, nil
+}
+
+func (p *MediaProcessor) buildBlock(episode Episode, cues []Cue, source string) *core.PostBlock {
+	plan := sources.SummaryPlanFromConfig(p.config.SummaryPlan)
+	chunks := chunkCues(cues, plan.MaxChunkChars)
+	parts := make([]string, 0, len(chunks))
+	for _, chunk := range chunks {
+		parts = append(parts, chunk.Content)
+	}
+	if plan.ChunkLimit > 0 && len(chunks) > plan.ChunkLimit {
+		chunks = chunks[:plan.ChunkLimit]
+	}
+
+	block := &core.PostBlock{
+		ID:          episode.ID,
+		URL:         episode.URL,
+		Title:       episode.Title,
+		Content:     strings.Join(parts, "\n\n"),
+		Author:      episode.Author,
+		CreatedAt:   episode.PublishedAt,
+		Chunks:      chunks,
+		SummaryPlan: plan,
+		ProcessedAt: time.Now().UTC(),
+		Metadata: map[string]string{
+			"media_kind":        string(episode.Kind),
+			"transcript_source": source,
+		},
+	}
+	if episode.Feed != "" {
+		block.Metadata["feed"] = episode.Feed
+	}
+	if episode.AudioURL != "" {
+		block.Metadata["audio_url"] = episode.AudioURL
+	}
+	if episode.Duration > 0 {
+		block.Metadata["duration_seconds"] = strconv.Itoa(int(episode.Duration / time.Second))
+	}
+	return block
+}
+
+// chunkCues packs cues into chunks of at most maxChars characters. Within a
+// chunk, a "[hh:mm:ss]" paragraph starts every paragraphSpan of audio, and
+// each chunk records the time span it covers.
+func chunkCues(cues []Cue, maxChars int) []core.ContentChunk {
+	if maxChars <= 0 {
+		maxChars = sources.DefaultMaxChunkChars
+	}
+	var chunks []core.ContentChunk
+	var text strings.Builder
+	var chunk core.ContentChunk
+	var paragraphStart time.Duration
+	for _, cue := range splitLongCues(cues, maxChars/2) {
+		if text.Len() > 0 && text.Len()+len(cue.Text)+len(paragraphMarker) > maxChars {
+			chunk.Content = text.String()
+			chunks = append(chunks, chunk)
+			text.Reset()
+		}
+		switch {
+		case text.Len() == 0:
+			chunk = core.ContentChunk{Start: cue.Start}
+			paragraphStart = cue.Start
+			text.WriteString("[" + formatTimestamp(cue.Start) + "] ")
+		case cue.Start-paragraphStart >= paragraphSpan:
+			paragraphStart = cue.Start
+			text.WriteString("\n\n[" + formatTimestamp(cue.Start) + "] ")
+		default:
+			text.WriteString(" ")
+		}
+		text.WriteString(cue.Text)
+		chunk.End = max(chunk.End, cue.End)
+	}
+	if text.Len() > 0 {
+		chunk.Content = text.String()
+		chunks = append(chunks, chunk)
+	}
+	return chunks
+}
+
+// splitLongCues breaks cues longer than limit characters at word boundaries,
+// so untimed transcripts (a single cue) still chunk.
+func splitLongCues(cues []Cue, limit int) []Cue {
+	out := make([]Cue, 0, len(cues))
+	for _, cue := range cues {
+		if len(cue.Text) <= limit {
+			out = append(out, cue)
+			continue
+		}
+		var piece strings.Builder
+		for _, word := range strings.Fields(cue.Text) {
+			if piece.Len() > 0 && piece.Len()+len(word)+1 > limit {
+				out = append(out, Cue{Start: cue.Start, End: cue.End, Text: piece.String()})
+				piece.Reset()
+			}
+			if piece.Len() > 0 {
+				piece.WriteString(" ")
+			}
+			piece.WriteString(word)
+		}
+		if piece.Len() > 0 {
+			out = append(out, Cue{Start: cue.Start, End: cue.End, Text: piece.String()})
+		}
+	}
+	return out
+}
@@ -0,0 +1,187 @@
+package media
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bakkerme/curator-ai/internal/config"
+	"github.com/bakkerme/curator-ai/internal/core"
+	"github.com/bakkerme/curator-ai/internal/llm/openai"
+)
+
+type feedsMock struct {
+	episodes    map[string][]Episode
+	transcripts map[string][]Cue
+	errs        map[string]error
+	feedURLs    []string
+}
+
+func (m *feedsMock) Episodes(ctx context.Context, feedURL string, kind Kind, limit int) ([]Episode, error) {
+	m.feedURLs = append(m.feedURLs, feedURL)
+	return m.episodes[feedURL], m.errs[feedURL]
+}
+
+func (m *feedsMock) Transcript(ctx context.Context, transcriptURL string) ([]Cue, error) {
+	return m.transcripts[transcriptURL], nil
+}
+
+type extractorMock struct {
+	subtitles map[string][]Cue
+	audio     []string
+}
+
+func (m *extractorMock) Subtitles(ctx context.Context, url string, languages []string) ([]Cue, error) {
+	return m.subtitles[url], nil
+}
+
+func (m *extractorMock) Audio(ctx context.Context, url string, dir string) (string, error) {
+	m.audio = append(m.audio, url)
+	path := filepath.Join(dir, "audio.m4a")
+	return path, os.WriteFile(path, []byte("audio"), 0o600)
+}
+
+func newTranscriptionServer(t *testing.T, requests *int) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests++
+		if r.URL.Path != "/audio/transcriptions" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"text": "Welcome. Today: attention. That's all.", "segments": [
+			{"start": 0.0, "end": 4.0, "text": " Welcome."},
+			{"start": 4.0, "end": 65.0, "text": " Today: attention."},
+			{"start": 65.0, "end": 70.0, "text": " That's all."}
+		]}`))
+	}))
+}
+
+func TestMediaProcessor_PrefersSubtitlesThenTranscribes(t *testing.T) {
+	var requests int
+	server := newTranscriptionServer(t, &requests)
+	defer server.Close()
+
+	channelFeed := youTubeFeedURL + "?channel_id=UC123"
+	feeds := &feedsMock{episodes: map[string][]Episode{
+		"https://pod.example/feed.xml": {
+			{ID: "ep-1", Kind: KindPodcast, Title: "Attention", URL: "https://pod.example/ep-1", AudioURL: "https://cdn.example/ep-1.mp3", Feed: "Pod", Duration: 70 * time.Second},
+		},
+		channelFeed: {
+			{ID: "youtube:abc", Kind: KindVideo, Title: "Subtitled", URL: "https://www.youtube.com/watch?v=abc"},
+		},
+	}}
+	extractor := &extractorMock{subtitles: map[string][]Cue{
+		"https://www.youtube.com/watch?v=abc": {{Start: time.Second, End: 3 * time.Second, Text: "Hello from subtitles"}},
+	}}
+	transcriber := openai.NewClient(config.OpenAIEnvConfig{BaseURL: server.URL, APIKey: "test"})
+	cfg := &config.MediaSource{
+		Podcasts:      []string{"https://pod.example/feed.xml"},
+		Channels:      []string{"UC123"},
+		Transcription: &config.MediaTranscriptionConfig{},
+		SummaryPlan:   &config.SummaryPlanConfig{Mode: "map_reduce", MaxChunkChars: 60},
+	}
+	processor, err := NewMediaProcessor(cfg, feeds, extractor, transcriber, nil)
+	if err != nil {
+		t.Fatalf("failed to create processor: %v", err)
+	}
+
+	blocks, err := processor.Fetch(context.Background())
+	if err != nil {
+		t.Fatalf("fetch failed: %v", err)
+	}
+	if len(blocks) != 2 {
+		t.Fatalf("expected two blocks, got %d", len(blocks))
+	}
+	if requests != 1 || len(extractor.audio) != 1 || extractor.audio[0] != "https://cdn.example/ep-1.mp3" {
+		t.Fatalf("expected only the podcast to be transcribed, got %d requests for %v", requests, extractor.audio)
+	}
+
+	podcast := blocks[0]
+	if podcast.Metadata["transcript_source"] != TranscriptTranscription || podcast.Metadata["duration_seconds"] != "70" {
+		t.Fatalf("unexpected podcast metadata %+v", podcast.Metadata)
+	}
+	if len(podcast.Chunks) != 2 {
+		t.Fatalf("expected two chunks, got %+v", podcast.Chunks)
+	}
+	first, second := podcast.Chunks[0], podcast.Chunks[1]
+	if first.Content != "[00:00:00] Welcome. Today: attention." || first.Start != 0 || first.End != 65*time.Second {
+		t.Fatalf("unexpected first chunk %+v", first)
+	}
+	if second.Content != "[00:01:05] That's all." || second.Start != 65*time.Second || second.End != 70*time.Second {
+		t.Fatalf("unexpected second chunk %+v", second)
+	}
+	if !strings.Contains(podcast.Content, "Today: attention.") || !strings.Contains(podcast.Content, "That's all.") {
+		t.Fatalf("unexpected content %q", podcast.Content)
+	}
+
+	video := blocks[1]
+	if video.Metadata["transcript_source"] != TranscriptSubtitles || video.Content != "[00:00:01] Hello from subtitles" {
+		t.Fatalf("unexpected video block %+v", video)
+	}
+}
+
+func TestMediaProcessor_SkipsEpisodesWithoutTranscript(t *testing.T) {
+	feeds := &feedsMock{
+		episodes: map[string][]Episode{"https://pod.example/feed.xml": {
+			{ID: "ep-1", Kind: KindPodcast, URL: "https://pod.example/ep-1", TranscriptURL: "https://pod.example/ep-1.vtt"},
+			{ID: "ep-2", Kind: KindPodcast, URL: "https://pod.example/ep-2", AudioURL: "https://cdn.example/ep-2.mp3"},
+		}},
+		transcripts: map[string][]Cue{"https://pod.example/ep-1.vtt": {{Text: "Published words"}}},
+	}
+	cfg := &config.MediaSource{Podcasts: []string{"https://pod.example/feed.xml"}}
+	processor, err := NewMediaProcessor(cfg, feeds, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to create processor: %v", err)
+	}
+
+	blocks, err := processor.Fetch(context.Background())
+	if err != nil {
+		t.Fatalf("fetch failed: %v", err)
+	}
+	if len(blocks) != 1 || blocks[0].ID != "ep-1" || blocks[0].Metadata["transcript_source"] != TranscriptPublished {
+		t.Fatalf("expected only the published transcript episode, got %+v", blocks)
+	}
+}
+
+func TestMediaProcessor_RecordsFailedFeedsAndKeepsOthers(t *testing.T) {
+	feeds := &feedsMock{
+		episodes: map[string][]Episode{"https://pod.example/feed.xml": {
+			{ID: "ep-1", Kind: KindPodcast, URL: "https://pod.example/ep-1", TranscriptURL: "https://pod.example/ep-1.vtt"},
+		}},
+		transcripts: map[string][]Cue{"https://pod.example/ep-1.vtt": {{Text: "Published words"}}},
+		errs:        map[string]error{"https://dead.example/feed.xml": errors.New("404 Not Found")},
+	}
+	cfg := &config.MediaSource{Podcasts: []string{"https://pod.example/feed.xml", "https://dead.example/feed.xml"}}
+	processor, err := NewMediaProcessor(cfg, feeds, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to create processor: %v", err)
+	}
+
+	runErrors := &core.RunErrors{}
+	blocks, err := processor.Fetch(core.WithRunErrors(context.Background(), runErrors))
+	if err != nil {
+		t.Fatalf("expected a partial failure not to fail the fetch, got %v", err)
+	}
+	if len(blocks) != 1 || blocks[0].ID != "ep-1" {
+		t.Fatalf("expected the healthy feed's episode, got %+v", blocks)
+	}
+	if errs := runErrors.Errors(); len(errs) != 1 || !strings.Contains(errs[0].Error, "https://dead.example/feed.xml") {
+		t.Fatalf("expected the dead feed recorded as a run error, got %+v", errs)
+	}
+
+	cfg.Podcasts = []string{"https://dead.example/feed.xml"}
+	processor, err = NewMediaProcessor(cfg, feeds, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to create processor: %v", err)
+	}
+	if _, err := processor.Fetch(context.Background()); err == nil || !strings.Contains(err.Error(), "all media feeds failed") {
+		t.Fatalf("expected an error when every feed fails, got %v", err)
+	}
+}
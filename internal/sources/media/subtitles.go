@@ -0,0 +1,90 @@
+package media
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	timingPattern    = regexp.MustCompile(`^((?:\d+:)?\d{1,2}:\d{2}[.,]\d{1,3})\s+-->\s+((?:\d+:)?\d{1,2}:\d{2}[.,]\d{1,3})`)
+	cueMarkupPattern = regexp.MustCompile(`<[^>]*>`)
+)
+
+// ParseSubtitles reads WebVTT or SRT cues. Markup is stripped, and lines
+// repeated from the previous cue (the rolling captions of automatic
+// subtitles) are dropped.
+func ParseSubtitles(data string) []Cue {
+	data = strings.ReplaceAll(strings.ReplaceAll(data, "\r\n", "\n"), "\r", "\n")
+	var cues []Cue
+	var previous []string
+	for _, block := range strings.Split(data, "\n\n") {
+		lines := strings.Split(strings.TrimSpace(block), "\n")
+		timing := -1
+		for i, line := range lines {
+			if timingPattern.MatchString(strings.TrimSpace(line)) {
+				timing = i
+				break
+			}
+		}
+		if timing < 0 {
+			continue
+		}
+		match := timingPattern.FindStringSubmatch(strings.TrimSpace(lines[timing]))
+		var text []string
+		var current []string
+		for _, line := range lines[timing+1:] {
+			line = strings.TrimSpace(cueMarkupPattern.ReplaceAllString(line, ""))
+			if line == "" {
+				continue
+			}
+			current = append(current, line)
+			if !slices.Contains(previous, line) {
+				text = append(text, line)
+			}
+		}
+		if len(current) > 0 {
+			previous = current
+		}
+		if len(text) == 0 {
+			continue
+		}
+		cues = append(cues, Cue{
+			Start: parseTimestamp(match[1]),
+			End:   parseTimestamp(match[2]),
+			Text:  strings.Join(text, " "),
+		})
+	}
+	return cues
+}
+
+// parseTimestamp reads [hh:]mm:ss.mmm (or a comma before the milliseconds).
+func parseTimestamp(value string) time.Duration {
+	value = strings.Replace(value, ",", ".", 1)
+	parts := strings.Split(value, ":")
+	var total time.Duration
+	for i, part := range parts {
+		unit := time.Second
+		switch len(parts) - i {
+		case 3:
+			unit = time.Hour
+		case 2:
+			unit = time.Minute
+		}
+		number, err := strconv.ParseFloat(part, 64)
+		if err != nil {
+			return 0
+		}
+		total += time.Duration(number * float64(unit))
+	}
+	return total
+}
+
+// formatTimestamp renders an offset as hh:mm:ss.
+func formatTimestamp(offset time.Duration) string {
+	seconds := int(offset / time.Second)
+	return fmt.Sprintf("%02d:%02d:%02d", seconds/3600, seconds/60%60, seconds%60)
+}
@@ -0,0 +1,64 @@
+package media
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseSubtitles_WebVTTDropsRollingDuplicates(t *testing.T) {
+	vtt := "WEBVTT\nKind: captions\nLanguage: en\n\n" +
+		"00:00:01.000 --> 00:00:03.500 align:start position:0%\n" +
+		"welcome<00:00:01.500><c> to</c><00:00:02.000><c> the show</c>\n\n" +
+		"00:00:03.500 --> 00:00:06.000\n" +
+		"welcome to the show\n" +
+		"today we talk about GPUs\n\n" +
+		"01:02:03.250 --> 01:02:04.000\n" +
+		"bye\n"
+	cues := ParseSubtitles(vtt)
+	if len(cues) != 3 {
+		t.Fatalf("expected 3 cues, got %+v", cues)
+	}
+	if cues[0].Text != "welcome to the show" || cues[0].Start != time.Second || cues[0].End != 3500*time.Millisecond {
+		t.Fatalf("unexpected first cue %+v", cues[0])
+	}
+	if cues[1].Text != "today we talk about GPUs" {
+		t.Fatalf("expected the repeated caption line to be dropped, got %q", cues[1].Text)
+	}
+	if cues[2].Start != time.Hour+2*time.Minute+3250*time.Millisecond {
+		t.Fatalf("unexpected hour timestamp %v", cues[2].Start)
+	}
+}
+
+func TestParseSubtitles_SRT(t *testing.T) {
+	srt := "1\r\n00:00:00,500 --> 00:00:02,000\r\nHello\r\nthere\r\n\r\n2\r\n00:00:02,000 --> 00:00:04,000\r\n<i>General Kenobi</i>\r\n"
+	cues := ParseSubtitles(srt)
+	if len(cues) != 2 || cues[0].Text != "Hello there" || cues[0].Start != 500*time.Millisecond || cues[1].Text != "General Kenobi" {
+		t.Fatalf("unexpected cues %+v", cues)
+	}
+}
+
+func TestChunkCues_PreservesTimestamps(t *testing.T) {
+	var cues []Cue
+	for i := 0; i < 40; i++ {
+		start := time.Duration(i) * 5 * time.Second
+		cues = append(cues, Cue{Start: start, End: start + 5*time.Second, Text: "twenty characters ok"})
+	}
+	chunks := chunkCues(cues, 300)
+	if len(chunks) < 3 {
+		t.Fatalf("expected several chunks, got %d", len(chunks))
+	}
+	if chunks[0].Start != 0 || chunks[0].Content[:10] != "[00:00:00]" {
+		t.Fatalf("unexpected first chunk %+v", chunks[0])
+	}
+	for i, chunk := range chunks {
+		if len(chunk.Content) > 300 {
+			t.Fatalf("chunk %d exceeds max chars: %d", i, len(chunk.Content))
+		}
+		if i > 0 && chunk.Start != chunks[i-1].End {
+			t.Fatalf("chunk %d starts at %v, previous ended at %v", i, chunk.Start, chunks[i-1].End)
+		}
+	}
+	if last := chunks[len(chunks)-1]; last.End != 200*time.Second {
+		t.Fatalf("expected last chunk to end at 200s, got %v", last.End)
+	}
+}
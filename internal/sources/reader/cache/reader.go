@@ -0,0 +1,130 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bakkerme/curator-ai/internal/core"
+	"github.com/bakkerme/curator-ai/internal/sources/reader"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+const (
+	statusHit   = "hit"
+	statusMiss  = "miss"
+	statusStale = "stale"
+)
+
+// Options controls caching behavior for a single wrapped reader.
+type Options struct {
+	// Name identifies the wrapped reader in logs and spans and is used as the
+	// cache namespace.
+	Name string
+	// TTL is how long a cached entry is served without re-reading. Zero means
+	// entries never expire.
+	TTL time.Duration
+	// ServeStale returns an expired entry when the wrapped reader fails.
+	ServeStale bool
+}
+
+// Reader decorates a reader.Reader with a persistent, URL-keyed cache so that
+// repeated links (and slow PDF conversions) are only fetched once per TTL.
+type Reader struct {
+	next    reader.Reader
+	store   Store
+	options Options
+	now     func() time.Time
+}
+
+// NewReader wraps next with store. When store is nil the wrapped reader is
+// returned unchanged so callers can wire caching unconditionally.
+func NewReader(next reader.Reader, store Store, options Options) reader.Reader {
+	if next == nil || store == nil {
+		return next
+	}
+	if strings.TrimSpace(options.Name) == "" {
+		options.Name = "reader"
+	}
+	return &Reader{
+		next:    next,
+		store:   store,
+		options: options,
+		now:     func() time.Time { return time.Now().UTC() },
+	}
+}
+
+func (r *Reader) Read(ctx context.Context, url string) (string, error) {
+	key := strings.TrimSpace(url)
+
+	ctx, span := otel.Tracer("curator-ai/reader").Start(ctx, "reader.read")
+	defer span.End()
+	span.SetAttributes(
+		attribute.String("reader.name", r.options.Name),
+		attribute.String("url.full", key),
+	)
+
+	logger := core.LoggerFromContext(ctx).With("reader", r.options.Name, "url", key)
+
+	cached, err := r.store.Get(ctx, r.options.Name, key)
+	if err != nil {
+		// A broken cache should never block the read itself.
+		logger.Warn("reader cache lookup failed", "error", err)
+		cached = nil
+	}
+
+	if cached != nil && r.isFresh(cached) {
+		logger.Info("reader cache hit", "age", r.now().Sub(cached.FetchedAt))
+		span.SetAttributes(
+			attribute.String("cache.status", statusHit),
+			attribute.Bool("cache.hit", true),
+		)
+		return cached.Content, nil
+	}
+
+	logger.Info("reader cache miss", "expired", cached != nil)
+	span.SetAttributes(attribute.Bool("cache.hit", false))
+
+	content, readErr := r.next.Read(ctx, url)
+	if readErr != nil {
+		if cached != nil && r.options.ServeStale {
+			logger.Warn("reader failed; serving stale cached content", "age", r.now().Sub(cached.FetchedAt), "error", readErr)
+			span.SetAttributes(attribute.String("cache.status", statusStale))
+			span.RecordError(readErr)
+			return cached.Content, nil
+		}
+		span.SetAttributes(attribute.String("cache.status", statusMiss))
+		span.RecordError(readErr)
+		span.SetStatus(codes.Error, readErr.Error())
+		return "", readErr
+	}
+	span.SetAttributes(attribute.String("cache.status", statusMiss))
+
+	if strings.TrimSpace(content) == "" {
+		return content, nil
+	}
+
+	entry := Entry{
+		URL:         key,
+		Content:     content,
+		ContentHash: HashContent(content),
+		FetchedAt:   r.now(),
+	}
+	if cached != nil && cached.ContentHash != entry.ContentHash {
+		logger.Info("reader cache content changed", "previous_hash", cached.ContentHash, "hash", entry.ContentHash)
+	}
+	if err := r.store.Put(ctx, r.options.Name, entry); err != nil {
+		logger.Warn("reader cache write failed", "error", fmt.Errorf("put %s: %w", key, err))
+	}
+	return content, nil
+}
+
+func (r *Reader) isFresh(entry *Entry) bool {
+	if r.options.TTL <= 0 {
+		return true
+	}
+	return r.now().Sub(entry.FetchedAt) < r.options.TTL
+}
@@ -0,0 +1,123 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+type fakeReader struct {
+	content string
+	err     error
+	calls   int
+}
+
+func (f *fakeReader) Read(ctx context.Context, url string) (string, error) {
+	f.calls++
+	if f.err != nil {
+		return "", f.err
+	}
+	return f.content, nil
+}
+
+func newTestStore(t *testing.T) *SQLiteStore {
+	t.Helper()
+	store, err := NewSQLiteStore(filepath.Join(t.TempDir(), "reader-cache.db"), "")
+	if err != nil {
+		t.Fatalf("failed to init sqlite store: %v", err)
+	}
+	t.Cleanup(func() { _ = store.Close() })
+	return store
+}
+
+func TestReaderCachesByURL(t *testing.T) {
+	store := newTestStore(t)
+	inner := &fakeReader{content: "page"}
+	cached := NewReader(inner, store, Options{Name: "web", TTL: time.Hour})
+
+	for i := 0; i < 2; i++ {
+		content, err := cached.Read(context.Background(), "https://example.com/a")
+		if err != nil {
+			t.Fatalf("read failed: %v", err)
+		}
+		if content != "page" {
+			t.Fatalf("expected cached content, got %q", content)
+		}
+	}
+	if inner.calls != 1 {
+		t.Fatalf("expected 1 upstream read, got %d", inner.calls)
+	}
+
+	entry, err := store.Get(context.Background(), "web", "https://example.com/a")
+	if err != nil {
+		t.Fatalf("get failed: %v", err)
+	}
+	if entry == nil || entry.ContentHash != HashContent("page") {
+		t.Fatalf("expected stored entry with content hash, got %#v", entry)
+	}
+
+	// Namespaces isolate readers sharing a store.
+	other, err := store.Get(context.Background(), "pdf", "https://example.com/a")
+	if err != nil {
+		t.Fatalf("get failed: %v", err)
+	}
+	if other != nil {
+		t.Fatalf("expected no entry in other namespace")
+	}
+}
+
+func TestReaderRefreshesExpiredEntries(t *testing.T) {
+	store := newTestStore(t)
+	inner := &fakeReader{content: "v1"}
+	cached := NewReader(inner, store, Options{Name: "web", TTL: time.Hour}).(*Reader)
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	cached.now = func() time.Time { return now }
+
+	if _, err := cached.Read(context.Background(), "https://example.com/a"); err != nil {
+		t.Fatalf("read failed: %v", err)
+	}
+
+	inner.content = "v2"
+	now = now.Add(2 * time.Hour)
+	content, err := cached.Read(context.Background(), "https://example.com/a")
+	if err != nil {
+		t.Fatalf("read failed: %v", err)
+	}
+	if content != "v2" {
+		t.Fatalf("expected refreshed content, got %q", content)
+	}
+	if inner.calls != 2 {
+		t.Fatalf("expected 2 upstream reads, got %d", inner.calls)
+	}
+}
+
+func TestReaderServesStaleOnError(t *testing.T) {
+	store := newTestStore(t)
+	inner := &fakeReader{content: "v1"}
+	cached := NewReader(inner, store, Options{Name: "web", TTL: time.Hour, ServeStale: true}).(*Reader)
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	cached.now = func() time.Time { return now }
+
+	if _, err := cached.Read(context.Background(), "https://example.com/a"); err != nil {
+		t.Fatalf("read failed: %v", err)
+	}
+
+	inner.err = errors.New("upstream down")
+	now = now.Add(2 * time.Hour)
+	content, err := cached.Read(context.Background(), "https://example.com/a")
+	if err != nil {
+		t.Fatalf("expected stale content, got error %v", err)
+	}
+	if content != "v1" {
+		t.Fatalf("expected stale content, got %q", content)
+	}
+
+	cached.options.ServeStale = false
+	if _, err := cached.Read(context.Background(), "https://example.com/a"); err == nil {
+		t.Fatalf("expected upstream error when serve_stale is disabled")
+	}
+}
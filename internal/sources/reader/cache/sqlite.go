@@ -0,0 +1,113 @@
+package cache
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/bakkerme/curator-ai/internal/sqliteutil"
+)
+
+const (
+	defaultSQLiteTable = "reader_cache"
+)
+
+// SQLiteStore keeps reader results in a single SQLite table keyed by
+// (namespace, url).
+type SQLiteStore struct {
+	db         *sql.DB
+	table      string
+	tableIdent string
+}
+
+func NewSQLiteStore(dsn string, table string) (*SQLiteStore, error) {
+	if table == "" {
+		table = defaultSQLiteTable
+	}
+	tableIdent, err := sqliteutil.QuoteIdentifier(table)
+	if err != nil {
+		return nil, err
+	}
+	db, err := sqliteutil.Open(dsn)
+	if err != nil {
+		return nil, err
+	}
+	store := &SQLiteStore{
+		db:         db,
+		table:      table,
+		tableIdent: tableIdent,
+	}
+	if err := store.ensureSchema(context.Background()); err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+	return store, nil
+}
+
+func (s *SQLiteStore) Get(ctx context.Context, namespace string, url string) (*Entry, error) {
+	if url == "" {
+		return nil, nil
+	}
+	entry := Entry{URL: url}
+	query := fmt.Sprintf("SELECT content, content_hash, fetched_at FROM %s WHERE namespace = ? AND url = ?", s.tableIdent)
+	err := s.db.QueryRowContext(ctx, query, namespace, url).Scan(&entry.Content, &entry.ContentHash, &entry.FetchedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &entry, nil
+}
+
+func (s *SQLiteStore) Put(ctx context.Context, namespace string, entry Entry) error {
+	if entry.URL == "" {
+		return nil
+	}
+	if entry.ContentHash == "" {
+		entry.ContentHash = HashContent(entry.Content)
+	}
+	if entry.FetchedAt.IsZero() {
+		entry.FetchedAt = time.Now().UTC()
+	}
+	_, err := s.db.ExecContext(
+		ctx,
+		fmt.Sprintf(`INSERT INTO %s (namespace, url, content, content_hash, fetched_at) VALUES (?, ?, ?, ?, ?)
+		ON CONFLICT(namespace, url) DO UPDATE SET
+			content = excluded.content,
+			content_hash = excluded.content_hash,
+			fetched_at = excluded.fetched_at`, s.tableIdent),
+		namespace,
+		entry.URL,
+		entry.Content,
+		entry.ContentHash,
+		entry.FetchedAt.UTC(),
+	)
+	return err
+}
+
+func (s *SQLiteStore) Close() error {
+	if s == nil || s.db == nil {
+		return nil
+	}
+	return s.db.Close()
+}
+
+func (s *SQLiteStore) ensureSchema(ctx context.Context) error {
+	if s.table == "" {
+		return fmt.Errorf("sqlite table name is required")
+	}
+	ddl := fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (
+		namespace TEXT NOT NULL,
+		url TEXT NOT NULL,
+		content TEXT NOT NULL,
+		content_hash TEXT NOT NULL,
+		fetched_at TIMESTAMP NOT NULL,
+		PRIMARY KEY (namespace, url)
+	)`, s.tableIdent)
+	if _, err := s.db.ExecContext(ctx, ddl); err != nil {
+		return fmt.Errorf("create sqlite table: %w", err)
+	}
+	return nil
+}
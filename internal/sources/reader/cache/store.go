@@ -0,0 +1,32 @@
+package cache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+)
+
+// Entry is a cached reader result for a single URL.
+type Entry struct {
+	URL         string
+	Content     string
+	ContentHash string
+	FetchedAt   time.Time
+}
+
+// Store persists reader results. Namespaces keep entries from different
+// readers (e.g. crawl4ai vs docling) apart when they share one backing store.
+type Store interface {
+	// Get returns the cached entry for url, or nil when nothing is cached.
+	Get(ctx context.Context, namespace string, url string) (*Entry, error)
+	// Put inserts or replaces the cached entry for entry.URL.
+	Put(ctx context.Context, namespace string, entry Entry) error
+	Close() error
+}
+
+// HashContent returns the hex-encoded SHA-256 of content.
+func HashContent(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:])
+}
@@ -102,71 +102,82 @@ func (f *RedditFetcher) Fetch(ctx context.Context, config Config) ([]Item, error
 	if f.initErr != nil {
 		return nil, f.initErr
 	}
-	if len(config.Subreddits) == 0 {
+	queries := resolveQueries(config)
+	if len(queries) == 0 {
 		return nil, fmt.Errorf("no subreddits configured")
 	}
 
-	sort := config.Sort
-	if sort == "" {
-		sort = "hot"
-	}
-
-	limit := config.Limit
-	if limit <= 0 {
-		limit = 25
-	}
-
 	requestsBefore := f.requestCounter.Load()
 
-	f.logger.Info("Fetching Reddit posts", slog.String("subreddits", strings.Join(config.Subreddits, ",")), slog.String("sort", sort), slog.Int("limit", limit))
-	subreddits := strings.Join(config.Subreddits, "+")
-	posts, err := f.fetchPosts(ctx, subreddits, sort, limit, config.TimeFilter)
-	if err != nil {
-		return nil, fmt.Errorf("got error fetching reddit posts %w", err)
-	}
-
-	items := make([]Item, 0, len(posts))
-	for _, post := range posts {
-		if post == nil {
-			continue
-		}
-		if config.MinScore > 0 && post.Score < config.MinScore {
-			continue
+	seen := make(map[string]struct{})
+	items := make([]Item, 0)
+	for _, query := range queries {
+		path, err := query.path()
+		if err != nil {
+			return nil, err
 		}
-
-		item := Item{
-			ID:        post.ID,
-			Title:     post.Title,
-			URL:       canonicalRedditPostURL(post.Permalink),
-			Content:   post.Body,
-			Author:    post.Author,
-			Score:     post.Score,
-			CreatedAt: timestampToTime(post.Created),
+		f.logger.Info("Fetching Reddit posts", slog.String("query", query.label()), slog.String("sort", query.Sort), slog.Int("limit", query.Limit))
+		posts, err := f.fetchPosts(ctx, path)
+		if err != nil {
+			return nil, fmt.Errorf("got error fetching reddit posts for %s: %w", query.label(), err)
 		}
 
-		if config.IncludeWeb || config.IncludeImages {
-			item.WebURLs, item.ImageURLs = extractPostURLs(post)
-			if !config.IncludeWeb {
-				item.WebURLs = nil
+		for _, post := range posts {
+			if post == nil {
+				continue
 			}
-			if !config.IncludeImages {
-				item.ImageURLs = nil
+			if query.MinScore > 0 && post.Score < query.MinScore {
+				continue
+			}
+			// Posts matched by several queries are kept once, from the first query.
+			if _, ok := seen[post.ID]; ok {
+				continue
+			}
+			seen[post.ID] = struct{}{}
+
+			item := Item{
+				ID:              post.ID,
+				Title:           post.Title,
+				URL:             canonicalRedditPostURL(post.Permalink),
+				Content:         post.Body,
+				Author:          post.Author,
+				Subreddit:       post.SubredditName,
+				Score:           post.Score,
+				UpvoteRatio:     float64(post.UpvoteRatio),
+				NumComments:     post.NumberOfComments,
+				Flair:           post.LinkFlairText,
+				IsSelf:          post.IsSelfPost,
+				Over18:          post.NSFW,
+				Domain:          post.Domain,
+				CrosspostParent: post.CrosspostParent,
+				CreatedAt:       timestampToTime(post.Created),
 			}
-		}
 
-		if config.IncludeComments {
-			comments, err := f.fetchTopLevelComments(ctx, post.ID, 25)
-			if err != nil {
-				return nil, err
+			if config.IncludeWeb || config.IncludeImages {
+				item.WebURLs, item.ImageURLs = extractPostURLs(&post.Post)
+				if !config.IncludeWeb {
+					item.WebURLs = nil
+				}
+				if !config.IncludeImages {
+					item.ImageURLs = nil
+				}
 			}
-			item.Comments = comments
-		}
 
-		items = append(items, item)
+			if config.IncludeComments {
+				comments, err := f.fetchCommentTree(ctx, post.ID, commentTreeOptionsFromConfig(config))
+				if err != nil {
+					return nil, err
+				}
+				item.Comments = comments
+			}
+
+			items = append(items, item)
+		}
 	}
 
 	f.logger.Info(
 		"Finished Reddit fetch",
+		slog.Int("queries", len(queries)),
 		slog.Int("posts_fetched", len(items)),
 		slog.Int("http_requests_this_fetch", int(f.requestCounter.Load()-requestsBefore)),
 		slog.Uint64("http_requests_total", f.requestCounter.Load()),
@@ -174,33 +185,183 @@ func (f *RedditFetcher) Fetch(ctx context.Context, config Config) ([]Item, error
 	return items, nil
 }
 
-func (f *RedditFetcher) fetchPosts(ctx context.Context, subreddit, sort string, limit int, timeFilter string) ([]*goreddit.Post, error) {
-	var posts []*goreddit.Post
-	err := f.doWithRetry(ctx, "fetch_posts", func() error {
-		var err error
-		switch strings.ToLower(sort) {
-		case "hot":
-			posts, _, err = f.client.Subreddit.HotPosts(ctx, subreddit, &goreddit.ListOptions{Limit: limit})
-		case "new":
-			posts, _, err = f.client.Subreddit.NewPosts(ctx, subreddit, &goreddit.ListOptions{Limit: limit})
-		case "rising":
-			posts, _, err = f.client.Subreddit.RisingPosts(ctx, subreddit, &goreddit.ListOptions{Limit: limit})
-		case "top":
-			posts, _, err = f.client.Subreddit.TopPosts(ctx, subreddit, &goreddit.ListPostOptions{
-				ListOptions: goreddit.ListOptions{Limit: limit},
-				Time:        timeFilter,
-			})
-		case "controversial":
-			posts, _, err = f.client.Subreddit.ControversialPosts(ctx, subreddit, &goreddit.ListPostOptions{
-				ListOptions: goreddit.ListOptions{Limit: limit},
-				Time:        timeFilter,
-			})
+// resolvedQuery is a Query with source-level defaults applied.
+type resolvedQuery struct {
+	Subreddit   string
+	User        string
+	Multireddit string
+	Search      string
+	Sort        string
+	Limit       int
+	TimeFilter  string
+	MinScore    int
+}
+
+// resolveQueries applies Config defaults to each query. Without explicit
+// queries the configured subreddits are fetched as one combined listing;
+// config validation rejects setting both.
+func resolveQueries(config Config) []resolvedQuery {
+	queries := config.Queries
+	if len(queries) == 0 {
+		if len(config.Subreddits) == 0 {
+			return nil
+		}
+		queries = []Query{{Subreddit: strings.Join(config.Subreddits, "+")}}
+	}
+
+	resolved := make([]resolvedQuery, 0, len(queries))
+	for _, q := range queries {
+		r := resolvedQuery{
+			Subreddit:   strings.TrimPrefix(strings.TrimSpace(q.Subreddit), "r/"),
+			User:        strings.TrimPrefix(strings.TrimSpace(q.User), "u/"),
+			Multireddit: strings.TrimSpace(q.Multireddit),
+			Search:      strings.TrimSpace(q.Search),
+			Sort:        strings.ToLower(firstNonEmpty(q.Sort, config.Sort)),
+			Limit:       q.Limit,
+			TimeFilter:  firstNonEmpty(q.TimeFilter, config.TimeFilter),
+			MinScore:    config.MinScore,
+		}
+		if r.Sort == "" {
+			r.Sort = "hot"
+			if r.Search != "" {
+				r.Sort = "relevance"
+			} else if r.User != "" {
+				r.Sort = "new"
+			}
+		}
+		if r.Limit <= 0 {
+			r.Limit = config.Limit
+		}
+		if r.Limit <= 0 {
+			r.Limit = 25
+		}
+		if q.MinScore != nil {
+			r.MinScore = *q.MinScore
+		}
+		resolved = append(resolved, r)
+	}
+	return resolved
+}
+
+// path returns the API path, including query string, for the listing.
+func (q resolvedQuery) path() (string, error) {
+	params := url.Values{}
+	params.Set("limit", strconv.Itoa(q.Limit))
+	params.Set("raw_json", "1")
+
+	timeFilter := func() {
+		if q.TimeFilter != "" {
+			params.Set("t", q.TimeFilter)
+		}
+	}
+
+	switch {
+	case q.Search != "":
+		params.Set("q", q.Search)
+		params.Set("sort", q.Sort)
+		params.Set("type", "link")
+		timeFilter()
+		if q.Subreddit != "" {
+			params.Set("restrict_sr", "1")
+			return fmt.Sprintf("r/%s/search?%s", q.Subreddit, params.Encode()), nil
+		}
+		return "search?" + params.Encode(), nil
+	case q.User != "":
+		switch q.Sort {
+		case "hot", "new":
+		case "top", "controversial":
+			timeFilter()
 		default:
-			return fmt.Errorf("unsupported reddit sort: %q", sort)
+			return "", fmt.Errorf("unsupported reddit user sort: %q", q.Sort)
+		}
+		params.Set("sort", q.Sort)
+		return fmt.Sprintf("user/%s/submitted?%s", q.User, params.Encode()), nil
+	}
+
+	var base string
+	switch {
+	case q.Multireddit != "":
+		owner, name, ok := strings.Cut(q.Multireddit, "/")
+		if !ok || owner == "" || name == "" {
+			return "", fmt.Errorf("invalid multireddit %q: expected owner/name", q.Multireddit)
+		}
+		base = fmt.Sprintf("user/%s/m/%s", owner, name)
+	case q.Subreddit != "":
+		base = "r/" + q.Subreddit
+	default:
+		return "", fmt.Errorf("reddit query requires a subreddit, user, multireddit or search")
+	}
+	switch q.Sort {
+	case "hot", "new", "rising":
+	case "top", "controversial":
+		timeFilter()
+	default:
+		return "", fmt.Errorf("unsupported reddit sort: %q", q.Sort)
+	}
+	return fmt.Sprintf("%s/%s?%s", base, q.Sort, params.Encode()), nil
+}
+
+// label identifies the query in logs and errors.
+func (q resolvedQuery) label() string {
+	switch {
+	case q.Search != "" && q.Subreddit != "":
+		return fmt.Sprintf("search %q in r/%s", q.Search, q.Subreddit)
+	case q.Search != "":
+		return fmt.Sprintf("search %q", q.Search)
+	case q.User != "":
+		return "u/" + q.User
+	case q.Multireddit != "":
+		return "m/" + q.Multireddit
+	default:
+		return "r/" + q.Subreddit
+	}
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if strings.TrimSpace(v) != "" {
+			return strings.TrimSpace(v)
 		}
+	}
+	return ""
+}
+
+// listingPost extends goreddit.Post with listing fields the library does not decode.
+type listingPost struct {
+	goreddit.Post
+	LinkFlairText   string `json:"link_flair_text"`
+	Domain          string `json:"domain"`
+	CrosspostParent string `json:"crosspost_parent"`
+}
+
+type postListing struct {
+	Data struct {
+		Children []struct {
+			Kind string      `json:"kind"`
+			Data listingPost `json:"data"`
+		} `json:"children"`
+	} `json:"data"`
+}
+
+func (f *RedditFetcher) fetchPosts(ctx context.Context, path string) ([]*listingPost, error) {
+	var posts []*listingPost
+	err := f.doWithRetry(ctx, "fetch_posts", func() error {
+		req, err := f.client.NewRequest(http.MethodGet, path, nil)
 		if err != nil {
 			return err
 		}
+		var listing postListing
+		if _, err := f.client.Do(ctx, req, &listing); err != nil {
+			return err
+		}
+		posts = posts[:0]
+		for i := range listing.Data.Children {
+			child := &listing.Data.Children[i]
+			if child.Kind != "t3" {
+				continue
+			}
+			posts = append(posts, &child.Data)
+		}
 		return nil
 	})
 	if err != nil {
@@ -209,11 +370,40 @@ func (f *RedditFetcher) fetchPosts(ctx context.Context, subreddit, sort string,
 	return posts, nil
 }
 
-func (f *RedditFetcher) fetchTopLevelComments(ctx context.Context, postID string, limit int) ([]Comment, error) {
-	if limit <= 0 {
-		return nil, nil
+const defaultCommentLimit = 25
+
+// commentTreeOptions controls how much of a post's comment tree is kept.
+type commentTreeOptions struct {
+	depth    int
+	limits   []int
+	minScore *int
+}
+
+func commentTreeOptionsFromConfig(config Config) commentTreeOptions {
+	opts := commentTreeOptions{
+		depth:    config.CommentDepth,
+		limits:   config.CommentLimits,
+		minScore: config.MinCommentScore,
+	}
+	if opts.depth <= 0 {
+		opts.depth = 1
+	}
+	if len(opts.limits) == 0 {
+		opts.limits = []int{defaultCommentLimit}
+	}
+	return opts
+}
+
+// limitForLevel returns the per-parent comment cap for a zero-based tree level.
+// The last configured limit applies to every deeper level.
+func (o commentTreeOptions) limitForLevel(level int) int {
+	if level < len(o.limits) {
+		return o.limits[level]
 	}
+	return o.limits[len(o.limits)-1]
+}
 
+func (f *RedditFetcher) fetchCommentTree(ctx context.Context, postID string, opts commentTreeOptions) ([]Comment, error) {
 	var (
 		pc *goreddit.PostAndComments
 	)
@@ -232,27 +422,51 @@ func (f *RedditFetcher) fetchTopLevelComments(ctx context.Context, postID string
 		return nil, nil
 	}
 
+	topLevel := make([]*goreddit.Comment, 0, len(pc.Comments))
 	parentFullID := "t3_" + postID
-	out := make([]Comment, 0, min(len(pc.Comments), limit))
 	for _, c := range pc.Comments {
-		if c == nil || c.ParentID != parentFullID {
+		if c != nil && c.ParentID == parentFullID {
+			topLevel = append(topLevel, c)
+		}
+	}
+	return buildCommentTree(topLevel, opts, 0), nil
+}
+
+// buildCommentTree converts reddit comments into a nested Comment tree, applying
+// the score threshold, per-level limits and depth cap. Comments below the score
+// threshold are dropped together with their replies.
+func buildCommentTree(comments []*goreddit.Comment, opts commentTreeOptions, level int) []Comment {
+	if level >= opts.depth || len(comments) == 0 {
+		return nil
+	}
+	limit := opts.limitForLevel(level)
+	out := make([]Comment, 0, min(len(comments), limit))
+	for _, c := range comments {
+		if c == nil {
 			continue
 		}
 		body := strings.TrimSpace(c.Body)
 		if body == "" || body == "[deleted]" || body == "[removed]" {
 			continue
 		}
+		if opts.minScore != nil && c.Score < *opts.minScore {
+			continue
+		}
 		out = append(out, Comment{
-			ID:        c.ID,
-			Author:    c.Author,
-			Content:   body,
-			CreatedAt: timestampToTime(c.Created),
+			ID:          c.ID,
+			Author:      c.Author,
+			Content:     body,
+			Score:       c.Score,
+			Permalink:   canonicalRedditPostURL(c.Permalink),
+			IsSubmitter: c.IsSubmitter,
+			CreatedAt:   timestampToTime(c.Created),
+			Replies:     buildCommentTree(c.Replies.Comments, opts, level+1),
 		})
 		if len(out) >= limit {
 			break
 		}
 	}
-	return out, nil
+	return out
 }
 
 // doWithRetry retries API operations with backoff and explicit support for Reddit
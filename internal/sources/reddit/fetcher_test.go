@@ -1,10 +1,14 @@
 package reddit
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"log/slog"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
+	"strings"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -122,4 +126,180 @@ func TestNewHTTPClient_WrapsObservabilityTransport(t *testing.T) {
 	if _, ok := client.Transport.(*observabilityRoundTripper); !ok {
 		t.Fatalf("expected observabilityRoundTripper transport, got %T", client.Transport)
 	}
-}
\ No newline at end of file
+}
+
+func TestBuildCommentTree_AppliesDepthLimitsAndMinScore(t *testing.T) {
+	reply := func(id string, score int, replies ...*goreddit.Comment) *goreddit.Comment {
+		return &goreddit.Comment{
+			ID:        id,
+			Body:      "body " + id,
+			Score:     score,
+			Permalink: "/r/test/comments/p/post/" + id + "/",
+			Replies:   goreddit.Replies{Comments: replies},
+		}
+	}
+	minScore := 2
+	comments := []*goreddit.Comment{
+		reply("a", 10,
+			reply("a1", 5, reply("a1x", 3, reply("too-deep", 100))),
+			reply("a2", 1), // below min score
+			reply("a3", 4),
+			reply("a4", 4), // over level-1 limit
+		),
+		reply("b", 0), // below min score
+		reply("c", 3),
+		reply("d", 3), // over top-level limit
+	}
+	comments[0].IsSubmitter = true
+
+	tree := buildCommentTree(comments, commentTreeOptions{depth: 3, limits: []int{2, 2}, minScore: &minScore}, 0)
+
+	if len(tree) != 2 || tree[0].ID != "a" || tree[1].ID != "c" {
+		t.Fatalf("unexpected top level: %+v", tree)
+	}
+	if !tree[0].IsSubmitter || tree[0].Score != 10 {
+		t.Fatalf("expected score and submitter flag to be kept, got %+v", tree[0])
+	}
+	if tree[0].Permalink != "https://www.reddit.com/r/test/comments/p/post/a/" {
+		t.Fatalf("expected canonical permalink, got %q", tree[0].Permalink)
+	}
+	replies := tree[0].Replies
+	if len(replies) != 2 || replies[0].ID != "a1" || replies[1].ID != "a3" {
+		t.Fatalf("unexpected level-1 replies: %+v", replies)
+	}
+	if len(replies[0].Replies) != 1 || replies[0].Replies[0].ID != "a1x" {
+		t.Fatalf("unexpected level-2 replies: %+v", replies[0].Replies)
+	}
+	if len(replies[0].Replies[0].Replies) != 0 {
+		t.Fatalf("expected depth cap to drop level-3 replies")
+	}
+}
+
+func TestPostListing_DecodesExtendedFields(t *testing.T) {
+	raw := `{"kind":"Listing","data":{"children":[{"kind":"t3","data":{
+		"id":"abc","title":"Qwen 4 released","subreddit":"LocalLLaMA","score":412,
+		"upvote_ratio":0.96,"num_comments":87,"link_flair_text":"New Model",
+		"is_self":false,"over_18":false,"domain":"huggingface.co",
+		"crosspost_parent":"t3_xyz","permalink":"/r/LocalLLaMA/comments/abc/qwen/"}}]}}`
+
+	var listing postListing
+	if err := json.Unmarshal([]byte(raw), &listing); err != nil {
+		t.Fatalf("decode listing: %v", err)
+	}
+	if len(listing.Data.Children) != 1 {
+		t.Fatalf("expected 1 child, got %d", len(listing.Data.Children))
+	}
+	post := listing.Data.Children[0].Data
+	if post.ID != "abc" || post.SubredditName != "LocalLLaMA" || post.Score != 412 || post.NumberOfComments != 87 {
+		t.Fatalf("unexpected base fields: %+v", post.Post)
+	}
+	if post.LinkFlairText != "New Model" || post.Domain != "huggingface.co" || post.CrosspostParent != "t3_xyz" {
+		t.Fatalf("unexpected extended fields: %+v", post)
+	}
+}
+
+func TestResolvedQueryPath(t *testing.T) {
+	tests := []struct {
+		name  string
+		query Query
+		want  string
+	}{
+		{
+			name:  "legacy combined subreddits",
+			query: Query{},
+			want:  "r/golang+rust/top?limit=25&raw_json=1&t=week",
+		},
+		{
+			name:  "subreddit override",
+			query: Query{Subreddit: "LocalLLaMA", Sort: "hot", Limit: 10},
+			want:  "r/LocalLLaMA/hot?limit=10&raw_json=1",
+		},
+		{
+			name:  "restricted search",
+			query: Query{Subreddit: "LocalLLaMA", Search: "llama.cpp", Sort: "new"},
+			want:  "r/LocalLLaMA/search?limit=25&q=llama.cpp&raw_json=1&restrict_sr=1&sort=new&t=week&type=link",
+		},
+		{
+			name:  "user submissions",
+			query: Query{User: "u/karpathy", Sort: "new"},
+			want:  "user/karpathy/submitted?limit=25&raw_json=1&sort=new",
+		},
+		{
+			name:  "multireddit",
+			query: Query{Multireddit: "someone/ml", Sort: "rising"},
+			want:  "user/someone/m/ml/rising?limit=25&raw_json=1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := Config{Sort: "top", TimeFilter: "week"}
+			if tt.query != (Query{}) {
+				cfg.Queries = []Query{tt.query}
+			} else {
+				cfg.Subreddits = []string{"golang", "rust"}
+			}
+			queries := resolveQueries(cfg)
+			if len(queries) != 1 {
+				t.Fatalf("expected 1 query, got %d", len(queries))
+			}
+			got, err := queries[0].path()
+			if err != nil {
+				t.Fatalf("path failed: %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("path = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFetch_MergesQueriesWithDedupeAndPerQueryMinScore(t *testing.T) {
+	listings := map[string]string{
+		"/r/MachineLearning/top": `{"data":{"children":[
+			{"kind":"t3","data":{"id":"a","title":"A","score":500,"permalink":"/r/MachineLearning/comments/a/"}},
+			{"kind":"t3","data":{"id":"b","title":"B","score":20,"permalink":"/r/MachineLearning/comments/b/"}}]}}`,
+		"/r/LocalLLaMA/hot": `{"data":{"children":[
+			{"kind":"t3","data":{"id":"a","title":"A","score":500,"permalink":"/r/MachineLearning/comments/a/"}},
+			{"kind":"t3","data":{"id":"c","title":"C","score":3,"permalink":"/r/LocalLLaMA/comments/c/"}}]}}`,
+	}
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path+"?"+r.URL.RawQuery)
+		body, ok := listings[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	client, err := goreddit.NewReadonlyClient(goreddit.WithBaseURL(server.URL))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+	fetcher := &RedditFetcher{client: client, logger: slog.Default()}
+
+	minScore := 100
+	items, err := fetcher.Fetch(context.Background(), Config{
+		Queries: []Query{
+			{Subreddit: "MachineLearning", Sort: "top", TimeFilter: "day", MinScore: &minScore},
+			{Subreddit: "LocalLLaMA", Sort: "hot", Limit: 10},
+		},
+	})
+	if err != nil {
+		t.Fatalf("fetch failed: %v", err)
+	}
+
+	var ids []string
+	for _, item := range items {
+		ids = append(ids, item.ID)
+	}
+	if strings.Join(ids, ",") != "a,c" {
+		t.Fatalf("expected deduped ids a,c, got %v", ids)
+	}
+	if len(paths) != 2 || !strings.Contains(paths[0], "t=day") || !strings.Contains(paths[1], "limit=10") {
+		t.Fatalf("unexpected request paths: %v", paths)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"strconv"
 	"time"
 
 	"github.com/bakkerme/curator-ai/internal/config"
@@ -48,8 +49,8 @@ func (p *RedditProcessor) Configure(config map[string]interface{}) error {
 }
 
 func (p *RedditProcessor) Validate() error {
-	if len(p.config.Subreddits) == 0 {
-		return fmt.Errorf("at least one subreddit is required")
+	if len(p.config.Subreddits) == 0 && len(p.config.Queries) == 0 {
+		return fmt.Errorf("at least one subreddit or query is required")
 	}
 	if p.fetcher == nil {
 		return fmt.Errorf("reddit fetcher is required")
@@ -61,13 +62,17 @@ func (p *RedditProcessor) Fetch(ctx context.Context) ([]*core.PostBlock, error)
 	if err := p.Validate(); err != nil {
 		return nil, err
 	}
-	p.logger.Info("Fetching posts from Reddit", slog.Int("subreddits", len(p.config.Subreddits)))
+	p.logger.Info("Fetching posts from Reddit", slog.Int("subreddits", len(p.config.Subreddits)), slog.Int("queries", len(p.config.Queries)))
 	items, err := p.fetcher.Fetch(ctx, Config{
 		Subreddits:      p.config.Subreddits,
+		Queries:         queriesFromConfig(p.config.Queries),
 		Limit:           p.config.Limit,
 		Sort:            p.config.Sort,
 		TimeFilter:      p.config.TimeFilter,
 		IncludeComments: p.config.IncludeComments,
+		CommentDepth:    p.config.CommentDepth,
+		CommentLimits:   p.config.CommentLimits,
+		MinCommentScore: p.config.MinCommentScore,
 		IncludeWeb:      p.config.IncludeWeb,
 		IncludeImages:   p.config.IncludeImages,
 		MinScore:        p.config.MinScore,
@@ -96,21 +101,12 @@ func (p *RedditProcessor) Fetch(ctx context.Context) ([]*core.PostBlock, error)
 			Content:     item.Content,
 			Author:      item.Author,
 			CreatedAt:   item.CreatedAt,
+			Metadata:    itemMetadata(item),
 			SummaryPlan: sources.SummaryPlanFromConfig(p.config.SummaryPlan),
 		}
 
 		if p.config.IncludeComments && len(item.Comments) > 0 {
-			block.Comments = make([]core.CommentBlock, 0, len(item.Comments))
-			for _, c := range item.Comments {
-				cb := core.CommentBlock{
-					ID:        c.ID,
-					Author:    c.Author,
-					Content:   c.Content,
-					CreatedAt: c.CreatedAt,
-				}
-
-				block.Comments = append(block.Comments, cb)
-			}
+			block.Comments = commentBlocks(item.Comments)
 		}
 
 		if p.config.IncludeWeb && len(item.WebURLs) > 0 {
@@ -157,3 +153,67 @@ func (p *RedditProcessor) Fetch(ctx context.Context) ([]*core.PostBlock, error)
 	}
 	return blocks, nil
 }
+
+func queriesFromConfig(cfg []config.RedditQuery) []Query {
+	if len(cfg) == 0 {
+		return nil
+	}
+	queries := make([]Query, 0, len(cfg))
+	for _, q := range cfg {
+		queries = append(queries, Query{
+			Subreddit:   q.Subreddit,
+			User:        q.User,
+			Multireddit: q.Multireddit,
+			Search:      q.Search,
+			Sort:        q.Sort,
+			Limit:       q.Limit,
+			TimeFilter:  q.TimeFilter,
+			MinScore:    q.MinScore,
+		})
+	}
+	return queries
+}
+
+// itemMetadata exposes reddit listing fields to quality rules and templates.
+// Empty flair, domain and crosspost parent are omitted.
+func itemMetadata(item Item) map[string]string {
+	metadata := map[string]string{
+		"subreddit":    item.Subreddit,
+		"score":        strconv.Itoa(item.Score),
+		"upvote_ratio": strconv.FormatFloat(item.UpvoteRatio, 'f', -1, 64),
+		"num_comments": strconv.Itoa(item.NumComments),
+		"is_self":      strconv.FormatBool(item.IsSelf),
+		"over_18":      strconv.FormatBool(item.Over18),
+	}
+	if item.Flair != "" {
+		metadata["flair"] = item.Flair
+	}
+	if item.Domain != "" {
+		metadata["domain"] = item.Domain
+	}
+	if item.CrosspostParent != "" {
+		metadata["crosspost_parent"] = item.CrosspostParent
+	}
+	return metadata
+}
+
+// commentBlocks converts a reddit comment tree into nested CommentBlocks.
+func commentBlocks(comments []Comment) []core.CommentBlock {
+	if len(comments) == 0 {
+		return nil
+	}
+	out := make([]core.CommentBlock, 0, len(comments))
+	for _, c := range comments {
+		out = append(out, core.CommentBlock{
+			ID:          c.ID,
+			Author:      c.Author,
+			Content:     c.Content,
+			CreatedAt:   c.CreatedAt,
+			Score:       c.Score,
+			Permalink:   c.Permalink,
+			IsSubmitter: c.IsSubmitter,
+			Replies:     commentBlocks(c.Replies),
+		})
+	}
+	return out
+}
@@ -189,3 +189,56 @@ func TestRedditProcessorFiltersSeenPosts(t *testing.T) {
 		t.Fatalf("expected to mark only new post as seen")
 	}
 }
+
+func TestRedditProcessor_PopulatesPostMetadata(t *testing.T) {
+	cfg := &config.RedditSource{
+		Subreddits:  []string{"LocalLLaMA"},
+		SummaryPlan: &config.SummaryPlanConfig{Mode: core.SummaryModeFull},
+	}
+	fetcher := &redditmock.Fetcher{
+		Items: []reddit.Item{
+			{
+				ID:          "p1",
+				Title:       "t",
+				URL:         "https://reddit.com/r/LocalLLaMA/comments/p1",
+				Subreddit:   "LocalLLaMA",
+				Score:       412,
+				UpvoteRatio: 0.96,
+				NumComments: 87,
+				Flair:       "New Model",
+				Domain:      "huggingface.co",
+				CreatedAt:   time.Now().UTC(),
+			},
+		},
+	}
+
+	processor, err := reddit.NewRedditProcessor(cfg, fetcher, &readerMock{}, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to create processor: %v", err)
+	}
+	blocks, err := processor.Fetch(context.Background())
+	if err != nil {
+		t.Fatalf("fetch failed: %v", err)
+	}
+	if len(blocks) != 1 {
+		t.Fatalf("expected 1 block, got %d", len(blocks))
+	}
+	want := map[string]string{
+		"subreddit":    "LocalLLaMA",
+		"score":        "412",
+		"upvote_ratio": "0.96",
+		"num_comments": "87",
+		"flair":        "New Model",
+		"is_self":      "false",
+		"over_18":      "false",
+		"domain":       "huggingface.co",
+	}
+	for key, value := range want {
+		if got := blocks[0].Metadata[key]; got != value {
+			t.Errorf("metadata[%q] = %q, want %q", key, got, value)
+		}
+	}
+	if _, ok := blocks[0].Metadata["crosspost_parent"]; ok {
+		t.Errorf("expected empty crosspost_parent to be omitted")
+	}
+}
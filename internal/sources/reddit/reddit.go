@@ -5,39 +5,72 @@ import (
 	"time"
 )
 
-// Config describes the reddit fetch configuration.
+// Config describes the reddit fetch configuration. When Queries is empty the
+// Subreddits are fetched as a single combined listing.
 type Config struct {
 	Subreddits      []string
+	Queries         []Query
 	Limit           int
 	Sort            string
 	TimeFilter      string
 	IncludeComments bool
+	// CommentDepth is how many levels of the comment tree to keep (1 = top-level only).
+	// CommentLimits caps comments kept per level; the last value applies to deeper levels.
+	CommentDepth    int
+	CommentLimits   []int
+	MinCommentScore *int
 	IncludeWeb      bool
 	IncludeImages   bool
 	MinScore        int
 	UserAgent       string
 }
 
-// Comment represents a single reddit comment.
+// Query selects one listing: a subreddit, a user's submissions, a multireddit
+// (owner/name) or a search, optionally restricted to Subreddit. Zero-valued
+// Sort, Limit, TimeFilter and MinScore fall back to the Config values.
+type Query struct {
+	Subreddit   string
+	User        string
+	Multireddit string
+	Search      string
+	Sort        string
+	Limit       int
+	TimeFilter  string
+	MinScore    *int
+}
+
+// Comment represents a single reddit comment and its replies.
 type Comment struct {
-	ID        string
-	Author    string
-	Content   string
-	CreatedAt time.Time
+	ID          string
+	Author      string
+	Content     string
+	Score       int
+	Permalink   string
+	IsSubmitter bool
+	CreatedAt   time.Time
+	Replies     []Comment
 }
 
 // Item represents a single reddit post.
 type Item struct {
-	ID        string
-	Title     string
-	URL       string
-	Content   string
-	Author    string
-	Score     int
-	CreatedAt time.Time
-	Comments  []Comment
-	WebURLs   []string
-	ImageURLs []string
+	ID              string
+	Title           string
+	URL             string
+	Content         string
+	Author          string
+	Subreddit       string
+	Score           int
+	UpvoteRatio     float64
+	NumComments     int
+	Flair           string
+	IsSelf          bool
+	Over18          bool
+	Domain          string
+	CrosspostParent string
+	CreatedAt       time.Time
+	Comments        []Comment
+	WebURLs         []string
+	ImageURLs       []string
 }
 
 // Fetcher retrieves reddit posts based on config.
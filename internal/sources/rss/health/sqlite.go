@@ -0,0 +1,164 @@
+package health
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/bakkerme/curator-ai/internal/sqliteutil"
+)
+
+const defaultSQLiteTable = "feed_health"
+
+type SQLiteStore struct {
+	db         *sql.DB
+	table      string
+	tableIdent string
+	now        func() time.Time
+}
+
+func NewSQLiteStore(dsn string, table string) (*SQLiteStore, error) {
+	if table == "" {
+		table = defaultSQLiteTable
+	}
+	tableIdent, err := sqliteutil.QuoteIdentifier(table)
+	if err != nil {
+		return nil, err
+	}
+	db, err := sqliteutil.Open(dsn)
+	if err != nil {
+		return nil, err
+	}
+	store := &SQLiteStore{
+		db:         db,
+		table:      table,
+		tableIdent: tableIdent,
+		now:        func() time.Time { return time.Now().UTC() },
+	}
+	if err := store.ensureSchema(context.Background()); err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+	return store, nil
+}
+
+func (s *SQLiteStore) RecordSuccess(ctx context.Context, feedURL string, items int, lastItemAt time.Time) error {
+	if feedURL == "" {
+		return nil
+	}
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	// Keep the newest item date seen so far; a feed that trims old entries
+	// shouldn't look fresher or staler than it is.
+	var previous sql.NullTime
+	err = tx.QueryRowContext(ctx, fmt.Sprintf("SELECT last_item_at FROM %s WHERE feed_url = ?", s.tableIdent), feedURL).Scan(&previous)
+	if err != nil && err != sql.ErrNoRows {
+		return err
+	}
+	var lastItem interface{}
+	switch {
+	case !lastItemAt.IsZero() && (!previous.Valid || lastItemAt.After(previous.Time)):
+		lastItem = lastItemAt.UTC()
+	case previous.Valid:
+		lastItem = previous.Time.UTC()
+	}
+
+	_, err = tx.ExecContext(
+		ctx,
+		fmt.Sprintf(`INSERT INTO %[1]s (feed_url, last_success_at, consecutive_failures, success_count, total_items, last_item_at)
+			VALUES (?, ?, 0, 1, ?, ?)
+			ON CONFLICT(feed_url) DO UPDATE SET
+				last_success_at = excluded.last_success_at,
+				consecutive_failures = 0,
+				success_count = %[1]s.success_count + 1,
+				total_items = %[1]s.total_items + excluded.total_items,
+				last_item_at = excluded.last_item_at`, s.tableIdent),
+		feedURL,
+		s.now(),
+		items,
+		lastItem,
+	)
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (s *SQLiteStore) RecordFailure(ctx context.Context, feedURL string, fetchErr error) error {
+	if feedURL == "" {
+		return nil
+	}
+	message := ""
+	if fetchErr != nil {
+		message = fetchErr.Error()
+	}
+	_, err := s.db.ExecContext(
+		ctx,
+		fmt.Sprintf(`INSERT INTO %[1]s (feed_url, last_failure_at, last_error, consecutive_failures, success_count, total_items)
+			VALUES (?, ?, ?, 1, 0, 0)
+			ON CONFLICT(feed_url) DO UPDATE SET
+				last_failure_at = excluded.last_failure_at,
+				last_error = excluded.last_error,
+				consecutive_failures = %[1]s.consecutive_failures + 1`, s.tableIdent),
+		feedURL,
+		s.now(),
+		message,
+	)
+	return err
+}
+
+func (s *SQLiteStore) List(ctx context.Context) ([]FeedHealth, error) {
+	rows, err := s.db.QueryContext(ctx, fmt.Sprintf(`SELECT feed_url, last_success_at, last_failure_at, last_error,
+		consecutive_failures, success_count, total_items, last_item_at FROM %s ORDER BY feed_url`, s.tableIdent))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var out []FeedHealth
+	for rows.Next() {
+		var (
+			h                                    FeedHealth
+			lastSuccess, lastFailure, lastItemAt sql.NullTime
+			lastError                            sql.NullString
+		)
+		if err := rows.Scan(&h.FeedURL, &lastSuccess, &lastFailure, &lastError, &h.ConsecutiveFailures, &h.SuccessCount, &h.TotalItems, &lastItemAt); err != nil {
+			return nil, err
+		}
+		h.LastSuccessAt = lastSuccess.Time
+		h.LastFailureAt = lastFailure.Time
+		h.LastError = lastError.String
+		h.LastItemAt = lastItemAt.Time
+		out = append(out, h)
+	}
+	return out, rows.Err()
+}
+
+func (s *SQLiteStore) Close() error {
+	if s == nil || s.db == nil {
+		return nil
+	}
+	return s.db.Close()
+}
+
+func (s *SQLiteStore) ensureSchema(ctx context.Context) error {
+	ddl := fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (
+		feed_url TEXT PRIMARY KEY,
+		last_success_at TIMESTAMP,
+		last_failure_at TIMESTAMP,
+		last_error TEXT,
+		consecutive_failures INTEGER NOT NULL DEFAULT 0,
+		success_count INTEGER NOT NULL DEFAULT 0,
+		total_items INTEGER NOT NULL DEFAULT 0,
+		last_item_at TIMESTAMP
+	)`, s.tableIdent)
+	if _, err := s.db.ExecContext(ctx, ddl); err != nil {
+		return fmt.Errorf("create sqlite table: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestSQLiteStoreTracksSuccessAndFailures(t *testing.T) {
+	store, err := NewSQLiteStore(filepath.Join(t.TempDir(), "health.db"), "")
+	if err != nil {
+		t.Fatalf("new store: %v", err)
+	}
+	defer func() { _ = store.Close() }()
+
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	store.now = func() time.Time { return now }
+	ctx := context.Background()
+	feed := "https://example.com/feed.xml"
+
+	newest := now.Add(-48 * time.Hour)
+	if err := store.RecordSuccess(ctx, feed, 4, newest); err != nil {
+		t.Fatalf("record success: %v", err)
+	}
+	if err := store.RecordSuccess(ctx, feed, 2, newest.Add(-time.Hour)); err != nil {
+		t.Fatalf("record success: %v", err)
+	}
+	for i := 0; i < 3; i++ {
+		if err := store.RecordFailure(ctx, feed, errors.New("503 Service Unavailable")); err != nil {
+			t.Fatalf("record failure: %v", err)
+		}
+	}
+	if err := store.RecordFailure(ctx, "https://dead.example.com/rss", errors.New("no such host")); err != nil {
+		t.Fatalf("record failure: %v", err)
+	}
+
+	feeds, err := store.List(ctx)
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if len(feeds) != 2 {
+		t.Fatalf("expected 2 feeds, got %d", len(feeds))
+	}
+	h := feeds[1]
+	if h.FeedURL != feed {
+		t.Fatalf("unexpected order: %+v", feeds)
+	}
+	if h.SuccessCount != 2 || h.TotalItems != 6 || h.AverageItems() != 3 {
+		t.Fatalf("unexpected counts: %+v", h)
+	}
+	if h.ConsecutiveFailures != 3 || h.LastError != "503 Service Unavailable" {
+		t.Fatalf("unexpected failure tracking: %+v", h)
+	}
+	if !h.LastItemAt.Equal(newest) || !h.LastSuccessAt.Equal(now) {
+		t.Fatalf("unexpected timestamps: %+v", h)
+	}
+
+	if err := store.RecordSuccess(ctx, feed, 1, time.Time{}); err != nil {
+		t.Fatalf("record success: %v", err)
+	}
+	feeds, _ = store.List(ctx)
+	if feeds[1].ConsecutiveFailures != 0 || !feeds[1].LastItemAt.Equal(newest) {
+		t.Fatalf("expected success to reset failures and keep last item date: %+v", feeds[1])
+	}
+}
+
+func TestClassify(t *testing.T) {
+	now := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	week := 7 * 24 * time.Hour
+	tests := []struct {
+		name string
+		h    FeedHealth
+		want Status
+	}{
+		{"fresh", FeedHealth{LastItemAt: now.Add(-time.Hour)}, StatusOK},
+		{"stale", FeedHealth{LastItemAt: now.Add(-2 * week)}, StatusStale},
+		{"dead", FeedHealth{LastItemAt: now.Add(-time.Hour), ConsecutiveFailures: 3}, StatusDead},
+		{"never succeeded", FeedHealth{ConsecutiveFailures: 1}, StatusStale},
+		{"undated items", FeedHealth{LastSuccessAt: now.Add(-time.Hour)}, StatusOK},
+	}
+	for _, tt := range tests {
+		if got := Classify(tt.h, now, week, 3); got != tt.want {
+			t.Errorf("%s: got %s, want %s", tt.name, got, tt.want)
+		}
+	}
+}
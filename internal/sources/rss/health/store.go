@@ -0,0 +1,63 @@
+package health
+
+import (
+	"context"
+	"time"
+)
+
+// FeedHealth is the persisted fetch history of a single feed.
+type FeedHealth struct {
+	FeedURL             string
+	LastSuccessAt       time.Time
+	LastFailureAt       time.Time
+	LastError           string
+	ConsecutiveFailures int
+	SuccessCount        int
+	TotalItems          int
+	LastItemAt          time.Time
+}
+
+// AverageItems returns the mean number of items per successful fetch.
+func (h FeedHealth) AverageItems() float64 {
+	if h.SuccessCount == 0 {
+		return 0
+	}
+	return float64(h.TotalItems) / float64(h.SuccessCount)
+}
+
+// Store records feed fetch outcomes.
+type Store interface {
+	RecordSuccess(ctx context.Context, feedURL string, items int, lastItemAt time.Time) error
+	RecordFailure(ctx context.Context, feedURL string, fetchErr error) error
+	List(ctx context.Context) ([]FeedHealth, error)
+	Close() error
+}
+
+// Status classifies a feed for the health report.
+type Status string
+
+const (
+	StatusOK    Status = "ok"
+	StatusStale Status = "stale"
+	StatusDead  Status = "dead"
+)
+
+// Classify reports a feed as dead once it has failed deadAfter times in a row,
+// and as stale when its newest item is older than staleAfter. Feeds that have
+// never produced a dated item are judged by their last successful fetch.
+func Classify(h FeedHealth, now time.Time, staleAfter time.Duration, deadAfter int) Status {
+	if deadAfter > 0 && h.ConsecutiveFailures >= deadAfter {
+		return StatusDead
+	}
+	if staleAfter <= 0 {
+		return StatusOK
+	}
+	latest := h.LastItemAt
+	if latest.IsZero() {
+		latest = h.LastSuccessAt
+	}
+	if latest.IsZero() || now.Sub(latest) > staleAfter {
+		return StatusStale
+	}
+	return StatusOK
+}
@@ -0,0 +1,211 @@
+package rss
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/bakkerme/curator-ai/internal/retry"
+)
+
+// Feed is a single feed URL with the optional display title and category it was
+// listed under in an OPML document.
+type Feed struct {
+	URL      string
+	Title    string
+	Category string
+}
+
+type opmlDocument struct {
+	XMLName xml.Name `xml:"opml"`
+	Version string   `xml:"version,attr"`
+	Head    struct {
+		Title string `xml:"title,omitempty"`
+	} `xml:"head"`
+	Body struct {
+		Outlines []opmlOutline `xml:"outline"`
+	} `xml:"body"`
+}
+
+type opmlOutline struct {
+	Text     string        `xml:"text,attr"`
+	Title    string        `xml:"title,attr,omitempty"`
+	Type     string        `xml:"type,attr,omitempty"`
+	XMLURL   string        `xml:"xmlUrl,attr,omitempty"`
+	HTMLURL  string        `xml:"htmlUrl,attr,omitempty"`
+	Category string        `xml:"category,attr,omitempty"`
+	Outlines []opmlOutline `xml:"outline"`
+}
+
+func (o opmlOutline) label() string {
+	if strings.TrimSpace(o.Title) != "" {
+		return strings.TrimSpace(o.Title)
+	}
+	return strings.TrimSpace(o.Text)
+}
+
+// ParseOPML reads the feeds listed in an OPML document. Outlines without an
+// xmlUrl are treated as folders: their titles, joined with "/", become the
+// category of the feeds nested below them. A feed's own category attribute is
+// used when it is not inside a folder.
+func ParseOPML(r io.Reader) ([]Feed, error) {
+	var doc opmlDocument
+	decoder := xml.NewDecoder(r)
+	decoder.Strict = false
+	if err := decoder.Decode(&doc); err != nil {
+		return nil, fmt.Errorf("parse opml: %w", err)
+	}
+	var feeds []Feed
+	var walk func(outlines []opmlOutline, category string)
+	walk = func(outlines []opmlOutline, category string) {
+		for _, outline := range outlines {
+			feedURL := strings.TrimSpace(outline.XMLURL)
+			if feedURL == "" {
+				child := outline.label()
+				if category != "" && child != "" {
+					child = category + "/" + child
+				} else if child == "" {
+					child = category
+				}
+				walk(outline.Outlines, child)
+				continue
+			}
+			feedCategory := category
+			if feedCategory == "" {
+				// OPML 2.0 category attributes are comma-separated slash paths.
+				first, _, _ := strings.Cut(outline.Category, ",")
+				feedCategory = strings.Trim(strings.TrimSpace(first), "/")
+			}
+			feeds = append(feeds, Feed{URL: feedURL, Title: outline.label(), Category: feedCategory})
+			walk(outline.Outlines, category)
+		}
+	}
+	walk(doc.Body.Outlines, "")
+	return feeds, nil
+}
+
+// WriteOPML writes feeds as an OPML 2.0 document, grouping them into folder
+// outlines by category.
+func WriteOPML(w io.Writer, title string, feeds []Feed) error {
+	doc := opmlDocument{Version: "2.0"}
+	doc.Head.Title = title
+
+	folders := map[string]int{}
+	for _, feed := range feeds {
+		text := feed.Title
+		if text == "" {
+			text = feed.URL
+		}
+		outline := opmlOutline{Text: text, Title: feed.Title, Type: "rss", XMLURL: feed.URL}
+		if feed.Category == "" {
+			doc.Body.Outlines = append(doc.Body.Outlines, outline)
+			continue
+		}
+		idx, ok := folders[feed.Category]
+		if !ok {
+			idx = len(doc.Body.Outlines)
+			folders[feed.Category] = idx
+			doc.Body.Outlines = append(doc.Body.Outlines, opmlOutline{Text: feed.Category, Title: feed.Category})
+		}
+		doc.Body.Outlines[idx].Outlines = append(doc.Body.Outlines[idx].Outlines, outline)
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(doc); err != nil {
+		return fmt.Errorf("write opml: %w", err)
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// LoadOPML reads an OPML document from a local path or an http(s) URL.
+func LoadOPML(ctx context.Context, location string, userAgent string) ([]Feed, error) {
+	location = strings.TrimSpace(location)
+	if !strings.HasPrefix(location, "http://") && !strings.HasPrefix(location, "https://") {
+		data, err := os.ReadFile(location)
+		if err != nil {
+			return nil, fmt.Errorf("read opml: %w", err)
+		}
+		return ParseOPML(bytes.NewReader(data))
+	}
+
+	client := &http.Client{Timeout: 30 * time.Second}
+	var data []byte
+	err := retry.Do(ctx, retry.Config{Attempts: 3, BaseDelay: 200 * time.Millisecond}, func() error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, location, nil)
+		if err != nil {
+			return retry.Permanent(err)
+		}
+		if userAgent != "" {
+			req.Header.Set("User-Agent", userAgent)
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = resp.Body.Close() }()
+		if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError {
+			return fmt.Errorf("opml fetch transient error: %s", resp.Status)
+		}
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			return retry.Permanent(fmt.Errorf("opml fetch failed: %s", resp.Status))
+		}
+		body, err := io.ReadAll(io.LimitReader(resp.Body, 10<<20))
+		if err != nil {
+			return err
+		}
+		data = body
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("fetch opml: %w", err)
+	}
+	return ParseOPML(bytes.NewReader(data))
+}
+
+// ResolveFeeds merges the plain feed URLs with the feeds listed in the OPML
+// document at opmlLocation. Duplicate URLs are kept once; OPML titles and
+// categories are attached to plain feeds with the same URL.
+func ResolveFeeds(ctx context.Context, feedURLs []string, opmlLocation string, userAgent string) ([]Feed, error) {
+	feeds := make([]Feed, 0, len(feedURLs))
+	index := map[string]int{}
+	for _, u := range feedURLs {
+		u = strings.TrimSpace(u)
+		if u == "" {
+			continue
+		}
+		if _, ok := index[u]; ok {
+			continue
+		}
+		index[u] = len(feeds)
+		feeds = append(feeds, Feed{URL: u})
+	}
+	if strings.TrimSpace(opmlLocation) == "" {
+		return feeds, nil
+	}
+
+	listed, err := LoadOPML(ctx, opmlLocation, userAgent)
+	if err != nil {
+		return feeds, err
+	}
+	for _, feed := range listed {
+		if i, ok := index[feed.URL]; ok {
+			feeds[i].Title = feed.Title
+			feeds[i].Category = feed.Category
+			continue
+		}
+		index[feed.URL] = len(feeds)
+		feeds = append(feeds, feed)
+	}
+	return feeds, nil
+}
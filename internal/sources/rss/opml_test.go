@@ -0,0 +1,100 @@
+package rss
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const sampleOPML = `<?xml version="1.0" encoding="UTF-8"?>
+<opml version="2.0">
+  <head><title>Reader export</title></head>
+  <body>
+    <outline text="AI" title="AI">
+      <outline text="Research" title="Research">
+        <outline type="rss" text="arXiv cs.CL" title="arXiv cs.CL" xmlUrl="https://export.arxiv.org/rss/cs.CL"/>
+      </outline>
+      <outline type="rss" text="Simon Willison" xmlUrl="https://simonwillison.net/atom/everything/"/>
+    </outline>
+    <outline type="rss" text="Go Blog" title="The Go Blog" xmlUrl="https://go.dev/blog/feed.atom" category="/Programming/Go"/>
+    <outline type="rss" text="Loose" xmlUrl="https://example.com/feed.xml"/>
+  </body>
+</opml>`
+
+func TestParseOPMLMapsFoldersToCategories(t *testing.T) {
+	feeds, err := ParseOPML(strings.NewReader(sampleOPML))
+	if err != nil {
+		t.Fatalf("parse failed: %v", err)
+	}
+	want := []Feed{
+		{URL: "https://export.arxiv.org/rss/cs.CL", Title: "arXiv cs.CL", Category: "AI/Research"},
+		{URL: "https://simonwillison.net/atom/everything/", Title: "Simon Willison", Category: "AI"},
+		{URL: "https://go.dev/blog/feed.atom", Title: "The Go Blog", Category: "Programming/Go"},
+		{URL: "https://example.com/feed.xml", Title: "Loose"},
+	}
+	if len(feeds) != len(want) {
+		t.Fatalf("expected %d feeds, got %+v", len(want), feeds)
+	}
+	for i := range want {
+		if feeds[i] != want[i] {
+			t.Errorf("feed %d = %+v, want %+v", i, feeds[i], want[i])
+		}
+	}
+}
+
+func TestWriteOPMLRoundTrips(t *testing.T) {
+	feeds := []Feed{
+		{URL: "https://a.example.com/rss", Title: "A", Category: "News"},
+		{URL: "https://b.example.com/rss"},
+		{URL: "https://c.example.com/rss", Title: "C", Category: "News"},
+	}
+	var buf bytes.Buffer
+	if err := WriteOPML(&buf, "Curator feeds", feeds); err != nil {
+		t.Fatalf("write failed: %v", err)
+	}
+	parsed, err := ParseOPML(&buf)
+	if err != nil {
+		t.Fatalf("parse failed: %v", err)
+	}
+	if len(parsed) != 3 {
+		t.Fatalf("expected 3 feeds, got %+v", parsed)
+	}
+	if parsed[0].Category != "News" || parsed[1].URL != "https://c.example.com/rss" || parsed[1].Category != "News" {
+		t.Fatalf("expected News feeds grouped in one folder, got %+v", parsed)
+	}
+	if parsed[2].URL != "https://b.example.com/rss" || parsed[2].Category != "" {
+		t.Fatalf("unexpected uncategorized feed: %+v", parsed[2])
+	}
+}
+
+func TestResolveFeedsMergesPlainFeedsAndOPML(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(sampleOPML))
+	}))
+	defer server.Close()
+
+	feeds, err := ResolveFeeds(context.Background(), []string{"https://go.dev/blog/feed.atom", "https://extra.example.com/rss"}, server.URL, "")
+	if err != nil {
+		t.Fatalf("resolve failed: %v", err)
+	}
+	if len(feeds) != 5 {
+		t.Fatalf("expected 5 unique feeds, got %+v", feeds)
+	}
+	if feeds[0].URL != "https://go.dev/blog/feed.atom" || feeds[0].Title != "The Go Blog" {
+		t.Fatalf("expected plain feed to pick up OPML title, got %+v", feeds[0])
+	}
+
+	path := filepath.Join(t.TempDir(), "feeds.opml")
+	if err := os.WriteFile(path, []byte(sampleOPML), 0o644); err != nil {
+		t.Fatalf("write opml: %v", err)
+	}
+	fromFile, err := ResolveFeeds(context.Background(), nil, path, "")
+	if err != nil || len(fromFile) != 4 {
+		t.Fatalf("expected 4 feeds from file, got %+v (%v)", fromFile, err)
+	}
+}
@@ -2,25 +2,43 @@ package rss
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"html"
 	"net/url"
+	"regexp"
+	"strings"
+	"sync"
 	"time"
+	"unicode/utf8"
 
 	"github.com/bakkerme/curator-ai/internal/config"
 	"github.com/bakkerme/curator-ai/internal/core"
 	"github.com/bakkerme/curator-ai/internal/dedupe"
 	"github.com/bakkerme/curator-ai/internal/sources"
 	"github.com/bakkerme/curator-ai/internal/sources/htmlconv"
+	"github.com/bakkerme/curator-ai/internal/sources/reader"
+	"github.com/bakkerme/curator-ai/internal/sources/rss/health"
+)
+
+const (
+	defaultMaxConcurrency       = 4
+	defaultFullArticleMinLength = 500
 )
 
 type RSSProcessor struct {
 	name    string
 	config  config.RSSSource
 	fetcher Fetcher
+	reader  reader.Reader
 	store   dedupe.SeenStore
+	health  health.Store
 }
 
-func NewRSSProcessor(cfg *config.RSSSource, fetcher Fetcher, store dedupe.SeenStore) (*RSSProcessor, error) {
+// NewRSSProcessor creates an RSS source. The reader is only used by
+// fetch_full_article. healthStore is optional; when set, the outcome of every
+// feed fetch is recorded for `curator feeds health`.
+func NewRSSProcessor(cfg *config.RSSSource, fetcher Fetcher, r reader.Reader, store dedupe.SeenStore, healthStore health.Store) (*RSSProcessor, error) {
 	if cfg == nil {
 		return nil, fmt.Errorf("rss config is required")
 	}
@@ -28,7 +46,9 @@ func NewRSSProcessor(cfg *config.RSSSource, fetcher Fetcher, store dedupe.SeenSt
 		name:    "rss",
 		config:  *cfg,
 		fetcher: fetcher,
+		reader:  r,
 		store:   store,
+		health:  healthStore,
 	}, nil
 }
 
@@ -41,12 +61,15 @@ func (p *RSSProcessor) Configure(config map[string]interface{}) error {
 }
 
 func (p *RSSProcessor) Validate() error {
-	if len(p.config.Feeds) == 0 {
-		return fmt.Errorf("at least one rss feed is required")
+	if len(p.config.Feeds) == 0 && strings.TrimSpace(p.config.OPML) == "" {
+		return fmt.Errorf("at least one rss feed or an opml document is required")
 	}
 	if p.fetcher == nil {
 		return fmt.Errorf("rss fetcher is required")
 	}
+	if p.config.FetchFullArticle != nil && p.reader == nil {
+		return fmt.Errorf("rss fetch_full_article requires a reader")
+	}
 	return nil
 }
 
@@ -72,12 +95,37 @@ func (p *RSSProcessor) Fetch(ctx context.Context) ([]*core.PostBlock, error) {
 		UserAgent: p.config.UserAgent,
 	}
 
-	for _, feedURL := range p.config.Feeds {
-		items, err := p.fetcher.Fetch(ctx, feedURL, options)
-		if err != nil {
+	feeds, err := ResolveFeeds(ctx, p.config.Feeds, p.config.OPML, p.config.UserAgent)
+	if err != nil {
+		if len(feeds) == 0 {
 			return nil, err
 		}
-		for _, item := range items {
+		logger.Warn("failed to load opml, continuing with configured feeds", "opml", p.config.OPML, "error", err)
+		core.RecordRunError(ctx, core.ProcessError{
+			ProcessorName: p.name,
+			Stage:         "source",
+			Error:         err.Error(),
+			OccurredAt:    time.Now().UTC(),
+		})
+	}
+
+	results := p.fetchFeeds(ctx, feeds, options)
+	var fetchErrs []error
+	for _, result := range results {
+		feedURL := result.feed.URL
+		if result.err != nil {
+			// One dead feed must not take down the rest of the digest.
+			logger.Warn("failed to fetch feed", "feed_url", feedURL, "error", result.err)
+			fetchErrs = append(fetchErrs, fmt.Errorf("feed %s: %w", feedURL, result.err))
+			core.RecordRunError(ctx, core.ProcessError{
+				ProcessorName: p.name,
+				Stage:         "source",
+				Error:         fmt.Sprintf("feed %s: %v", feedURL, result.err),
+				OccurredAt:    time.Now().UTC(),
+			})
+			continue
+		}
+		for _, item := range result.items {
 			postLogger := logger.With("feed_url", feedURL)
 
 			id := item.ID
@@ -152,6 +200,28 @@ func (p *RSSProcessor) Fetch(ctx context.Context) ([]*core.PostBlock, error) {
 				}
 			}
 
+			metadata := feedMetadata(result.feed)
+			if p.shouldFetchFullArticle(content, item.Link) {
+				started := time.Now()
+				article, err := p.reader.Read(ctx, item.Link)
+				if err == nil && strings.TrimSpace(article) == "" {
+					err = fmt.Errorf("reader returned empty article")
+				}
+				if err != nil {
+					postLogger.Warn("failed to fetch full article via reader", "post_id", id, "post_url", item.Link, "elapsed", time.Since(started), "error", err)
+					procErrors = append(procErrors, core.ProcessError{
+						ProcessorName: p.name,
+						Stage:         "source",
+						Error:         fmt.Sprintf("full article fetch %s: %v", item.Link, err),
+						OccurredAt:    time.Now().UTC(),
+					})
+				} else {
+					postLogger.Info("fetched full article via reader", "post_id", id, "post_url", item.Link, "elapsed", time.Since(started))
+					content = mergeFullArticle(content, article, p.config.FetchFullArticle.Mode)
+					metadata["full_article"] = "true"
+				}
+			}
+
 			block := &core.PostBlock{
 				ID:          id,
 				URL:         item.Link,
@@ -159,6 +229,7 @@ func (p *RSSProcessor) Fetch(ctx context.Context) ([]*core.PostBlock, error) {
 				Content:     content,
 				Author:      item.Author,
 				CreatedAt:   item.PublishedAt,
+				Metadata:    metadata,
 				SummaryPlan: sources.SummaryPlanFromConfig(p.config.SummaryPlan),
 			}
 			if len(images) > 0 {
@@ -178,5 +249,116 @@ func (p *RSSProcessor) Fetch(ctx context.Context) ([]*core.PostBlock, error) {
 		}
 	}
 
+	if len(results) > 0 && len(fetchErrs) == len(results) {
+		return nil, fmt.Errorf("all rss feeds failed: %w", errors.Join(fetchErrs...))
+	}
 	return blocks, nil
 }
+
+type feedResult struct {
+	feed  Feed
+	items []Item
+	err   error
+}
+
+// fetchFeeds fetches every feed with bounded concurrency and returns the
+// results in feed order.
+func (p *RSSProcessor) fetchFeeds(ctx context.Context, feeds []Feed, options FetchOptions) []feedResult {
+	maxConcurrency := p.config.MaxConcurrency
+	if maxConcurrency <= 0 {
+		maxConcurrency = defaultMaxConcurrency
+	}
+	sem := make(chan struct{}, maxConcurrency)
+	results := make([]feedResult, len(feeds))
+
+	var wg sync.WaitGroup
+	for i, feed := range feeds {
+		feedURL := feed.URL
+		results[i].feed = feed
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			results[i].err = ctx.Err()
+			continue
+		}
+		wg.Add(1)
+		go func(i int, feedURL string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			items, err := p.fetcher.Fetch(ctx, feedURL, options)
+			results[i].items = items
+			results[i].err = err
+			p.recordHealth(ctx, feedURL, items, err)
+		}(i, feedURL)
+	}
+	wg.Wait()
+	return results
+}
+
+// shouldFetchFullArticle reports whether the item's content looks like a
+// teaser that should be replaced by the linked article.
+func (p *RSSProcessor) shouldFetchFullArticle(content string, link string) bool {
+	cfg := p.config.FetchFullArticle
+	if cfg == nil || p.reader == nil || strings.TrimSpace(link) == "" {
+		return false
+	}
+	if strings.EqualFold(cfg.When, "always") {
+		return true
+	}
+	minLength := cfg.MinLength
+	if minLength <= 0 {
+		minLength = defaultFullArticleMinLength
+	}
+	return visibleTextLength(content) < minLength
+}
+
+var htmlTagPattern = regexp.MustCompile(`(?s)<[^>]*>`)
+
+// visibleTextLength counts the characters a reader would see, ignoring markup
+// and runs of whitespace, so HTML-heavy teasers aren't mistaken for articles.
+func visibleTextLength(content string) int {
+	text := htmlTagPattern.ReplaceAllString(content, " ")
+	return utf8.RuneCountInString(strings.Join(strings.Fields(html.UnescapeString(text)), " "))
+}
+
+func mergeFullArticle(teaser string, article string, mode string) string {
+	if strings.EqualFold(mode, "append") && strings.TrimSpace(teaser) != "" {
+		return strings.TrimSpace(teaser) + "\n\n---\n\n" + strings.TrimSpace(article)
+	}
+	return article
+}
+
+// feedMetadata records which feed a block came from; the OPML outline title
+// names the feed and its folder becomes the category.
+func feedMetadata(feed Feed) map[string]string {
+	metadata := map[string]string{"feed_url": feed.URL}
+	if feed.Title != "" {
+		metadata["feed"] = feed.Title
+	}
+	if feed.Category != "" {
+		metadata["category"] = feed.Category
+	}
+	return metadata
+}
+
+func (p *RSSProcessor) recordHealth(ctx context.Context, feedURL string, items []Item, fetchErr error) {
+	if p.health == nil {
+		return
+	}
+	logger := core.LoggerFromContext(ctx)
+	if fetchErr != nil {
+		if err := p.health.RecordFailure(ctx, feedURL, fetchErr); err != nil {
+			logger.Warn("failed to record feed health", "feed_url", feedURL, "error", err)
+		}
+		return
+	}
+	var lastItemAt time.Time
+	for _, item := range items {
+		if item.PublishedAt.After(lastItemAt) {
+			lastItemAt = item.PublishedAt
+		}
+	}
+	if err := p.health.RecordSuccess(ctx, feedURL, len(items), lastItemAt); err != nil {
+		logger.Warn("failed to record feed health", "feed_url", feedURL, "error", err)
+	}
+}
@@ -3,11 +3,17 @@ package rss
 import (
 	"context"
 	"encoding/base64"
+	"errors"
+	"os"
+	"path/filepath"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/bakkerme/curator-ai/internal/config"
 	"github.com/bakkerme/curator-ai/internal/core"
+	"github.com/bakkerme/curator-ai/internal/sources/rss/health"
 )
 
 type rssFetcherMock struct {
@@ -76,7 +82,7 @@ func TestRSSProcessorPrefersContentByDefault(t *testing.T) {
 		},
 	}
 
-	processor, err := NewRSSProcessor(cfg, fetcher, nil)
+	processor, err := NewRSSProcessor(cfg, fetcher, nil, nil, nil)
 	if err != nil {
 		t.Fatalf("failed to create processor: %v", err)
 	}
@@ -109,7 +115,7 @@ func TestRSSProcessorConvertsHTMLToMarkdownWhenEnabled(t *testing.T) {
 		},
 	}
 
-	processor, err := NewRSSProcessor(cfg, fetcher, nil)
+	processor, err := NewRSSProcessor(cfg, fetcher, nil, nil, nil)
 	if err != nil {
 		t.Fatalf("failed to create processor: %v", err)
 	}
@@ -142,7 +148,7 @@ func TestRSSProcessorExtractsDataURIImagesIntoImageBlocks(t *testing.T) {
 		Description: "<p>hello<img alt=\"x\" src=\"data:image/png;base64," + imgB64 + "\" /></p>",
 	}}}
 
-	processor, err := NewRSSProcessor(cfg, fetcher, nil)
+	processor, err := NewRSSProcessor(cfg, fetcher, nil, nil, nil)
 	if err != nil {
 		t.Fatalf("failed to create processor: %v", err)
 	}
@@ -182,7 +188,7 @@ func TestRSSProcessorFiltersSeenPosts(t *testing.T) {
 	}
 	store := &fakeSeenStore{seen: map[string]bool{"a": true}}
 
-	processor, err := NewRSSProcessor(cfg, fetcher, store)
+	processor, err := NewRSSProcessor(cfg, fetcher, nil, store, nil)
 	if err != nil {
 		t.Fatalf("failed to create processor: %v", err)
 	}
@@ -198,3 +204,204 @@ func TestRSSProcessorFiltersSeenPosts(t *testing.T) {
 		t.Fatalf("expected to mark only new post as seen")
 	}
 }
+
+type feedFetcherMock struct {
+	itemsByFeed map[string][]Item
+	errByFeed   map[string]error
+}
+
+func (m *feedFetcherMock) Fetch(ctx context.Context, feedURL string, options FetchOptions) ([]Item, error) {
+	_ = ctx
+	if err, ok := m.errByFeed[feedURL]; ok {
+		return nil, err
+	}
+	return m.itemsByFeed[feedURL], nil
+}
+
+type healthStoreMock struct {
+	mu        sync.Mutex
+	successes map[string]int
+	failures  map[string]string
+}
+
+func (s *healthStoreMock) RecordSuccess(ctx context.Context, feedURL string, items int, lastItemAt time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.successes[feedURL] = items
+	return nil
+}
+
+func (s *healthStoreMock) RecordFailure(ctx context.Context, feedURL string, fetchErr error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures[feedURL] = fetchErr.Error()
+	return nil
+}
+
+func (s *healthStoreMock) List(ctx context.Context) ([]health.FeedHealth, error) { return nil, nil }
+func (s *healthStoreMock) Close() error                                          { return nil }
+
+func TestRSSProcessorIsolatesFeedFailures(t *testing.T) {
+	cfg := &config.RSSSource{
+		Feeds:          []string{"https://a.example.com/rss", "https://dead.example.com/rss", "https://b.example.com/rss"},
+		MaxConcurrency: 2,
+		SummaryPlan:    &config.SummaryPlanConfig{Mode: core.SummaryModeFull},
+	}
+	fetcher := &feedFetcherMock{
+		itemsByFeed: map[string][]Item{
+			"https://a.example.com/rss": {{ID: "a1", Title: "A", Link: "https://a.example.com/1"}},
+			"https://b.example.com/rss": {{ID: "b1", Title: "B", Link: "https://b.example.com/1"}},
+		},
+		errByFeed: map[string]error{"https://dead.example.com/rss": errors.New("404 Not Found")},
+	}
+	healthStore := &healthStoreMock{successes: map[string]int{}, failures: map[string]string{}}
+
+	processor, err := NewRSSProcessor(cfg, fetcher, nil, nil, healthStore)
+	if err != nil {
+		t.Fatalf("failed to create processor: %v", err)
+	}
+	runErrors := &core.RunErrors{}
+	blocks, err := processor.Fetch(core.WithRunErrors(context.Background(), runErrors))
+	if err != nil {
+		t.Fatalf("fetch failed: %v", err)
+	}
+	if len(blocks) != 2 || blocks[0].ID != "a1" || blocks[1].ID != "b1" {
+		t.Fatalf("expected blocks from healthy feeds in feed order, got %+v", blocks)
+	}
+	errs := runErrors.Errors()
+	if len(errs) != 1 || !strings.Contains(errs[0].Error, "dead.example.com") {
+		t.Fatalf("expected one run error for the dead feed, got %+v", errs)
+	}
+	if healthStore.successes["https://a.example.com/rss"] != 1 || healthStore.failures["https://dead.example.com/rss"] != "404 Not Found" {
+		t.Fatalf("unexpected health records: %+v %+v", healthStore.successes, healthStore.failures)
+	}
+}
+
+func TestRSSProcessorFailsWhenEveryFeedFails(t *testing.T) {
+	cfg := &config.RSSSource{
+		Feeds:       []string{"https://dead.example.com/rss"},
+		SummaryPlan: &config.SummaryPlanConfig{Mode: core.SummaryModeFull},
+	}
+	fetcher := &feedFetcherMock{errByFeed: map[string]error{"https://dead.example.com/rss": errors.New("no such host")}}
+
+	processor, err := NewRSSProcessor(cfg, fetcher, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to create processor: %v", err)
+	}
+	if _, err := processor.Fetch(context.Background()); err == nil {
+		t.Fatalf("expected error when all feeds fail")
+	}
+}
+
+func TestRSSProcessorAddsOPMLFeedMetadata(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "feeds.opml")
+	if err := os.WriteFile(path, []byte(sampleOPML), 0o644); err != nil {
+		t.Fatalf("write opml: %v", err)
+	}
+	cfg := &config.RSSSource{
+		OPML:        path,
+		SummaryPlan: &config.SummaryPlanConfig{Mode: core.SummaryModeFull},
+	}
+	fetcher := &feedFetcherMock{itemsByFeed: map[string][]Item{
+		"https://export.arxiv.org/rss/cs.CL": {{ID: "paper", Title: "Paper", Link: "https://arxiv.org/abs/1"}},
+	}}
+
+	processor, err := NewRSSProcessor(cfg, fetcher, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to create processor: %v", err)
+	}
+	blocks, err := processor.Fetch(context.Background())
+	if err != nil {
+		t.Fatalf("fetch failed: %v", err)
+	}
+	if len(blocks) != 1 {
+		t.Fatalf("expected 1 block, got %d", len(blocks))
+	}
+	metadata := blocks[0].Metadata
+	if metadata["category"] != "AI/Research" || metadata["feed"] != "arXiv cs.CL" || metadata["feed_url"] != "https://export.arxiv.org/rss/cs.CL" {
+		t.Fatalf("unexpected metadata: %+v", metadata)
+	}
+}
+
+type articleReaderMock struct {
+	pages map[string]string
+	err   error
+	calls []string
+}
+
+func (m *articleReaderMock) Read(ctx context.Context, url string) (string, error) {
+	_ = ctx
+	m.calls = append(m.calls, url)
+	if m.err != nil {
+		return "", m.err
+	}
+	return m.pages[url], nil
+}
+
+func TestRSSProcessorFetchesFullArticleForTeasers(t *testing.T) {
+	long := strings.Repeat("word ", 200)
+	cfg := &config.RSSSource{
+		Feeds:            []string{"https://example.com/feed.xml"},
+		FetchFullArticle: &config.FullArticleConfig{MinLength: 100},
+		SummaryPlan:      &config.SummaryPlanConfig{Mode: core.SummaryModeFull},
+	}
+	fetcher := &rssFetcherMock{items: []Item{
+		{ID: "teaser", Link: "https://example.com/teaser", Description: "<p>Read <b>more</b>&hellip;</p>"},
+		{ID: "full", Link: "https://example.com/full", Content: long},
+	}}
+	reader := &articleReaderMock{pages: map[string]string{"https://example.com/teaser": "# Full article"}}
+
+	processor, err := NewRSSProcessor(cfg, fetcher, reader, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to create processor: %v", err)
+	}
+	blocks, err := processor.Fetch(context.Background())
+	if err != nil {
+		t.Fatalf("fetch failed: %v", err)
+	}
+	if len(reader.calls) != 1 || reader.calls[0] != "https://example.com/teaser" {
+		t.Fatalf("expected only the teaser to be fetched, got %v", reader.calls)
+	}
+	if blocks[0].Content != "# Full article" || blocks[0].Metadata["full_article"] != "true" {
+		t.Fatalf("expected teaser replaced by article, got %q (%v)", blocks[0].Content, blocks[0].Metadata)
+	}
+	if blocks[1].Content != long {
+		t.Fatalf("expected long content untouched")
+	}
+}
+
+func TestRSSProcessorFullArticleAppendAndErrors(t *testing.T) {
+	cfg := &config.RSSSource{
+		Feeds:            []string{"https://example.com/feed.xml"},
+		FetchFullArticle: &config.FullArticleConfig{When: "always", Mode: "append"},
+		SummaryPlan:      &config.SummaryPlanConfig{Mode: core.SummaryModeFull},
+	}
+	fetcher := &rssFetcherMock{items: []Item{{ID: "1", Link: "https://example.com/1", Description: "Teaser"}}}
+
+	processor, err := NewRSSProcessor(cfg, fetcher, &articleReaderMock{pages: map[string]string{"https://example.com/1": "Article"}}, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to create processor: %v", err)
+	}
+	blocks, err := processor.Fetch(context.Background())
+	if err != nil {
+		t.Fatalf("fetch failed: %v", err)
+	}
+	if blocks[0].Content != "Teaser\n\n---\n\nArticle" {
+		t.Fatalf("expected appended article, got %q", blocks[0].Content)
+	}
+
+	processor, err = NewRSSProcessor(cfg, fetcher, &articleReaderMock{err: errors.New("boom")}, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to create processor: %v", err)
+	}
+	blocks, err = processor.Fetch(context.Background())
+	if err != nil {
+		t.Fatalf("fetch failed: %v", err)
+	}
+	if blocks[0].Content != "Teaser" {
+		t.Fatalf("expected teaser kept on reader failure, got %q", blocks[0].Content)
+	}
+	if len(blocks[0].Errors) != 1 || !strings.Contains(blocks[0].Errors[0].Error, "full article fetch") {
+		t.Fatalf("expected full article error on block, got %+v", blocks[0].Errors)
+	}
+}
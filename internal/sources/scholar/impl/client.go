@@ -0,0 +1,122 @@
+package impl
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/bakkerme/curator-ai/internal/retry"
+	"github.com/bakkerme/curator-ai/internal/sources/scholar"
+)
+
+const (
+	defaultBaseURL = "https://api.semanticscholar.org/graph/v1"
+	lookupFields   = "citationCount,influentialCitationCount,venue,tldr"
+)
+
+// Client calls the Semantic Scholar Graph API paper batch endpoint.
+type Client struct {
+	client    *http.Client
+	baseURL   string
+	apiKey    string
+	userAgent string
+}
+
+// NewClient builds a client for baseURL (default: the public Semantic Scholar
+// Graph API). apiKey is optional and raises the API's rate limits.
+func NewClient(timeout time.Duration, baseURL string, apiKey string, userAgent string) *Client {
+	if timeout <= 0 {
+		timeout = 15 * time.Second
+	}
+	if strings.TrimSpace(baseURL) == "" {
+		baseURL = defaultBaseURL
+	}
+	return &Client{
+		client:    &http.Client{Timeout: timeout},
+		baseURL:   strings.TrimRight(baseURL, "/"),
+		apiKey:    apiKey,
+		userAgent: userAgent,
+	}
+}
+
+type batchRequest struct {
+	IDs []string `json:"ids"`
+}
+
+type batchPaper struct {
+	CitationCount            int    `json:"citationCount"`
+	InfluentialCitationCount int    `json:"influentialCitationCount"`
+	Venue                    string `json:"venue"`
+	TLDR                     *struct {
+		Text string `json:"text"`
+	} `json:"tldr"`
+}
+
+func (c *Client) LookupArxiv(ctx context.Context, arxivIDs []string) (map[string]scholar.PaperInfo, error) {
+	if len(arxivIDs) == 0 {
+		return nil, nil
+	}
+	ids := make([]string, len(arxivIDs))
+	for i, id := range arxivIDs {
+		ids[i] = "ARXIV:" + id
+	}
+	payload, err := json.Marshal(batchRequest{IDs: ids})
+	if err != nil {
+		return nil, err
+	}
+
+	var papers []*batchPaper
+	err = retry.Do(ctx, retry.Config{Attempts: 3, BaseDelay: time.Second}, func() error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+"/paper/batch?fields="+lookupFields, bytes.NewReader(payload))
+		if err != nil {
+			return retry.Permanent(err)
+		}
+		req.Header.Set("Content-Type", "application/json")
+		if c.apiKey != "" {
+			req.Header.Set("x-api-key", c.apiKey)
+		}
+		if c.userAgent != "" {
+			req.Header.Set("User-Agent", c.userAgent)
+		}
+		resp, err := c.client.Do(req)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = resp.Body.Close() }()
+		if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError {
+			return fmt.Errorf("semantic scholar transient error: %s", resp.Status)
+		}
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+			return retry.Permanent(fmt.Errorf("semantic scholar status %s: %s", resp.Status, strings.TrimSpace(string(body))))
+		}
+		papers = nil
+		return json.NewDecoder(resp.Body).Decode(&papers)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("semantic scholar batch lookup: %w", err)
+	}
+
+	// The batch endpoint answers in request order, with null for unknown papers.
+	infos := make(map[string]scholar.PaperInfo, len(papers))
+	for i, paper := range papers {
+		if paper == nil || i >= len(arxivIDs) {
+			continue
+		}
+		info := scholar.PaperInfo{
+			CitationCount:            paper.CitationCount,
+			InfluentialCitationCount: paper.InfluentialCitationCount,
+			Venue:                    strings.TrimSpace(paper.Venue),
+		}
+		if paper.TLDR != nil {
+			info.TLDR = strings.TrimSpace(paper.TLDR.Text)
+		}
+		infos[arxivIDs[i]] = info
+	}
+	return infos, nil
+}
@@ -0,0 +1,69 @@
+package impl
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestLookupArxiv_MapsBatchResponse(t *testing.T) {
+	var gotIDs []string
+	var gotKey, gotFields string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/graph/v1/paper/batch" {
+			http.NotFound(w, r)
+			return
+		}
+		gotKey = r.Header.Get("x-api-key")
+		gotFields = r.URL.Query().Get("fields")
+		var body struct {
+			IDs []string `json:"ids"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		gotIDs = body.IDs
+		_, _ = w.Write([]byte(`[
+			{"paperId": "abc", "citationCount": 31, "influentialCitationCount": 4, "venue": "NeurIPS", "tldr": {"model": "tldr@v2", "text": "We do a thing."}},
+			null
+		]`))
+	}))
+	defer server.Close()
+
+	client := NewClient(2*time.Second, server.URL+"/graph/v1/", "secret", "test-agent")
+	infos, err := client.LookupArxiv(context.Background(), []string{"2401.00001", "2401.99999"})
+	if err != nil {
+		t.Fatalf("lookup failed: %v", err)
+	}
+	if len(gotIDs) != 2 || gotIDs[0] != "ARXIV:2401.00001" {
+		t.Fatalf("expected ARXIV-prefixed ids, got %v", gotIDs)
+	}
+	if gotKey != "secret" || gotFields == "" {
+		t.Fatalf("expected api key and fields, got key=%q fields=%q", gotKey, gotFields)
+	}
+	info, ok := infos["2401.00001"]
+	if !ok || info.CitationCount != 31 || info.InfluentialCitationCount != 4 || info.Venue != "NeurIPS" || info.TLDR != "We do a thing." {
+		t.Fatalf("unexpected info: %+v", infos)
+	}
+	if _, ok := infos["2401.99999"]; ok {
+		t.Fatalf("expected unknown paper to be omitted")
+	}
+}
+
+func TestLookupArxiv_DoesNotRetryClientErrors(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		http.Error(w, "bad fields", http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	client := NewClient(2*time.Second, server.URL, "", "")
+	if _, err := client.LookupArxiv(context.Background(), []string{"2401.00001"}); err == nil {
+		t.Fatalf("expected error")
+	}
+	if calls != 1 {
+		t.Fatalf("expected a single request, got %d", calls)
+	}
+}
@@ -0,0 +1,119 @@
+package scholar
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/bakkerme/curator-ai/internal/config"
+	"github.com/bakkerme/curator-ai/internal/core"
+)
+
+const defaultBatchSize = 100
+
+// Source wraps a source processor and adds citation metadata to every block
+// that carries Metadata["arxiv_id"]. Lookup failures are recorded as run
+// errors and leave the blocks unchanged, so a flaky API never drops papers.
+type Source struct {
+	core.SourceProcessor
+	config config.CitationsConfig
+	client Client
+	logger *slog.Logger
+}
+
+// WrapSource returns source unchanged when cfg is nil, so factories can call it
+// unconditionally.
+func WrapSource(source core.SourceProcessor, cfg *config.CitationsConfig, client Client, logger *slog.Logger) core.SourceProcessor {
+	if source == nil || cfg == nil {
+		return source
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &Source{
+		SourceProcessor: source,
+		config:          *cfg,
+		client:          client,
+		logger:          logger,
+	}
+}
+
+func (s *Source) Validate() error {
+	if err := s.SourceProcessor.Validate(); err != nil {
+		return err
+	}
+	if s.client == nil {
+		return fmt.Errorf("citations requires a scholar client")
+	}
+	return nil
+}
+
+func (s *Source) Fetch(ctx context.Context) ([]*core.PostBlock, error) {
+	blocks, err := s.SourceProcessor.Fetch(ctx)
+	if err != nil {
+		return blocks, err
+	}
+	if s.client == nil {
+		return blocks, fmt.Errorf("citations requires a scholar client")
+	}
+
+	byID := map[string][]*core.PostBlock{}
+	var ids []string
+	for _, block := range blocks {
+		if block == nil {
+			continue
+		}
+		id := block.Metadata["arxiv_id"]
+		if id == "" {
+			continue
+		}
+		if _, ok := byID[id]; !ok {
+			ids = append(ids, id)
+		}
+		byID[id] = append(byID[id], block)
+	}
+
+	batchSize := s.config.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultBatchSize
+	}
+	found := 0
+	for start := 0; start < len(ids); start += batchSize {
+		batch := ids[start:min(start+batchSize, len(ids))]
+		infos, err := s.client.LookupArxiv(ctx, batch)
+		if err != nil {
+			s.logger.Warn("Citation lookup failed", slog.Int("papers", len(batch)), slog.String("error", err.Error()))
+			core.RecordRunError(ctx, core.ProcessError{
+				ProcessorName: "citations",
+				Stage:         "source",
+				Error:         fmt.Sprintf("citation lookup: %v", err),
+				OccurredAt:    time.Now().UTC(),
+			})
+			continue
+		}
+		for id, info := range infos {
+			for _, block := range byID[id] {
+				applyPaperInfo(block, info)
+				found++
+			}
+		}
+	}
+	s.logger.Info("Added citation metadata", slog.Int("papers", len(ids)), slog.Int("found", found))
+	return blocks, nil
+}
+
+func applyPaperInfo(block *core.PostBlock, info PaperInfo) {
+	if block.Metadata == nil {
+		block.Metadata = map[string]string{}
+	}
+	block.Metadata["citation_count"] = strconv.Itoa(info.CitationCount)
+	block.Metadata["influential_citation_count"] = strconv.Itoa(info.InfluentialCitationCount)
+	if info.Venue != "" {
+		block.Metadata["venue"] = info.Venue
+	}
+	if info.TLDR != "" {
+		block.Metadata["tldr"] = info.TLDR
+	}
+}
@@ -0,0 +1,95 @@
+package scholar_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/bakkerme/curator-ai/internal/config"
+	"github.com/bakkerme/curator-ai/internal/core"
+	"github.com/bakkerme/curator-ai/internal/sources/scholar"
+)
+
+type staticSource struct {
+	blocks []*core.PostBlock
+}
+
+func (s *staticSource) Name() string                                  { return "static" }
+func (s *staticSource) Configure(config map[string]interface{}) error { return nil }
+func (s *staticSource) Validate() error                               { return nil }
+func (s *staticSource) Fetch(ctx context.Context) ([]*core.PostBlock, error) {
+	return s.blocks, nil
+}
+
+type clientMock struct {
+	infos   map[string]scholar.PaperInfo
+	err     error
+	batches [][]string
+}
+
+func (c *clientMock) LookupArxiv(ctx context.Context, ids []string) (map[string]scholar.PaperInfo, error) {
+	c.batches = append(c.batches, ids)
+	if c.err != nil {
+		return nil, c.err
+	}
+	out := map[string]scholar.PaperInfo{}
+	for _, id := range ids {
+		if info, ok := c.infos[id]; ok {
+			out[id] = info
+		}
+	}
+	return out, nil
+}
+
+func TestWrapSourceAddsCitationMetadataInBatches(t *testing.T) {
+	blocks := []*core.PostBlock{
+		{ID: "a", Metadata: map[string]string{"arxiv_id": "2401.00001"}},
+		{ID: "b", Metadata: map[string]string{"arxiv_id": "2401.00002"}},
+		{ID: "c", Metadata: map[string]string{"arxiv_id": "2401.00003"}},
+		{ID: "reddit-post"},
+	}
+	client := &clientMock{infos: map[string]scholar.PaperInfo{
+		"2401.00001": {CitationCount: 12, InfluentialCitationCount: 2, Venue: "ICML", TLDR: "Short."},
+		"2401.00003": {CitationCount: 0},
+	}}
+	source := scholar.WrapSource(&staticSource{blocks: blocks}, &config.CitationsConfig{BatchSize: 2}, client, nil)
+
+	got, err := source.Fetch(context.Background())
+	if err != nil {
+		t.Fatalf("fetch failed: %v", err)
+	}
+	if len(client.batches) != 2 || len(client.batches[0]) != 2 || len(client.batches[1]) != 1 {
+		t.Fatalf("expected batches of 2 and 1, got %v", client.batches)
+	}
+	first := got[0].Metadata
+	if first["citation_count"] != "12" || first["influential_citation_count"] != "2" || first["venue"] != "ICML" || first["tldr"] != "Short." {
+		t.Fatalf("unexpected metadata for known paper: %v", first)
+	}
+	if _, ok := got[1].Metadata["citation_count"]; ok {
+		t.Fatalf("expected unknown paper to be left without citation metadata")
+	}
+	if got[2].Metadata["citation_count"] != "0" {
+		t.Fatalf("expected zero citations to be recorded, got %v", got[2].Metadata)
+	}
+	if got[3].Metadata != nil {
+		t.Fatalf("expected blocks without arxiv_id to be untouched")
+	}
+}
+
+func TestWrapSourceRecordsLookupFailureAsRunError(t *testing.T) {
+	blocks := []*core.PostBlock{{ID: "a", Metadata: map[string]string{"arxiv_id": "2401.00001"}}}
+	client := &clientMock{err: errors.New("429 too many requests")}
+	source := scholar.WrapSource(&staticSource{blocks: blocks}, &config.CitationsConfig{}, client, nil)
+
+	runErrors := &core.RunErrors{}
+	got, err := source.Fetch(core.WithRunErrors(context.Background(), runErrors))
+	if err != nil {
+		t.Fatalf("expected lookup failure not to fail the source, got %v", err)
+	}
+	if len(got) != 1 {
+		t.Fatalf("expected blocks to be kept")
+	}
+	if recorded := runErrors.Errors(); len(recorded) != 1 || recorded[0].ProcessorName != "citations" {
+		t.Fatalf("expected one citations run error, got %v", recorded)
+	}
+}
@@ -0,0 +1,19 @@
+// Package scholar enriches paper blocks with citation data from a Semantic
+// Scholar-compatible API.
+package scholar
+
+import "context"
+
+// PaperInfo is the citation data looked up for one paper.
+type PaperInfo struct {
+	CitationCount            int
+	InfluentialCitationCount int
+	Venue                    string
+	TLDR                     string
+}
+
+// Client looks up papers by arXiv ID. Papers the API does not know are
+// missing from the returned map.
+type Client interface {
+	LookupArxiv(ctx context.Context, arxivIDs []string) (map[string]PaperInfo, error)
+}
@@ -0,0 +1,26 @@
+package sources
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/bakkerme/curator-ai/internal/dedupe"
+)
+
+// Seen reports whether store already holds key, logging skips as "already
+// seen <kind>". A missing store or a failed lookup counts as unseen, so a
+// broken store never hides posts.
+func Seen(ctx context.Context, store dedupe.SeenStore, logger *slog.Logger, kind string, key string) bool {
+	if store == nil {
+		return false
+	}
+	seen, err := store.HasSeen(ctx, key)
+	if err != nil {
+		logger.Warn("Failed to check dedupe store", kind, key, "error", err)
+		return false
+	}
+	if seen {
+		logger.Info("Skipping already seen "+kind, kind, key)
+	}
+	return seen
+}
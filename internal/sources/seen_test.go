@@ -0,0 +1,38 @@
+package sources
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+)
+
+type seenStoreMock struct {
+	seen map[string]bool
+	err  error
+}
+
+func (m *seenStoreMock) HasSeen(ctx context.Context, id string) (bool, error) {
+	return m.seen[id], m.err
+}
+
+func (m *seenStoreMock) MarkSeen(ctx context.Context, id string) error { return nil }
+
+func (m *seenStoreMock) MarkSeenBatch(ctx context.Context, ids []string) error { return nil }
+
+func (m *seenStoreMock) Close() error { return nil }
+
+func TestSeen(t *testing.T) {
+	ctx := context.Background()
+	logger := slog.Default()
+	store := &seenStoreMock{seen: map[string]bool{"a": true}}
+	if !Seen(ctx, store, logger, "post", "a") || Seen(ctx, store, logger, "post", "b") {
+		t.Fatalf("expected only the stored key to be seen")
+	}
+	if Seen(ctx, nil, logger, "post", "a") {
+		t.Fatalf("expected no store to mean unseen")
+	}
+	if Seen(ctx, &seenStoreMock{seen: map[string]bool{"a": true}, err: errors.New("locked")}, logger, "post", "a") {
+		t.Fatalf("expected a failed lookup to count as unseen")
+	}
+}
@@ -0,0 +1,84 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/bakkerme/curator-ai/internal/retry"
+	"github.com/bakkerme/curator-ai/internal/sources/urls"
+	"github.com/gabriel-vasile/mimetype"
+)
+
+const maxBodySize = 20 << 20 // 20 MiB
+
+// Fetcher implements urls.Fetcher over net/http.
+type Fetcher struct {
+	client    *http.Client
+	userAgent string
+}
+
+func NewFetcher(timeout time.Duration, userAgent string) *Fetcher {
+	if timeout <= 0 {
+		timeout = 30 * time.Second
+	}
+	return &Fetcher{client: &http.Client{Timeout: timeout}, userAgent: userAgent}
+}
+
+func (f *Fetcher) Fetch(ctx context.Context, url string) (urls.Document, error) {
+	var doc urls.Document
+	err := retry.Do(ctx, retry.Config{Attempts: 3, BaseDelay: 200 * time.Millisecond}, func() error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return retry.Permanent(err)
+		}
+		if f.userAgent != "" {
+			req.Header.Set("User-Agent", f.userAgent)
+		}
+		resp, err := f.client.Do(req)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = resp.Body.Close() }()
+		if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500 {
+			return fmt.Errorf("http status %d", resp.StatusCode)
+		}
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			return retry.Permanent(fmt.Errorf("http status %d", resp.StatusCode))
+		}
+		body, err := io.ReadAll(io.LimitReader(resp.Body, maxBodySize+1))
+		if err != nil {
+			return err
+		}
+		if len(body) > maxBodySize {
+			return retry.Permanent(fmt.Errorf("response larger than %d MiB", maxBodySize>>20))
+		}
+		doc = urls.Document{
+			URL:         resp.Request.URL.String(),
+			ContentType: contentType(resp.Header.Get("Content-Type"), body),
+			Body:        body,
+		}
+		return nil
+	})
+	return doc, err
+}
+
+// contentType returns the media type from the header, sniffing the body when
+// the server sends none or a generic binary type (common for PDFs).
+func contentType(header string, body []byte) string {
+	mediaType, _, err := mime.ParseMediaType(header)
+	if err != nil {
+		mediaType = ""
+	}
+	mediaType = strings.ToLower(mediaType)
+	switch mediaType {
+	case "", "application/octet-stream", "binary/octet-stream", "application/x-download", "application/download":
+		sniffed, _, _ := mime.ParseMediaType(mimetype.Detect(body).String())
+		return sniffed
+	}
+	return mediaType
+}
@@ -0,0 +1,51 @@
+package impl
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestFetch_UsesHeaderOrSniffsGenericTypes(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/page":
+			w.Header().Set("Content-Type", "text/html; charset=utf-8")
+			_, _ = w.Write([]byte("<html><body>Hello</body></html>"))
+		case "/paper":
+			w.Header().Set("Content-Type", "application/octet-stream")
+			_, _ = w.Write([]byte("%PDF-1.7\n%âãÏÓ\n1 0 obj\n<<>>\nendobj\n"))
+		case "/redirect":
+			http.Redirect(w, r, "/page", http.StatusFound)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	fetcher := NewFetcher(5*time.Second, "test-agent")
+	cases := []struct {
+		path        string
+		contentType string
+		finalPath   string
+	}{
+		{path: "/page", contentType: "text/html", finalPath: "/page"},
+		{path: "/paper", contentType: "application/pdf", finalPath: "/paper"},
+		{path: "/redirect", contentType: "text/html", finalPath: "/page"},
+	}
+	for _, tc := range cases {
+		doc, err := fetcher.Fetch(context.Background(), server.URL+tc.path)
+		if err != nil {
+			t.Fatalf("fetch %s failed: %v", tc.path, err)
+		}
+		if doc.ContentType != tc.contentType || doc.URL != server.URL+tc.finalPath {
+			t.Fatalf("fetch %s: unexpected document %q at %q", tc.path, doc.ContentType, doc.URL)
+		}
+	}
+
+	if _, err := fetcher.Fetch(context.Background(), server.URL+"/missing"); err == nil {
+		t.Fatalf("expected an error for a 404")
+	}
+}
@@ -0,0 +1,298 @@
+package urls
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/bakkerme/curator-ai/internal/config"
+	"github.com/bakkerme/curator-ai/internal/core"
+	"github.com/bakkerme/curator-ai/internal/dedupe"
+	"github.com/bakkerme/curator-ai/internal/sources"
+	"github.com/bakkerme/curator-ai/internal/sources/htmlconv"
+	"github.com/bakkerme/curator-ai/internal/sources/reader"
+)
+
+const (
+	dedupePrefix       = "urls:"
+	htmlReaderCrawl4AI = "crawl4ai"
+)
+
+// Readers recorded in the "reader" metadata key.
+const (
+	ReaderDocling  = "docling"
+	ReaderCrawl4AI = "crawl4ai"
+	ReaderBuiltin  = "builtin"
+	ReaderText     = "text"
+)
+
+// boilerplateSelector matches page chrome the built-in HTML reader drops.
+const boilerplateSelector = "script, style, noscript, template, iframe, svg, nav, header, footer, aside, form"
+
+// URLsProcessor reads a fixed list of URLs and emits one PostBlock per URL.
+// The content type decides how each URL is read: PDFs through Docling, HTML
+// through the built-in converter or Crawl4AI, and plain text or markdown as
+// is.
+type URLsProcessor struct {
+	name      string
+	config    config.URLsSource
+	fetcher   Fetcher
+	webReader reader.Reader
+	pdfReader reader.Reader
+	store     dedupe.SeenStore
+	logger    *slog.Logger
+}
+
+// NewURLsProcessor wires a new urls source. webReader is only needed with
+// html_reader "crawl4ai" and pdfReader only for PDFs.
+func NewURLsProcessor(cfg *config.URLsSource, fetcher Fetcher, webReader reader.Reader, pdfReader reader.Reader, store dedupe.SeenStore, logger *slog.Logger) (*URLsProcessor, error) {
+	if cfg == nil {
+		return nil, fmt.Errorf("urls config is required")
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &URLsProcessor{
+		name:      "urls",
+		config:    *cfg,
+		fetcher:   fetcher,
+		webReader: webReader,
+		pdfReader: pdfReader,
+		store:     store,
+		logger:    logger,
+	}, nil
+}
+
+func (p *URLsProcessor) Name() string {
+	return p.name
+}
+
+func (p *URLsProcessor) Configure(config map[string]interface{}) error {
+	return nil
+}
+
+func (p *URLsProcessor) Validate() error {
+	if len(p.config.URLs) == 0 && strings.TrimSpace(p.config.File) == "" {
+		return fmt.Errorf("urls or file are required")
+	}
+	if p.fetcher == nil {
+		return fmt.Errorf("urls fetcher is required")
+	}
+	if p.htmlReader() == htmlReaderCrawl4AI && p.webReader == nil {
+		return fmt.Errorf("urls html_reader crawl4ai requires a web reader")
+	}
+	return nil
+}
+
+func (p *URLsProcessor) Fetch(ctx context.Context) ([]*core.PostBlock, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	logger := core.LoggerFromContext(ctx).With("stage", "source", "processor", p.name)
+
+	list, err := p.list()
+	if err != nil {
+		return nil, err
+	}
+	var blocks []*core.PostBlock
+	for _, target := range list {
+		key := dedupePrefix + target
+		if sources.Seen(ctx, p.store, logger, "url", key) {
+			continue
+		}
+		block, err := p.read(ctx, target)
+		if err != nil {
+			// A dead link shouldn't sink the rest of a hand-picked batch.
+			logger.Warn("Failed to read URL; skipping", "url", target, "error", err)
+			core.RecordRunError(ctx, core.ProcessError{
+				ProcessorName: p.name,
+				Stage:         "source",
+				Error:         fmt.Sprintf("url %s: %v", target, err),
+				OccurredAt:    time.Now().UTC(),
+			})
+			continue
+		}
+		blocks = append(blocks, block)
+		logger.Info("Read URL", "url", target, "content_type", block.Metadata["content_type"], "reader", block.Metadata["reader"], "chars", len(block.Content))
+		if p.store != nil {
+			if err := p.store.MarkSeen(ctx, key); err != nil {
+				logger.Warn("Failed to mark URL as seen", "url", target, "error", err)
+			}
+		}
+	}
+	return blocks, nil
+}
+
+// list returns the inline URLs followed by those in the file, without
+// duplicates.
+func (p *URLsProcessor) list() ([]string, error) {
+	entries := append([]string{}, p.config.URLs...)
+	if file := strings.TrimSpace(p.config.File); file != "" {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("read urls file: %w", err)
+		}
+		scanner := bufio.NewScanner(bytes.NewReader(data))
+		for scanner.Scan() {
+			entries = append(entries, scanner.Text())
+		}
+		if err := scanner.Err(); err != nil {
+			return nil, fmt.Errorf("read urls file: %w", err)
+		}
+	}
+
+	var list []string
+	listed := make(map[string]bool)
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if entry == "" || strings.HasPrefix(entry, "#") || listed[entry] {
+			continue
+		}
+		parsed, err := url.Parse(entry)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			return nil, fmt.Errorf("urls entry %q is not an http(s) URL", entry)
+		}
+		listed[entry] = true
+		list = append(list, entry)
+	}
+	return list, nil
+}
+
+// read fetches target, picks a reader from the content type and builds the
+// block.
+func (p *URLsProcessor) read(ctx context.Context, target string) (*core.PostBlock, error) {
+	doc, err := p.fetcher.Fetch(ctx, target)
+	if err != nil {
+		return nil, err
+	}
+
+	var title, content, readerName string
+	switch {
+	case doc.ContentType == "application/pdf":
+		if p.pdfReader == nil {
+			return nil, fmt.Errorf("no PDF reader configured")
+		}
+		readerName = ReaderDocling
+		content, err = p.pdfReader.Read(ctx, target)
+	case doc.ContentType == "text/html" || doc.ContentType == "application/xhtml+xml":
+		title, content, err = extractHTML(doc.Body)
+		readerName = ReaderBuiltin
+		if err == nil && p.htmlReader() == htmlReaderCrawl4AI {
+			readerName = ReaderCrawl4AI
+			content, err = p.webReader.Read(ctx, target)
+		}
+	case strings.HasPrefix(doc.ContentType, "text/"):
+		readerName = ReaderText
+		content = string(doc.Body)
+	default:
+		return nil, fmt.Errorf("unsupported content type %q", doc.ContentType)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", readerName, err)
+	}
+	content = strings.TrimSpace(content)
+	if content == "" {
+		return nil, fmt.Errorf("%s returned no content", readerName)
+	}
+	if title == "" {
+		title = markdownTitle(content)
+	}
+	if title == "" {
+		title = urlTitle(target)
+	}
+
+	now := time.Now().UTC()
+	block := &core.PostBlock{
+		ID:          target,
+		URL:         target,
+		Title:       title,
+		Content:     content,
+		CreatedAt:   now,
+		ProcessedAt: now,
+		SummaryPlan: sources.SummaryPlanFromConfig(p.config.SummaryPlan),
+		Metadata: map[string]string{
+			"content_type": doc.ContentType,
+			"reader":       readerName,
+		},
+	}
+	if mode := block.SummaryPlan.Mode; mode == core.SummaryModePerChunk || mode == core.SummaryModeMapReduce {
+		block.Chunks = chunkMarkdown(content, block.SummaryPlan.MaxChunkChars, block.SummaryPlan.ChunkLimit)
+	}
+	return block, nil
+}
+
+func (p *URLsProcessor) htmlReader() string {
+	return strings.ToLower(strings.TrimSpace(p.config.HTMLReader))
+}
+
+// extractHTML is the built-in HTML reader: it keeps the main article (or
+// body) without page chrome and converts it to markdown.
+func extractHTML(body []byte) (string, string, error) {
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(body))
+	if err != nil {
+		return "", "", fmt.Errorf("parse html: %w", err)
+	}
+	title, _ := doc.Find(`meta[property="og:title"]`).First().Attr("content")
+	if strings.TrimSpace(title) == "" {
+		title = doc.Find("title").First().Text()
+	}
+	doc.Find(boilerplateSelector).Remove()
+	main := doc.Find("article").First()
+	for _, selector := range []string{"main", `[role="main"]`, "body"} {
+		if main.Length() > 0 {
+			break
+		}
+		main = doc.Find(selector).First()
+	}
+	fragment, err := goquery.OuterHtml(main)
+	if err != nil {
+		return "", "", fmt.Errorf("extract html: %w", err)
+	}
+	content, err := htmlconv.ConvertHTMLToMarkdown(fragment)
+	if err != nil {
+		return "", "", fmt.Errorf("convert html: %w", err)
+	}
+	return strings.Join(strings.Fields(title), " "), content, nil
+}
+
+// markdownTitle returns the first top-level heading of a markdown document.
+func markdownTitle(content string) string {
+	for _, line := range strings.Split(content, "\n") {
+		if heading, ok := strings.CutPrefix(strings.TrimSpace(line), "# "); ok {
+			return strings.TrimSpace(heading)
+		}
+	}
+	return ""
+}
+
+// urlTitle falls back to the last path segment, or the host.
+func urlTitle(target string) string {
+	parsed, err := url.Parse(target)
+	if err != nil {
+		return target
+	}
+	if base := path.Base(parsed.Path); base != "/" && base != "." {
+		if unescaped, err := url.PathUnescape(base); err == nil {
+			return unescaped
+		}
+		return base
+	}
+	return parsed.Host
+}
+
+// chunkMarkdown packs paragraphs into chunks of at most maxChars characters,
+// starting a new chunk at each heading once the current one is half full so
+// sections tend to stay together.
+func chunkMarkdown(content string, maxChars int, limit int) []core.ContentChunk {
+	return sources.PackChunks(strings.Split(content, "\n\n"), maxChars, limit, func(paragraph string) bool {
+		return strings.HasPrefix(paragraph, "#")
+	})
+}
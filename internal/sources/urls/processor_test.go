@@ -0,0 +1,143 @@
+package urls
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/bakkerme/curator-ai/internal/config"
+	"github.com/bakkerme/curator-ai/internal/core"
+)
+
+type fetcherMock struct {
+	docs map[string]Document
+}
+
+func (m *fetcherMock) Fetch(ctx context.Context, url string) (Document, error) {
+	doc, ok := m.docs[url]
+	if !ok {
+		return Document{}, os.ErrNotExist
+	}
+	return doc, nil
+}
+
+type readerMock struct {
+	content map[string]string
+	reads   []string
+}
+
+func (m *readerMock) Read(ctx context.Context, url string) (string, error) {
+	m.reads = append(m.reads, url)
+	return m.content[url], nil
+}
+
+const talkPage = `<html><head><title>Ignored title</title><meta property="og:title" content="Scaling Talk"></head>
+<body><nav>Home | Talks</nav><article><h1>Scaling Talk</h1><p>Slides and <a href="/notes">notes</a>.</p></article>
+<footer>© Conf</footer></body></html>`
+
+func TestURLsProcessor_ReadsByContentType(t *testing.T) {
+	fetcher := &fetcherMock{docs: map[string]Document{
+		"https://conf.example/talk":      {ContentType: "text/html", Body: []byte(talkPage)},
+		"https://papers.example/a.pdf":   {ContentType: "application/pdf", Body: []byte("%PDF-1.7")},
+		"https://notes.example/list.md":  {ContentType: "text/markdown", Body: []byte("# Reading list\n\n- one\n- two\n")},
+		"https://files.example/data.zip": {ContentType: "application/zip", Body: []byte("PK")},
+	}}
+	pdfReader := &readerMock{content: map[string]string{"https://papers.example/a.pdf": "# Attention Is All You Need\n\nAbstract..."}}
+
+	dir := t.TempDir()
+	file := filepath.Join(dir, "urls.txt")
+	list := "# talks\nhttps://conf.example/talk\n\nhttps://papers.example/a.pdf\nhttps://files.example/data.zip\nhttps://missing.example/\n"
+	if err := os.WriteFile(file, []byte(list), 0o600); err != nil {
+		t.Fatalf("write list: %v", err)
+	}
+	cfg := &config.URLsSource{URLs: []string{"https://notes.example/list.md", "https://conf.example/talk"}, File: file}
+	processor, err := NewURLsProcessor(cfg, fetcher, nil, pdfReader, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to create processor: %v", err)
+	}
+
+	runErrors := &core.RunErrors{}
+	blocks, err := processor.Fetch(core.WithRunErrors(context.Background(), runErrors))
+	if err != nil {
+		t.Fatalf("fetch failed: %v", err)
+	}
+	if len(blocks) != 3 {
+		t.Fatalf("expected unsupported and unreachable URLs to be skipped, got %d blocks", len(blocks))
+	}
+	if errs := runErrors.Errors(); len(errs) != 2 || !strings.Contains(errs[0].Error, "https://files.example/data.zip") || !strings.Contains(errs[1].Error, "https://missing.example/") {
+		t.Fatalf("expected the skipped URLs in the run errors, got %+v", errs)
+	}
+
+	notes, talk, paper := blocks[0], blocks[1], blocks[2]
+	if notes.Title != "Reading list" || notes.Metadata["reader"] != ReaderText || !strings.Contains(notes.Content, "- two") {
+		t.Fatalf("unexpected notes block %+v", notes)
+	}
+	if talk.Title != "Scaling Talk" || talk.Metadata["reader"] != ReaderBuiltin {
+		t.Fatalf("unexpected talk block %+v", talk)
+	}
+	if !strings.Contains(talk.Content, "Slides and [notes](/notes).") || strings.Contains(talk.Content, "Home") || strings.Contains(talk.Content, "©") {
+		t.Fatalf("expected article content without page chrome, got %q", talk.Content)
+	}
+	if paper.Title != "Attention Is All You Need" || paper.Metadata["reader"] != ReaderDocling || paper.Metadata["content_type"] != "application/pdf" {
+		t.Fatalf("unexpected paper block %+v", paper)
+	}
+	if len(paper.Chunks) != 0 {
+		t.Fatalf("expected no chunks without a chunked summary plan")
+	}
+}
+
+func TestURLsProcessor_Crawl4AIAndChunking(t *testing.T) {
+	fetcher := &fetcherMock{docs: map[string]Document{
+		"https://blog.example/post": {ContentType: "text/html", Body: []byte(talkPage)},
+	}}
+	long := "# Post\n\n" + strings.Repeat("Intro sentence. ", 10) + "\n\n## Method\n\n" + strings.Repeat("Method sentence. ", 10) + "\n\n## Results\n\n" + strings.Repeat("Result. ", 40)
+	webReader := &readerMock{content: map[string]string{"https://blog.example/post": long}}
+	cfg := &config.URLsSource{
+		URLs:        []string{"https://blog.example/post"},
+		HTMLReader:  "crawl4ai",
+		SummaryPlan: &config.SummaryPlanConfig{Mode: "map_reduce", MaxChunkChars: 200, ChunkLimit: 3},
+	}
+	processor, err := NewURLsProcessor(cfg, fetcher, webReader, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to create processor: %v", err)
+	}
+
+	blocks, err := processor.Fetch(context.Background())
+	if err != nil {
+		t.Fatalf("fetch failed: %v", err)
+	}
+	if len(blocks) != 1 || len(webReader.reads) != 1 {
+		t.Fatalf("expected one block read through crawl4ai, got %d blocks and %v", len(blocks), webReader.reads)
+	}
+	block := blocks[0]
+	if block.Metadata["reader"] != ReaderCrawl4AI || block.Content != strings.TrimSpace(long) || block.Title != "Scaling Talk" {
+		t.Fatalf("unexpected block %+v", block)
+	}
+	if len(block.Chunks) != 3 {
+		t.Fatalf("expected chunk_limit to cap chunks, got %d", len(block.Chunks))
+	}
+	if !strings.HasPrefix(block.Chunks[1].Content, "## Method") {
+		t.Fatalf("expected a heading to start a chunk, got %q", block.Chunks[1].Content)
+	}
+	for _, chunk := range block.Chunks {
+		if len([]rune(chunk.Content)) > 200 {
+			t.Fatalf("chunk exceeds max_chunk_chars: %d", len(chunk.Content))
+		}
+	}
+}
+
+func TestURLsProcessor_RejectsBadFileEntries(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "urls.txt")
+	if err := os.WriteFile(file, []byte("conf.example/talk\n"), 0o600); err != nil {
+		t.Fatalf("write list: %v", err)
+	}
+	processor, err := NewURLsProcessor(&config.URLsSource{File: file}, &fetcherMock{}, nil, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to create processor: %v", err)
+	}
+	if _, err := processor.Fetch(context.Background()); err == nil || !strings.Contains(err.Error(), "not an http(s) URL") {
+		t.Fatalf("expected a bad entry error, got %v", err)
+	}
+}
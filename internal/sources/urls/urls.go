@@ -0,0 +1,17 @@
+package urls
+
+import "context"
+
+// Document is a fetched URL: the body and its content type, taken from the
+// response header or sniffed from the body when the header is missing or
+// generic.
+type Document struct {
+	URL         string
+	ContentType string
+	Body        []byte
+}
+
+// Fetcher downloads URLs so the processor can decide how to read them.
+type Fetcher interface {
+	Fetch(ctx context.Context, url string) (Document, error)
+}
@@ -0,0 +1,164 @@
+package watch
+
+import (
+	"fmt"
+	"strings"
+)
+
+// maxDiffCells bounds the LCS table. Beyond it, the changed middle of the two
+// versions is reported as fully replaced rather than diffed line by line.
+const maxDiffCells = 4_000_000
+
+type opKind int
+
+const (
+	opEqual opKind = iota
+	opDelete
+	opInsert
+)
+
+type diffOp struct {
+	kind opKind
+	line string
+	// newLine is the 1-based line in the new version the op sits at.
+	newLine int
+}
+
+type lineDiff struct {
+	ops     []diffOp
+	added   int
+	removed int
+}
+
+// diffLines computes a line diff of before and after: common prefix and
+// suffix are matched directly, the middle with a longest-common-subsequence
+// table.
+func diffLines(before, after []string) lineDiff {
+	prefix := 0
+	for prefix < len(before) && prefix < len(after) && before[prefix] == after[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(before)-prefix && suffix < len(after)-prefix && before[len(before)-1-suffix] == after[len(after)-1-suffix] {
+		suffix++
+	}
+
+	var d lineDiff
+	written := 0
+	emit := func(kind opKind, line string) {
+		switch kind {
+		case opDelete:
+			d.removed++
+		case opInsert:
+			d.added++
+		}
+		// A deleted line sits before the next line of the new version.
+		position := written + 1
+		if kind != opDelete {
+			written++
+		}
+		d.ops = append(d.ops, diffOp{kind: kind, line: line, newLine: position})
+	}
+
+	for _, line := range before[:prefix] {
+		emit(opEqual, line)
+	}
+	a, b := before[prefix:len(before)-suffix], after[prefix:len(after)-suffix]
+	if len(a)*len(b) > maxDiffCells {
+		for _, line := range a {
+			emit(opDelete, line)
+		}
+		for _, line := range b {
+			emit(opInsert, line)
+		}
+	} else {
+		// lcs[i][j] is the LCS length of a[i:] and b[j:].
+		lcs := make([][]int32, len(a)+1)
+		for i := range lcs {
+			lcs[i] = make([]int32, len(b)+1)
+		}
+		for i := len(a) - 1; i >= 0; i-- {
+			for j := len(b) - 1; j >= 0; j-- {
+				if a[i] == b[j] {
+					lcs[i][j] = lcs[i+1][j+1] + 1
+				} else {
+					lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+				}
+			}
+		}
+		i, j := 0, 0
+		for i < len(a) || j < len(b) {
+			switch {
+			case i < len(a) && j < len(b) && a[i] == b[j]:
+				emit(opEqual, a[i])
+				i++
+				j++
+			case i < len(a) && (j == len(b) || lcs[i+1][j] >= lcs[i][j+1]):
+				emit(opDelete, a[i])
+				i++
+			default:
+				emit(opInsert, b[j])
+				j++
+			}
+		}
+	}
+	for _, line := range before[len(before)-suffix:] {
+		emit(opEqual, line)
+	}
+	return d
+}
+
+// changed is the number of changed lines: a replaced line counts once.
+func (d lineDiff) changed() int {
+	return max(d.added, d.removed)
+}
+
+// render formats the diff as a fenced markdown "diff" block, keeping context
+// unchanged lines around each hunk.
+func (d lineDiff) render(context int) string {
+	keep := make([]bool, len(d.ops))
+	for i, op := range d.ops {
+		if op.kind == opEqual {
+			continue
+		}
+		for k := max(0, i-context); k <= min(len(d.ops)-1, i+context); k++ {
+			keep[k] = true
+		}
+	}
+
+	var lines []string
+	longestFence := 0
+	for i, op := range d.ops {
+		if !keep[i] {
+			continue
+		}
+		if i == 0 || !keep[i-1] {
+			lines = append(lines, fmt.Sprintf("@@ line %d @@", op.newLine))
+		}
+		prefix := " "
+		switch op.kind {
+		case opDelete:
+			prefix = "-"
+		case opInsert:
+			prefix = "+"
+		}
+		lines = append(lines, prefix+op.line)
+		longestFence = max(longestFence, longestRun(op.line, '`'))
+	}
+	// Pages often contain code fences; the outer fence must be longer.
+	fence := strings.Repeat("`", max(3, longestFence+1))
+	return fence + "diff\n" + strings.Join(lines, "\n") + "\n" + fence
+}
+
+func longestRun(s string, r rune) int {
+	longest, current := 0, 0
+	for _, c := range s {
+		if c == r {
+			current++
+			longest = max(longest, current)
+		} else {
+			current = 0
+		}
+	}
+	return longest
+}
@@ -0,0 +1,42 @@
+package watch
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDiffLines_RendersHunksWithContext(t *testing.T) {
+	before := []string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j"}
+	after := []string{"a", "b", "c", "D", "e", "f", "g", "h", "i", "j", "k"}
+
+	diff := diffLines(before, after)
+	if diff.added != 2 || diff.removed != 1 || diff.changed() != 2 {
+		t.Fatalf("unexpected counts +%d -%d", diff.added, diff.removed)
+	}
+	want := strings.Join([]string{
+		"```diff",
+		"@@ line 2 @@",
+		" b",
+		" c",
+		"-d",
+		"+D",
+		" e",
+		" f",
+		"@@ line 9 @@",
+		" i",
+		" j",
+		"+k",
+		"```",
+	}, "\n")
+	if got := diff.render(2); got != want {
+		t.Fatalf("unexpected render:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestDiffLines_FenceOutlastsCodeBlocks(t *testing.T) {
+	diff := diffLines([]string{"```go", "x := 1", "```"}, []string{"```go", "x := 2", "```"})
+	got := diff.render(1)
+	if !strings.HasPrefix(got, "````diff\n") || !strings.HasSuffix(got, "\n````") {
+		t.Fatalf("expected a four-backtick fence, got %q", got)
+	}
+}
@@ -0,0 +1,314 @@
+package watch
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/bakkerme/curator-ai/internal/config"
+	"github.com/bakkerme/curator-ai/internal/core"
+	"github.com/bakkerme/curator-ai/internal/sources"
+	"github.com/bakkerme/curator-ai/internal/sources/htmlconv"
+	"github.com/bakkerme/curator-ai/internal/sources/reader"
+	"github.com/bakkerme/curator-ai/internal/sources/scrape"
+	"github.com/bakkerme/curator-ai/internal/state"
+)
+
+const (
+	stateNamespace         = "watch_page"
+	defaultMinChangedLines = 1
+	// diffContext is the number of unchanged lines shown around each change.
+	diffContext = 2
+	viaReader   = "reader"
+)
+
+// pageState is the stored version of a page: the normalized content last
+// reported (or the baseline) and when it was captured.
+type pageState struct {
+	Content    string    `json:"content"`
+	CapturedAt time.Time `json:"captured_at"`
+}
+
+// WatchProcessor emits a PostBlock with a markdown diff whenever a monitored
+// page changes by more than the configured threshold. The first run of a page
+// only records a baseline. Changes below the threshold leave the stored
+// version untouched, so small edits accumulate until they are reported.
+type WatchProcessor struct {
+	name       string
+	config     config.WatchSource
+	fetcher    scrape.Fetcher
+	reader     reader.Reader
+	stateStore state.Store
+	ignore     []*regexp.Regexp
+	logger     *slog.Logger
+	now        func() time.Time
+}
+
+// NewWatchProcessor wires a new watch source. fetcher is used with via
+// "scrape" and r with via "reader".
+func NewWatchProcessor(cfg *config.WatchSource, fetcher scrape.Fetcher, r reader.Reader, stateStore state.Store, logger *slog.Logger) (*WatchProcessor, error) {
+	if cfg == nil {
+		return nil, fmt.Errorf("watch config is required")
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+	ignore := make([]*regexp.Regexp, 0, len(cfg.IgnorePatterns))
+	for _, pattern := range cfg.IgnorePatterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("watch ignore_patterns %q: %w", pattern, err)
+		}
+		ignore = append(ignore, re)
+	}
+	return &WatchProcessor{
+		name:       "watch",
+		config:     *cfg,
+		fetcher:    fetcher,
+		reader:     r,
+		stateStore: stateStore,
+		ignore:     ignore,
+		logger:     logger,
+		now:        time.Now,
+	}, nil
+}
+
+func (p *WatchProcessor) Name() string {
+	return p.name
+}
+
+func (p *WatchProcessor) Configure(config map[string]interface{}) error {
+	return nil
+}
+
+func (p *WatchProcessor) Validate() error {
+	if len(p.config.Pages) == 0 {
+		return fmt.Errorf("watch pages are required")
+	}
+	if p.stateStore == nil {
+		return fmt.Errorf("watch requires a state store")
+	}
+	if p.via() == viaReader {
+		if p.reader == nil {
+			return fmt.Errorf("watch reader is required")
+		}
+	} else if p.fetcher == nil {
+		return fmt.Errorf("watch fetcher is required")
+	}
+	return nil
+}
+
+func (p *WatchProcessor) Fetch(ctx context.Context) ([]*core.PostBlock, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	logger := core.LoggerFromContext(ctx).With("stage", "source", "processor", p.name)
+
+	var blocks []*core.PostBlock
+	for _, page := range p.config.Pages {
+		title, lines, err := p.capture(ctx, page)
+		if err != nil {
+			// Pages are independent; one unreachable page shouldn't hide
+			// changes on the others.
+			logger.Warn("Failed to capture watched page", "url", page.URL, "error", err)
+			core.RecordRunError(ctx, core.ProcessError{
+				ProcessorName: p.name,
+				Stage:         "source",
+				Error:         fmt.Sprintf("page %s: %v", page.URL, err),
+				OccurredAt:    p.now().UTC(),
+			})
+			continue
+		}
+		current := pageState{Content: strings.Join(lines, "\n"), CapturedAt: p.now().UTC()}
+
+		key := p.stateKey(ctx, page)
+		raw, ok, err := p.stateStore.Get(ctx, stateNamespace, key)
+		if err != nil {
+			return nil, fmt.Errorf("load watch state for %s: %w", page.URL, err)
+		}
+		// Versions are stored once the run delivers its outputs, so a run
+		// that fails later reports the same change again.
+		saveCurrent := func(ctx context.Context) error { return p.save(ctx, key, current) }
+		if !ok {
+			logger.Info("Storing baseline for watched page", "url", page.URL, "lines", len(lines))
+			if err := core.OnRunSuccess(ctx, p.name, saveCurrent); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		var previous pageState
+		if err := json.Unmarshal([]byte(raw), &previous); err != nil {
+			return nil, fmt.Errorf("parse watch state for %s: %w", page.URL, err)
+		}
+		if previous.Content == current.Content {
+			continue
+		}
+
+		before := splitLines(previous.Content)
+		diff := diffLines(before, lines)
+		ratio := float64(diff.changed()) / float64(max(len(before), len(lines), 1))
+		if !p.material(diff, ratio) {
+			logger.Info("Watched page change below threshold", "url", page.URL, "changed_lines", diff.changed(), "ratio", ratio)
+			continue
+		}
+
+		blocks = append(blocks, p.buildBlock(page, title, previous, current, diff, ratio))
+		if err := core.OnRunSuccess(ctx, p.name, saveCurrent); err != nil {
+			return nil, err
+		}
+	}
+	return blocks, nil
+}
+
+func (p *WatchProcessor) via() string {
+	return strings.ToLower(strings.TrimSpace(p.config.Via))
+}
+
+// capture fetches a page and returns its title and normalized markdown lines.
+func (p *WatchProcessor) capture(ctx context.Context, page config.WatchPage) (string, []string, error) {
+	title := strings.TrimSpace(page.Name)
+	var markdown string
+	if p.via() == viaReader {
+		content, err := p.reader.Read(ctx, page.URL)
+		if err != nil {
+			return "", nil, err
+		}
+		markdown = content
+	} else {
+		html, err := p.fetcher.Fetch(ctx, page.URL, scrape.FetchOptions{UserAgent: p.config.Request.UserAgent})
+		if err != nil {
+			return "", nil, err
+		}
+		doc, err := goquery.NewDocumentFromReader(strings.NewReader(html))
+		if err != nil {
+			return "", nil, fmt.Errorf("parse page: %w", err)
+		}
+		if title == "" {
+			title = strings.TrimSpace(doc.Find("title").First().Text())
+		}
+		doc.Find("script, style, noscript, template").Remove()
+		for _, selector := range append(append([]string{}, p.config.IgnoreSelectors...), page.IgnoreSelectors...) {
+			doc.Find(selector).Remove()
+		}
+		selector := strings.TrimSpace(page.Selector)
+		if selector == "" {
+			selector = "body"
+		}
+		selection := doc.Find(selector)
+		if selection.Length() == 0 {
+			// Reporting this as "everything was removed" would be noise; a
+			// layout change needs a config fix instead.
+			return "", nil, fmt.Errorf("selector %q matched nothing", selector)
+		}
+		var parts []string
+		selection.Each(func(_ int, s *goquery.Selection) {
+			if fragment, err := goquery.OuterHtml(s); err == nil {
+				parts = append(parts, fragment)
+			}
+		})
+		converted, err := htmlconv.ConvertHTMLToMarkdown(strings.Join(parts, "\n"))
+		if err != nil {
+			return "", nil, fmt.Errorf("convert page: %w", err)
+		}
+		markdown = converted
+	}
+	if title == "" {
+		title = page.URL
+	}
+	return title, p.normalize(markdown), nil
+}
+
+// normalize drops blank lines, trailing whitespace and lines matching
+// ignore_patterns, so only meaningful edits show up in the diff.
+func (p *WatchProcessor) normalize(markdown string) []string {
+	var lines []string
+next:
+	for _, line := range strings.Split(markdown, "\n") {
+		line = strings.TrimRight(line, " \t\r")
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		for _, re := range p.ignore {
+			if re.MatchString(line) {
+				continue next
+			}
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+func (p *WatchProcessor) material(diff lineDiff, ratio float64) bool {
+	minLines := p.config.MinChangedLines
+	if minLines <= 0 {
+		minLines = defaultMinChangedLines
+	}
+	return diff.changed() >= minLines && ratio >= p.config.Threshold
+}
+
+func (p *WatchProcessor) buildBlock(page config.WatchPage, title string, previous, current pageState, diff lineDiff, ratio float64) *core.PostBlock {
+	summary := fmt.Sprintf("**%s** changed since %s: %d lines added, %d removed.",
+		title, previous.CapturedAt.UTC().Format("2006-01-02 15:04 MST"), diff.added, diff.removed)
+	sum := sha256.Sum256([]byte(page.URL + "\n" + current.Content))
+	return &core.PostBlock{
+		ID:          page.URL + "@" + hex.EncodeToString(sum[:8]),
+		URL:         page.URL,
+		Title:       title + " changed",
+		Content:     summary + "\n\n" + diff.render(diffContext),
+		CreatedAt:   current.CapturedAt,
+		ProcessedAt: time.Now().UTC(),
+		SummaryPlan: sources.SummaryPlanFromConfig(p.config.SummaryPlan),
+		Metadata: map[string]string{
+			"lines_added":      strconv.Itoa(diff.added),
+			"lines_removed":    strconv.Itoa(diff.removed),
+			"change_ratio":     strconv.FormatFloat(ratio, 'f', 3, 64),
+			"previous_version": previous.CapturedAt.UTC().Format(time.RFC3339),
+		},
+	}
+}
+
+func (p *WatchProcessor) save(ctx context.Context, key string, current pageState) error {
+	raw, err := json.Marshal(current)
+	if err != nil {
+		return err
+	}
+	if err := p.stateStore.Set(ctx, stateNamespace, key, string(raw)); err != nil {
+		return fmt.Errorf("save watch state: %w", err)
+	}
+	return nil
+}
+
+// stateKey scopes stored versions to the flow and to everything that shapes
+// the normalized content, so editing a selector starts from a fresh baseline
+// instead of reporting the edit as a page change.
+func (p *WatchProcessor) stateKey(ctx context.Context, page config.WatchPage) string {
+	flowID := core.FlowIDFromContext(ctx)
+	if flowID == "" {
+		flowID = "default"
+	}
+	parts := []string{
+		p.via(),
+		strings.TrimSpace(page.URL),
+		strings.TrimSpace(page.Selector),
+		strings.Join(p.config.IgnoreSelectors, ","),
+		strings.Join(page.IgnoreSelectors, ","),
+		strings.Join(p.config.IgnorePatterns, "\n"),
+	}
+	sum := sha256.Sum256([]byte(strings.Join(parts, "\n")))
+	return flowID + "/" + hex.EncodeToString(sum[:8])
+}
+
+func splitLines(content string) []string {
+	if content == "" {
+		return nil
+	}
+	return strings.Split(content, "\n")
+}
@@ -0,0 +1,204 @@
+package watch
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bakkerme/curator-ai/internal/config"
+	"github.com/bakkerme/curator-ai/internal/core"
+	"github.com/bakkerme/curator-ai/internal/sources/scrape"
+	"github.com/bakkerme/curator-ai/internal/state"
+)
+
+type fetcherMock struct {
+	pages map[string]string
+}
+
+func (m *fetcherMock) Fetch(ctx context.Context, url string, options scrape.FetchOptions) (string, error) {
+	return m.pages[url], nil
+}
+
+type readerMock struct {
+	content string
+}
+
+func (m *readerMock) Read(ctx context.Context, url string) (string, error) {
+	return m.content, nil
+}
+
+func pricingPage(price string, updated string) string {
+	return `<html><head><title>Pricing</title><script>var x = 1;</script></head><body>
+<div class="banner ad">Sale ends soon!</div>
+<main>
+  <h1>Plans</h1>
+  <p>Updated ` + updated + `</p>
+  <ul><li>Free: $0</li><li>Pro: ` + price + `</li><li>Team: $50</li><li>Enterprise: contact us</li></ul>
+</main>
+<footer>© 2026</footer>
+</body></html>`
+}
+
+func newStateStore(t *testing.T) *state.SQLiteStore {
+	t.Helper()
+	store, err := state.NewSQLiteStore(filepath.Join(t.TempDir(), "state.db"), "")
+	if err != nil {
+		t.Fatalf("open state store: %v", err)
+	}
+	t.Cleanup(func() { _ = store.Close() })
+	return store
+}
+
+func TestWatchProcessor_EmitsDiffOnlyForMaterialChanges(t *testing.T) {
+	const pageURL = "https://lab.example/pricing"
+	fetcher := &fetcherMock{pages: map[string]string{pageURL: pricingPage("$20", "Monday")}}
+	cfg := &config.WatchSource{
+		Pages:           []config.WatchPage{{URL: pageURL, Selector: "main", IgnoreSelectors: []string{".ad"}}},
+		IgnorePatterns:  []string{`^Updated `},
+		MinChangedLines: 1,
+	}
+	processor, err := NewWatchProcessor(cfg, fetcher, nil, newStateStore(t), nil)
+	if err != nil {
+		t.Fatalf("failed to create processor: %v", err)
+	}
+	processor.now = func() time.Time { return time.Date(2026, 5, 1, 9, 0, 0, 0, time.UTC) }
+	ctx := core.WithFlowID(context.Background(), "flow-1")
+
+	blocks, err := processor.Fetch(ctx)
+	if err != nil || len(blocks) != 0 {
+		t.Fatalf("expected the first run to store a baseline, got %v, %v", blocks, err)
+	}
+
+	// Only ignored content changes: no block.
+	fetcher.pages[pageURL] = pricingPage("$20", "Tuesday")
+	if blocks, err = processor.Fetch(ctx); err != nil || len(blocks) != 0 {
+		t.Fatalf("expected ignored changes to be skipped, got %v, %v", blocks, err)
+	}
+
+	processor.now = func() time.Time { return time.Date(2026, 5, 2, 9, 0, 0, 0, time.UTC) }
+	fetcher.pages[pageURL] = pricingPage("$25", "Wednesday")
+	blocks, err = processor.Fetch(ctx)
+	if err != nil {
+		t.Fatalf("fetch failed: %v", err)
+	}
+	if len(blocks) != 1 {
+		t.Fatalf("expected one change block, got %d", len(blocks))
+	}
+	block := blocks[0]
+	if block.Title != "Pricing changed" || block.URL != pageURL {
+		t.Fatalf("unexpected block %+v", block)
+	}
+	if !strings.Contains(block.Content, "since 2026-05-01 09:00 UTC: 1 lines added, 1 removed") {
+		t.Fatalf("unexpected summary in %q", block.Content)
+	}
+	if !strings.Contains(block.Content, "-- Pro: $20\n+- Pro: $25") || strings.Contains(block.Content, "Sale ends") || strings.Contains(block.Content, "©") {
+		t.Fatalf("unexpected diff in %q", block.Content)
+	}
+	if block.Metadata["lines_added"] != "1" || block.Metadata["previous_version"] != "2026-05-01T09:00:00Z" {
+		t.Fatalf("unexpected metadata %+v", block.Metadata)
+	}
+
+	// The reported version becomes the new baseline.
+	if blocks, err = processor.Fetch(ctx); err != nil || len(blocks) != 0 {
+		t.Fatalf("expected no change after reporting, got %v, %v", blocks, err)
+	}
+}
+
+func TestWatchProcessor_ReportsChangeAgainAfterFailedRun(t *testing.T) {
+	const pageURL = "https://lab.example/pricing"
+	fetcher := &fetcherMock{pages: map[string]string{pageURL: pricingPage("$20", "Monday")}}
+	cfg := &config.WatchSource{Pages: []config.WatchPage{{URL: pageURL, Selector: "main"}}, MinChangedLines: 1}
+	processor, err := NewWatchProcessor(cfg, fetcher, nil, newStateStore(t), nil)
+	if err != nil {
+		t.Fatalf("failed to create processor: %v", err)
+	}
+	run := func(deliver bool) []*core.PostBlock {
+		t.Helper()
+		hooks := &core.SuccessHooks{}
+		blocks, err := processor.Fetch(core.WithSuccessHooks(core.WithFlowID(context.Background(), "flow-1"), hooks))
+		if err != nil {
+			t.Fatalf("fetch failed: %v", err)
+		}
+		if deliver {
+			if errs := hooks.Run(context.Background()); len(errs) != 0 {
+				t.Fatalf("success hooks failed: %v", errs)
+			}
+		}
+		return blocks
+	}
+
+	if blocks := run(false); len(blocks) != 0 {
+		t.Fatalf("expected no block for the first capture, got %v", blocks)
+	}
+	if blocks := run(true); len(blocks) != 0 {
+		t.Fatalf("expected the baseline to be stored only once a run succeeds, got %v", blocks)
+	}
+
+	fetcher.pages[pageURL] = pricingPage("$25", "Monday")
+	if blocks := run(false); len(blocks) != 1 {
+		t.Fatalf("expected the change reported, got %d blocks", len(blocks))
+	}
+	if blocks := run(true); len(blocks) != 1 {
+		t.Fatalf("expected a failed run's change reported again, got %d blocks", len(blocks))
+	}
+	if blocks := run(true); len(blocks) != 0 {
+		t.Fatalf("expected no change once a run delivered it, got %d blocks", len(blocks))
+	}
+}
+
+func TestWatchProcessor_ThresholdAccumulatesSmallChanges(t *testing.T) {
+	const pageURL = "https://lab.example/leaderboard"
+	reader := &readerMock{content: "# Leaderboard\n\n1. model-a 81.0\n2. model-b 80.5\n3. model-c 79.9\n4. model-d 75.0"}
+	cfg := &config.WatchSource{
+		Pages:     []config.WatchPage{{URL: pageURL, Name: "Leaderboard"}},
+		Via:       "reader",
+		Threshold: 0.4,
+	}
+	processor, err := NewWatchProcessor(cfg, nil, reader, newStateStore(t), nil)
+	if err != nil {
+		t.Fatalf("failed to create processor: %v", err)
+	}
+	ctx := context.Background()
+	if _, err := processor.Fetch(ctx); err != nil {
+		t.Fatalf("baseline fetch failed: %v", err)
+	}
+
+	reader.content = "# Leaderboard\n\n1. model-a 81.0\n2. model-b 80.5\n3. model-e 80.1\n4. model-d 75.0"
+	if blocks, err := processor.Fetch(ctx); err != nil || len(blocks) != 0 {
+		t.Fatalf("expected a 1/5 change to stay below the threshold, got %v, %v", blocks, err)
+	}
+
+	reader.content = "# Leaderboard\n\n1. model-f 84.2\n2. model-a 81.0\n3. model-b 80.5\n4. model-e 80.1\n5. model-d 75.0"
+	blocks, err := processor.Fetch(ctx)
+	if err != nil {
+		t.Fatalf("fetch failed: %v", err)
+	}
+	if len(blocks) != 1 || blocks[0].Title != "Leaderboard changed" {
+		t.Fatalf("expected accumulated changes to be reported against the baseline, got %+v", blocks)
+	}
+	if !strings.Contains(blocks[0].Content, "-3. model-c 79.9") {
+		t.Fatalf("expected the diff against the original baseline, got %q", blocks[0].Content)
+	}
+}
+
+func TestWatchProcessor_SkipsPagesWhoseSelectorMatchesNothing(t *testing.T) {
+	fetcher := &fetcherMock{pages: map[string]string{"https://lab.example/research": "<html><body><p>Moved</p></body></html>"}}
+	cfg := &config.WatchSource{Pages: []config.WatchPage{{URL: "https://lab.example/research", Selector: "#papers"}}}
+	store := newStateStore(t)
+	processor, err := NewWatchProcessor(cfg, fetcher, nil, store, nil)
+	if err != nil {
+		t.Fatalf("failed to create processor: %v", err)
+	}
+	runErrors := &core.RunErrors{}
+	if blocks, err := processor.Fetch(core.WithRunErrors(context.Background(), runErrors)); err != nil || len(blocks) != 0 {
+		t.Fatalf("expected the page to be skipped, got %v, %v", blocks, err)
+	}
+	if errs := runErrors.Errors(); len(errs) != 1 || !strings.Contains(errs[0].Error, "https://lab.example/research") {
+		t.Fatalf("expected the skipped page in the run errors, got %+v", errs)
+	}
+	if _, ok, _ := store.Get(context.Background(), stateNamespace, processor.stateKey(context.Background(), cfg.Pages[0])); ok {
+		t.Fatalf("expected no baseline for an unmatched selector")
+	}
+}
@@ -0,0 +1,208 @@
+// Package watchlist flags posts written by people or organisations a flow
+// cares about, independent of the source's keyword query.
+package watchlist
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+
+	"github.com/bakkerme/curator-ai/internal/config"
+	"github.com/bakkerme/curator-ai/internal/core"
+)
+
+// MetadataKey holds the matched watchlist entries, joined with ", ".
+const MetadataKey = "watchlist"
+
+const (
+	matchSeparator    = ", "
+	maxTypoDistance   = 1
+	minTypoNameLength = 6
+)
+
+// Matcher matches authors against configured names and affiliations.
+type Matcher struct {
+	config       config.WatchlistConfig
+	authors      []personName
+	affiliations [][]string
+}
+
+// New returns nil when cfg is nil, so callers can use it unconditionally.
+func New(cfg *config.WatchlistConfig) *Matcher {
+	if cfg == nil {
+		return nil
+	}
+	m := &Matcher{config: *cfg}
+	for _, name := range cfg.Authors {
+		m.authors = append(m.authors, parseName(name))
+	}
+	for _, affiliation := range cfg.Affiliations {
+		m.affiliations = append(m.affiliations, tokens(affiliation))
+	}
+	return m
+}
+
+// Match returns the watchlist entries (as configured) matched by authors.
+func (m *Matcher) Match(authors []core.Author) []string {
+	if m == nil {
+		return nil
+	}
+	var matched []string
+	for i, entry := range m.authors {
+		for _, author := range authors {
+			if entry.matches(parseName(author.Name)) {
+				matched = append(matched, m.config.Authors[i])
+				break
+			}
+		}
+	}
+	for i, entry := range m.affiliations {
+	authorsLoop:
+		for _, author := range authors {
+			for _, affiliation := range author.Affiliations {
+				if containsSequence(tokens(affiliation), entry) {
+					matched = append(matched, m.config.Affiliations[i])
+					break authorsLoop
+				}
+			}
+		}
+	}
+	return matched
+}
+
+// Apply matches block.Authors and records the result in block.Metadata. It
+// reports whether the block matched.
+func (m *Matcher) Apply(block *core.PostBlock) bool {
+	if m == nil || block == nil {
+		return false
+	}
+	matched := m.Match(block.Authors)
+	if len(matched) == 0 {
+		return false
+	}
+	if block.Metadata == nil {
+		block.Metadata = map[string]string{}
+	}
+	block.Metadata[MetadataKey] = strings.Join(matched, matchSeparator)
+	// A must-read post has to survive quality filtering to reach the digest.
+	if m.config.BypassQuality || m.config.MustRead {
+		block.Metadata[core.MetadataQualityBypass] = "true"
+	}
+	if m.config.MustRead {
+		block.Metadata[core.MetadataMustRead] = "true"
+	}
+	return true
+}
+
+type personName struct {
+	given   []string
+	family  string
+	compact string
+}
+
+// parseName splits a display name into given names and a family name,
+// accepting both "Given Family" and "Family, Given".
+func parseName(raw string) personName {
+	if family, given, ok := strings.Cut(raw, ","); ok {
+		raw = given + " " + family
+	}
+	parts := tokens(raw)
+	if len(parts) == 0 {
+		return personName{}
+	}
+	return personName{
+		given:   parts[:len(parts)-1],
+		family:  parts[len(parts)-1],
+		compact: strings.Join(parts, ""),
+	}
+}
+
+// matches compares names loosely: "Y. LeCun", "Yann Le Cun" and "LeCun, Yann"
+// all match "Yann LeCun", and long family names tolerate a one-letter typo.
+func (n personName) matches(other personName) bool {
+	if n.family == "" || other.family == "" {
+		return false
+	}
+	if n.compact == other.compact {
+		return true
+	}
+	if !similar(n.family, other.family) {
+		return false
+	}
+	if len(n.given) == 0 || len(other.given) == 0 {
+		return true
+	}
+	a, b := n.given[0], other.given[0]
+	if len(a) == 1 || len(b) == 1 {
+		return a[0] == b[0]
+	}
+	return similar(a, b)
+}
+
+func similar(a, b string) bool {
+	if a == b {
+		return true
+	}
+	if len([]rune(a)) < minTypoNameLength || len([]rune(b)) < minTypoNameLength {
+		return false
+	}
+	return levenshtein(a, b) <= maxTypoDistance
+}
+
+// tokens lowercases s, folds accents (é -> e) and splits on anything that is
+// not a letter or digit.
+func tokens(s string) []string {
+	var b strings.Builder
+	for _, r := range norm.NFD.String(s) {
+		switch {
+		case unicode.Is(unicode.Mn, r):
+			continue
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			b.WriteRune(unicode.ToLower(r))
+		default:
+			b.WriteRune(' ')
+		}
+	}
+	return strings.Fields(b.String())
+}
+
+func containsSequence(haystack, needle []string) bool {
+	if len(needle) == 0 || len(needle) > len(haystack) {
+		return false
+	}
+	for i := 0; i+len(needle) <= len(haystack); i++ {
+		match := true
+		for j := range needle {
+			if haystack[i+j] != needle[j] {
+				match = false
+				break
+			}
+		}
+		if match {
+			return true
+		}
+	}
+	return false
+}
+
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr := make([]int, len(rb)+1)
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev = curr
+	}
+	return prev[len(rb)]
+}
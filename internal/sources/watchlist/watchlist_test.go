@@ -0,0 +1,105 @@
+package watchlist
+
+import (
+	"testing"
+
+	"github.com/bakkerme/curator-ai/internal/config"
+	"github.com/bakkerme/curator-ai/internal/core"
+)
+
+func TestMatcher_MatchesAuthorNamesLoosely(t *testing.T) {
+	m := New(&config.WatchlistConfig{Authors: []string{"Yann LeCun"}})
+
+	tests := []struct {
+		name   string
+		author string
+		want   bool
+	}{
+		{name: "exact", author: "Yann LeCun", want: true},
+		{name: "initial", author: "Y. LeCun", want: true},
+		{name: "family first", author: "LeCun, Yann", want: true},
+		{name: "split family name", author: "Yann Le Cun", want: true},
+		{name: "case", author: "yann lecun", want: true},
+		{name: "different given name", author: "Maria LeCun", want: false},
+		{name: "different family name", author: "Yann Bengio", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := len(m.Match([]core.Author{{Name: tt.author}})) > 0
+			if got != tt.want {
+				t.Fatalf("Match(%q) = %v, want %v", tt.author, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatcher_ToleratesTypoInLongNames(t *testing.T) {
+	m := New(&config.WatchlistConfig{Authors: []string{"Geoffrey Hinton"}})
+	if len(m.Match([]core.Author{{Name: "Geoffry Hintton"}})) == 0 {
+		t.Fatalf("expected one-letter typos to match")
+	}
+}
+
+func TestMatcher_ShortNamesRequireExactFamilyName(t *testing.T) {
+	m := New(&config.WatchlistConfig{Authors: []string{"Kai Li"}})
+	if len(m.Match([]core.Author{{Name: "Kai Lu"}})) > 0 {
+		t.Fatalf("expected short family names not to tolerate typos")
+	}
+	if len(m.Match([]core.Author{{Name: "K. Li"}})) == 0 {
+		t.Fatalf("expected initial to match")
+	}
+}
+
+func TestMatcher_FoldsAccents(t *testing.T) {
+	m := New(&config.WatchlistConfig{Authors: []string{"Jose Munoz"}})
+	if len(m.Match([]core.Author{{Name: "José Muñoz"}})) == 0 {
+		t.Fatalf("expected accented name to match")
+	}
+}
+
+func TestMatcher_MatchesAffiliationWords(t *testing.T) {
+	m := New(&config.WatchlistConfig{Affiliations: []string{"Google DeepMind"}})
+	authors := []core.Author{
+		{Name: "A. Person", Affiliations: []string{"University of Oxford"}},
+		{Name: "B. Person", Affiliations: []string{"Google DeepMind, London, UK"}},
+	}
+	got := m.Match(authors)
+	if len(got) != 1 || got[0] != "Google DeepMind" {
+		t.Fatalf("expected affiliation match, got %v", got)
+	}
+	if len(m.Match([]core.Author{{Name: "C", Affiliations: []string{"Google Research"}}})) > 0 {
+		t.Fatalf("expected partial affiliation not to match")
+	}
+}
+
+func TestMatcher_ApplySetsMetadata(t *testing.T) {
+	m := New(&config.WatchlistConfig{
+		Authors:      []string{"Ada Lovelace"},
+		Affiliations: []string{"Analytical Engine Society"},
+		MustRead:     true,
+	})
+	block := &core.PostBlock{Authors: []core.Author{
+		{Name: "Lovelace, Ada", Affiliations: []string{"The Analytical Engine Society"}},
+	}}
+	if !m.Apply(block) {
+		t.Fatalf("expected block to match")
+	}
+	if got := block.Metadata[MetadataKey]; got != "Ada Lovelace, Analytical Engine Society" {
+		t.Fatalf("unexpected watchlist metadata %q", got)
+	}
+	if block.Metadata[core.MetadataMustRead] != "true" || block.Metadata[core.MetadataQualityBypass] != "true" {
+		t.Fatalf("expected must_read to imply quality bypass, got %v", block.Metadata)
+	}
+
+	other := &core.PostBlock{Authors: []core.Author{{Name: "Charles Babbage"}}}
+	if m.Apply(other) || other.Metadata != nil {
+		t.Fatalf("expected no match and no metadata, got %v", other.Metadata)
+	}
+}
+
+func TestNew_NilConfigIsNoop(t *testing.T) {
+	var m *Matcher = New(nil)
+	if m.Apply(&core.PostBlock{Authors: []core.Author{{Name: "Anyone"}}}) {
+		t.Fatalf("expected nil matcher not to match")
+	}
+}
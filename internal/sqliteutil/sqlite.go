@@ -0,0 +1,61 @@
+// Package sqliteutil holds the setup shared by the SQLite-backed stores.
+package sqliteutil
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	_ "modernc.org/sqlite"
+)
+
+// Open creates the database's directory if needed and opens it with a single
+// connection, so writes from concurrent callers are serialized.
+func Open(dsn string) (*sql.DB, error) {
+	dsn = strings.TrimSpace(dsn)
+	if dsn == "" {
+		return nil, fmt.Errorf("sqlite dsn is required")
+	}
+	if err := ensureDir(dsn); err != nil {
+		return nil, err
+	}
+	db, err := sql.Open("sqlite", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("open sqlite: %w", err)
+	}
+	db.SetMaxOpenConns(1)
+	return db, nil
+}
+
+func ensureDir(dsn string) error {
+	if strings.HasPrefix(dsn, "file:") {
+		dsn = strings.TrimPrefix(dsn, "file:")
+		if idx := strings.IndexRune(dsn, '?'); idx >= 0 {
+			dsn = dsn[:idx]
+		}
+	}
+	if dsn == "" || dsn == ":memory:" {
+		return nil
+	}
+	dir := filepath.Dir(dsn)
+	if dir == "." || dir == "" {
+		return nil
+	}
+	return os.MkdirAll(dir, 0o755)
+}
+
+var identifierPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// QuoteIdentifier validates a table name and quotes it for use in SQL.
+func QuoteIdentifier(identifier string) (string, error) {
+	if identifier == "" {
+		return "", fmt.Errorf("sqlite table name is required")
+	}
+	if !identifierPattern.MatchString(identifier) {
+		return "", fmt.Errorf("sqlite table name %q must match %s", identifier, identifierPattern.String())
+	}
+	return `"` + identifier + `"`, nil
+}
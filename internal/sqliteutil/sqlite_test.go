@@ -0,0 +1,35 @@
+package sqliteutil
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestOpenCreatesDirectory(t *testing.T) {
+	dsn := filepath.Join(t.TempDir(), "nested", "dir", "store.db")
+	db, err := Open(dsn)
+	if err != nil {
+		t.Fatalf("Open error: %v", err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+	if err := db.Ping(); err != nil {
+		t.Fatalf("ping: %v", err)
+	}
+}
+
+func TestOpenRequiresDSN(t *testing.T) {
+	if _, err := Open("  "); err == nil {
+		t.Fatalf("expected an error for an empty dsn")
+	}
+}
+
+func TestQuoteIdentifier(t *testing.T) {
+	if got, err := QuoteIdentifier("seen_posts"); err != nil || got != `"seen_posts"` {
+		t.Fatalf("QuoteIdentifier = %q, %v", got, err)
+	}
+	for _, bad := range []string{"", "posts; DROP TABLE x", "1posts"} {
+		if _, err := QuoteIdentifier(bad); err == nil {
+			t.Errorf("expected %q to be rejected", bad)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package state
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/bakkerme/curator-ai/internal/sqliteutil"
+)
+
+const defaultSQLiteTable = "flow_state"
+
+type SQLiteStore struct {
+	db         *sql.DB
+	table      string
+	tableIdent string
+}
+
+func NewSQLiteStore(dsn string, table string) (*SQLiteStore, error) {
+	if table == "" {
+		table = defaultSQLiteTable
+	}
+	tableIdent, err := sqliteutil.QuoteIdentifier(table)
+	if err != nil {
+		return nil, err
+	}
+	db, err := sqliteutil.Open(dsn)
+	if err != nil {
+		return nil, err
+	}
+	store := &SQLiteStore{
+		db:         db,
+		table:      table,
+		tableIdent: tableIdent,
+	}
+	if err := store.ensureSchema(context.Background()); err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+	return store, nil
+}
+
+func (s *SQLiteStore) Get(ctx context.Context, namespace, key string) (string, bool, error) {
+	var value string
+	query := fmt.Sprintf("SELECT value FROM %s WHERE namespace = ? AND key = ?", s.tableIdent)
+	err := s.db.QueryRowContext(ctx, query, namespace, key).Scan(&value)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return "", false, nil
+		}
+		return "", false, err
+	}
+	return value, true, nil
+}
+
+func (s *SQLiteStore) Set(ctx context.Context, namespace, key, value string) error {
+	_, err := s.db.ExecContext(
+		ctx,
+		fmt.Sprintf(`INSERT INTO %s (namespace, key, value, updated_at) VALUES (?, ?, ?, ?)
+			ON CONFLICT(namespace, key) DO UPDATE SET value = excluded.value, updated_at = excluded.updated_at`, s.tableIdent),
+		namespace,
+		key,
+		value,
+		time.Now().UTC(),
+	)
+	return err
+}
+
+func (s *SQLiteStore) Close() error {
+	if s == nil || s.db == nil {
+		return nil
+	}
+	return s.db.Close()
+}
+
+func (s *SQLiteStore) ensureSchema(ctx context.Context) error {
+	ddl := fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (
+		namespace TEXT NOT NULL,
+		key TEXT NOT NULL,
+		value TEXT NOT NULL,
+		updated_at TIMESTAMP NOT NULL,
+		PRIMARY KEY (namespace, key)
+	)`, s.tableIdent)
+	if _, err := s.db.ExecContext(ctx, ddl); err != nil {
+		return fmt.Errorf("create sqlite table: %w", err)
+	}
+	return nil
+}
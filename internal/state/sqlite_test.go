@@ -0,0 +1,42 @@
+package state
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+)
+
+func TestSQLiteStoreGetSet(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state", "curator-state.db")
+	store, err := NewSQLiteStore(path, "")
+	if err != nil {
+		t.Fatalf("new store: %v", err)
+	}
+	ctx := context.Background()
+
+	if _, ok, err := store.Get(ctx, "arxiv_window", "flow-1/abc"); err != nil || ok {
+		t.Fatalf("expected missing key, got ok=%v err=%v", ok, err)
+	}
+	if err := store.Set(ctx, "arxiv_window", "flow-1/abc", "2026-01-01T00:00:00Z"); err != nil {
+		t.Fatalf("set: %v", err)
+	}
+	if err := store.Set(ctx, "arxiv_window", "flow-1/abc", "2026-01-02T00:00:00Z"); err != nil {
+		t.Fatalf("overwrite: %v", err)
+	}
+	if err := store.Close(); err != nil {
+		t.Fatalf("close: %v", err)
+	}
+
+	reopened, err := NewSQLiteStore(path, "")
+	if err != nil {
+		t.Fatalf("reopen: %v", err)
+	}
+	defer func() { _ = reopened.Close() }()
+	value, ok, err := reopened.Get(ctx, "arxiv_window", "flow-1/abc")
+	if err != nil || !ok || value != "2026-01-02T00:00:00Z" {
+		t.Fatalf("expected persisted value, got %q ok=%v err=%v", value, ok, err)
+	}
+	if _, ok, _ := reopened.Get(ctx, "other", "flow-1/abc"); ok {
+		t.Fatalf("expected namespaces to be isolated")
+	}
+}
@@ -0,0 +1,13 @@
+// Package state persists small pieces of per-flow bookkeeping between runs,
+// such as the end of the last window a source fetched.
+package state
+
+import "context"
+
+// Store is a namespaced key/value store.
+type Store interface {
+	// Get returns the stored value and whether it exists.
+	Get(ctx context.Context, namespace, key string) (string, bool, error)
+	Set(ctx context.Context, namespace, key, value string) error
+	Close() error
+}